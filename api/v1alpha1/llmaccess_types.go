@@ -19,17 +19,20 @@ package v1alpha1
 import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 // LLMAccessSpec defines the desired state of LLMAccess
 type LLMAccessSpec struct {
-	// ProviderRef references the cluster-scoped LLMProvider resource
+	// ProviderRef references the LLMProvider, or a NamespacedLLMProvider in the same
+	// namespace, that supplies the credentials
 	// +kubebuilder:validation:Required
 	ProviderRef ProviderReference `json:"providerRef"`
 
 	// Models is a list of model names/IDs that this access requires.
 	// Must be a subset of the provider's allowedModels.
-	// +kubebuilder:validation:MinItems=1
+	// When empty, the access is granted every model the provider allows.
+	// +listType=set
 	// +optional
 	Models []string `json:"models,omitempty"`
 
@@ -39,26 +42,83 @@ type LLMAccessSpec struct {
 	// +kubebuilder:validation:MinLength=1
 	SecretName string `json:"secretName"`
 
-	// WorkloadSelector determines which pods receive credential injection via webhook
+	// WorkloadSelector determines which pods receive credential injection via webhook.
+	// When ServiceAccountSelector or AnnotationSelector is also set, a pod must match all
+	// of them
 	// +optional
 	WorkloadSelector *metav1.LabelSelector `json:"workloadSelector,omitempty"`
 
+	// ServiceAccountSelector selects pods by the ServiceAccount they run as, for workloads
+	// without consistent labels. When another selector is also set, a pod must match all
+	// of them
+	// +optional
+	ServiceAccountSelector *ServiceAccountSelector `json:"serviceAccountSelector,omitempty"`
+
+	// AnnotationSelector selects pods carrying all of these annotations with exactly these
+	// values, for platforms that mark LLM-enabled workloads with annotations. When another
+	// selector is also set, a pod must match all of them
+	// +optional
+	AnnotationSelector map[string]string `json:"annotationSelector,omitempty"`
+
 	// Injection defines how credentials are injected into matching pods
 	// +kubebuilder:validation:Required
 	Injection InjectionConfig `json:"injection"`
 
+	// Priority orders this access among the accesses matching the same pod. Higher
+	// priorities are injected first, ties in name order, and an env var injected by an
+	// earlier access is not overridden by a later one with the same name
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
 	// Rotation allows overriding the provider's rotation schedule
 	// The interval must be less than or equal to the provider's interval
 	// +optional
 	Rotation *AccessRotationConfig `json:"rotation,omitempty"`
+
+	// TTL is how long after creation the LLMAccess deletes itself (e.g., "7d", "12h").
+	// Useful for ephemeral environments such as preview deployments
+	// +kubebuilder:validation:Pattern=`^\d+[dhm]$`
+	// +optional
+	TTL string `json:"ttl,omitempty"`
+
+	// SecretEncryptionClass overrides the provider's secretEncryptionClass for this
+	// access's target secret
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^\S+$`
+	// +optional
+	SecretEncryptionClass string `json:"secretEncryptionClass,omitempty"`
 }
 
-// ProviderReference references a cluster-scoped LLMProvider
+// ProviderKind is the kind of provider an LLMAccess references
+// +kubebuilder:validation:Enum=LLMProvider;NamespacedLLMProvider
+type ProviderKind string
+
+const (
+	ProviderKindLLMProvider           ProviderKind = "LLMProvider"
+	ProviderKindNamespacedLLMProvider ProviderKind = "NamespacedLLMProvider"
+)
+
+// ProviderReference references a cluster-scoped LLMProvider or a NamespacedLLMProvider
+// in the access's namespace
 type ProviderReference struct {
 	// Name of the LLMProvider resource
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinLength=1
 	Name string `json:"name"`
+
+	// Kind of the referenced provider. NamespacedLLMProvider resolves in the access's
+	// own namespace
+	// +kubebuilder:default=LLMProvider
+	// +optional
+	Kind ProviderKind `json:"kind,omitempty"`
+}
+
+// ServiceAccountSelector matches pods by their spec.serviceAccountName
+type ServiceAccountSelector struct {
+	// Names of ServiceAccounts in the access's namespace whose pods are selected
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	Names []string `json:"names"`
 }
 
 // InjectionConfig defines how credentials are injected into pods
@@ -67,9 +127,95 @@ type InjectionConfig struct {
 	// +optional
 	Env []EnvVarMapping `json:"env,omitempty"`
 
+	// EnvPosition controls where injected env vars go in each container's env list.
+	// Append (the default) adds them after the container's own vars; Prepend adds them
+	// before, so the container's vars can reference them with $(NAME); Replace overwrites
+	// a container var of the same name in place and appends the rest. In every mode a
+	// container var that shares a name with an injected var is replaced, so each name
+	// appears once and the injected credential always wins
+	// +kubebuilder:validation:Enum=Append;Prepend;Replace
+	// +optional
+	EnvPosition EnvPosition `json:"envPosition,omitempty"`
+
+	// InitContainers restricts injection to the named init containers. When set, env vars,
+	// the credential volume and the CA bundle go only into these init containers and the
+	// pod's regular containers are left untouched, e.g. for an init container that fetches
+	// a model at startup. Names that don't match an init container of the pod are ignored
+	// +listType=set
+	// +optional
+	InitContainers []string `json:"initContainers,omitempty"`
+
 	// Volume defines volume mount injection
 	// +optional
 	Volume *VolumeInjection `json:"volume,omitempty"`
+
+	// EnvFile writes the env mappings to a dotenv file, one NAME=value line each, instead of
+	// injecting them as env vars, for frameworks that read a single .env file. The mappings
+	// of every access with envFile that matches a pod are merged into one file; a name
+	// mapped by more than one of them is prefixed with each access's provider name, e.g.
+	// OPENAI_PRODUCTION_OPENAI_API_KEY. Needs the operator's credential copy image; without
+	// it the mappings are injected as env vars
+	// +optional
+	EnvFile *EnvFileInjection `json:"envFile,omitempty"`
+
+	// UsageSidecar injects a usage-reporting proxy sidecar that counts requests
+	// and tokens for cost visibility. It runs as a native sidecar (an init container
+	// with restartPolicy Always), which needs Kubernetes 1.29 or later
+	// +optional
+	UsageSidecar *UsageSidecarConfig `json:"usageSidecar,omitempty"`
+
+	// LazyProvisioning, when set, creates the target secret only once a running pod matches
+	// the workloadSelector or names this access in its llmwarden.io/access annotation, and
+	// deletes it again once no such pod has existed for idleGracePeriod. The first matching
+	// pod may fail to start its containers until the secret has been created
+	// +optional
+	LazyProvisioning *LazyProvisioningConfig `json:"lazyProvisioning,omitempty"`
+
+	// CACert mounts the provider's endpoint CA bundle (spec.endpoint.caSecretRef) into every
+	// container and points an env var at it, so SDKs trust a private endpoint. Skipped when
+	// the provider has no CA bundle configured
+	// +optional
+	CACert *CACertInjection `json:"caCert,omitempty"`
+
+	// Attribution injects plain env vars identifying the access, and optionally the pod,
+	// so upstream usage and logs can be attributed to the workload: LLMWARDEN_ACCESS_ID
+	// set to this access's UID, and LLMWARDEN_POD_NAME
+	// +optional
+	Attribution *AttributionInjection `json:"attribution,omitempty"`
+}
+
+// AttributionInjection configures the attribution env vars injected with the credentials
+type AttributionInjection struct {
+	// PodName also injects LLMWARDEN_POD_NAME, set to the pod's name by the downward API
+	// +optional
+	PodName bool `json:"podName,omitempty"`
+}
+
+// CACertInjection configures how the provider's endpoint CA bundle is injected into pods
+type CACertInjection struct {
+	// MountPath is the directory the CA bundle is mounted in, as ca.crt
+	// +kubebuilder:default="/etc/llmwarden/ca"
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	MountPath string `json:"mountPath,omitempty"`
+
+	// EnvVar is the environment variable set to the CA bundle's path. SSL_CERT_FILE replaces
+	// the system trust store for most SDKs; use e.g. REQUESTS_CA_BUNDLE or NODE_EXTRA_CA_CERTS
+	// to match the client library
+	// +kubebuilder:default="SSL_CERT_FILE"
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	EnvVar string `json:"envVar,omitempty"`
+}
+
+// LazyProvisioningConfig configures on-demand provisioning of the target secret
+type LazyProvisioningConfig struct {
+	// IdleGracePeriod is how long the secret is kept after the last matching pod is gone
+	// (e.g., "30m", "24h", "7d")
+	// +kubebuilder:validation:Pattern=`^\d+[dhm]$`
+	// +kubebuilder:default="24h"
+	// +optional
+	IdleGracePeriod string `json:"idleGracePeriod,omitempty"`
 }
 
 // EnvVarMapping defines mapping from secret key to environment variable
@@ -79,12 +225,21 @@ type EnvVarMapping struct {
 	// +kubebuilder:validation:MinLength=1
 	Name string `json:"name"`
 
-	// SecretKey is the key in the generated secret to map from
-	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:MinLength=1
-	SecretKey string `json:"secretKey"`
+	// SecretKey is the key in the generated secret to map from. Defaults to the
+	// provider's credential key (spec.auth.targetKey, "apiKey" unless overridden)
+	// +optional
+	SecretKey string `json:"secretKey,omitempty"`
 }
 
+// EnvPosition defines where injected env vars are placed in a container's env list
+type EnvPosition string
+
+const (
+	EnvPositionAppend  EnvPosition = "Append"
+	EnvPositionPrepend EnvPosition = "Prepend"
+	EnvPositionReplace EnvPosition = "Replace"
+)
+
 // VolumeInjection defines volume mount configuration for credential injection
 type VolumeInjection struct {
 	// MountPath is where to mount the secret volume in the pod
@@ -96,6 +251,77 @@ type VolumeInjection struct {
 	// +kubebuilder:default=true
 	// +optional
 	ReadOnly bool `json:"readOnly,omitempty"`
+
+	// Medium selects how the credential files are backed. Secret (the default) mounts the
+	// secret volume directly, which kubelets back with tmpfs and refresh on rotation.
+	// Memory copies the files into an emptyDir with medium Memory from an init container,
+	// guaranteeing RAM-backed storage regardless of kubelet configuration; the copy is not
+	// refreshed on rotation, so pods must be restarted to pick up a new key
+	// +kubebuilder:validation:Enum=Secret;Memory
+	// +optional
+	Medium VolumeMedium `json:"medium,omitempty"`
+
+	// DefaultMode is the file mode of the credential files, as a decimal number (288 is
+	// 0440). Secret volume files are owned by root and the pod's fsGroup, so the default
+	// 0440 is readable by non-root containers only when the pod sets
+	// securityContext.fsGroup
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=511
+	// +optional
+	DefaultMode *int32 `json:"defaultMode,omitempty"`
+}
+
+// DefaultCredentialFileMode is the file mode of injected credential files unless
+// spec.injection.volume.defaultMode overrides it: readable by root and the pod's fsGroup.
+const DefaultCredentialFileMode int32 = 0440
+
+// VolumeMedium defines how injected credential files are backed
+type VolumeMedium string
+
+const (
+	VolumeMediumSecret VolumeMedium = "Secret"
+	VolumeMediumMemory VolumeMedium = "Memory"
+)
+
+// EnvFileInjection configures the merged dotenv credentials file
+type EnvFileInjection struct {
+	// MountPath is the directory the file is mounted in, as .env. When several accesses
+	// with envFile match a pod, the mount path of the one injected first is used
+	// +kubebuilder:default="/etc/llmwarden/env"
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	MountPath string `json:"mountPath,omitempty"`
+}
+
+// Default ports for the usage-reporting sidecar.
+const (
+	DefaultUsageSidecarProxyPort   int32 = 8089
+	DefaultUsageSidecarMetricsPort int32 = 9464
+)
+
+// UsageSidecarConfig defines the usage-reporting sidecar injected alongside workloads.
+// The sidecar proxies OpenAI-compatible requests to the provider endpoint and serves
+// cumulative request and token counts that the operator scrapes.
+type UsageSidecarConfig struct {
+	// Image is the usage-reporting proxy image
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Image string `json:"image"`
+
+	// ProxyPort is the local port the sidecar accepts LLM API requests on.
+	// Workloads should point their base URL at http://localhost:<proxyPort>
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +kubebuilder:default=8089
+	// +optional
+	ProxyPort int32 `json:"proxyPort,omitempty"`
+
+	// MetricsPort is the port the sidecar serves usage counters on
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +kubebuilder:default=9464
+	// +optional
+	MetricsPort int32 `json:"metricsPort,omitempty"`
 }
 
 // AccessRotationConfig defines rotation configuration for this LLMAccess
@@ -105,10 +331,24 @@ type AccessRotationConfig struct {
 	// +kubebuilder:validation:Pattern=`^\d+[dhm]$`
 	// +optional
 	Interval string `json:"interval,omitempty"`
+
+	// MaintenanceWindow restricts scheduled rotations of this access to a recurring
+	// window, overriding the provider's. Ignored when the provider disallows overrides
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
 }
 
 // LLMAccessStatus defines the observed state of LLMAccess
 type LLMAccessStatus struct {
+	// Phase summarizes the conditions as Pending, Ready, Degraded or Error
+	// +optional
+	Phase Phase `json:"phase,omitempty"`
+
+	// Ready mirrors the Ready condition's status (true only when it is True) for
+	// automation that cannot easily query the conditions list
+	// +optional
+	Ready bool `json:"ready"`
+
 	// Conditions represent the current state of the LLMAccess resource
 	// +listType=map
 	// +listMapKey=type
@@ -119,17 +359,133 @@ type LLMAccessStatus struct {
 	// +optional
 	SecretRef *corev1.ObjectReference `json:"secretRef,omitempty"`
 
+	// SourceSecretRef references where the credential is copied from. For apiKey auth it
+	// is the provider's source Secret with fieldPath "data[<key>]" (".<property>" appended
+	// for JSON values); for externalSecret auth it is the SecretStore or ClusterSecretStore
+	// with fieldPath "remoteRef[<key>]" (".<property>" appended when set)
+	// +optional
+	SourceSecretRef *corev1.ObjectReference `json:"sourceSecretRef,omitempty"`
+
+	// ProvisionedAuthType is the provider auth type the credentials were last provisioned
+	// with. When the provider switches auth type, the previous provisioner's resources are
+	// cleaned up before provisioning with the new one
+	// +optional
+	ProvisionedAuthType AuthType `json:"provisionedAuthType,omitempty"`
+
+	// LastAuthTypeChange records the most recent provider auth type switch this access was
+	// migrated through
+	// +optional
+	LastAuthTypeChange *AuthTypeChange `json:"lastAuthTypeChange,omitempty"`
+
+	// EffectiveRefreshInterval is the refreshInterval set on the ExternalSecret for
+	// externalSecret auth: spec.rotation.interval, else the provider's refreshInterval,
+	// else ESO's 1h default
+	// +optional
+	EffectiveRefreshInterval string `json:"effectiveRefreshInterval,omitempty"`
+
+	// ResolvedStore is the SecretStore or ClusterSecretStore the ExternalSecret reads from
+	// for externalSecret auth, as resolved from the provider when last provisioned
+	// +optional
+	ResolvedStore *StoreReference `json:"resolvedStore,omitempty"`
+
 	// LastRotation is the timestamp of the last credential rotation
 	// +optional
 	LastRotation *metav1.Time `json:"lastRotation,omitempty"`
 
+	// RotationHistory lists the most recent credential rotations, oldest first. Records
+	// identify credentials by fingerprint, never by value
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	RotationHistory []RotationRecord `json:"rotationHistory,omitempty"`
+
+	// KeyRotation tracks the rotation window opened while the provider configures a
+	// next API key
+	// +optional
+	KeyRotation *KeyRotationStatus `json:"keyRotation,omitempty"`
+
 	// NextRotation is the timestamp of the next scheduled rotation
 	// +optional
 	NextRotation *metav1.Time `json:"nextRotation,omitempty"`
 
+	// IdleSince is when lazy provisioning last found no matching pods for a provisioned
+	// secret; the secret is deleted idleGracePeriod later unless a pod matches first
+	// +optional
+	IdleSince *metav1.Time `json:"idleSince,omitempty"`
+
+	// ExpiresAt is when the LLMAccess will be deleted because its TTL elapsed
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
 	// ProvisionedModels is the list of models that have been successfully provisioned
 	// +optional
 	ProvisionedModels []string `json:"provisionedModels,omitempty"`
+
+	// ValidatedGenerations records the access and provider the namespace and model checks
+	// last passed for. Reconciles skip those checks while neither has changed
+	// +optional
+	ValidatedGenerations *ValidatedGenerations `json:"validatedGenerations,omitempty"`
+}
+
+// ValidatedGenerations identifies the access and provider versions that passed validation
+type ValidatedGenerations struct {
+	// Access is the LLMAccess generation that was validated
+	Access int64 `json:"access"`
+
+	// Provider is the provider generation that was validated
+	Provider int64 `json:"provider"`
+
+	// ProviderUID distinguishes a recreated provider, whose generation starts over
+	ProviderUID types.UID `json:"providerUID"`
+}
+
+// AuthTypeChange is a provider auth type switch an LLMAccess was migrated through: the
+// previous provisioner's resources were cleaned up and the new one provisioned
+type AuthTypeChange struct {
+	// From is the auth type the access was provisioned with before the switch
+	From AuthType `json:"from"`
+
+	// To is the auth type the access was provisioned with after the switch
+	To AuthType `json:"to"`
+
+	// Time is when the access was first provisioned with the new auth type
+	Time metav1.Time `json:"time"`
+}
+
+// RotationRecord is one credential rotation of an LLMAccess
+type RotationRecord struct {
+	// Time is when the rotated credential was provisioned
+	Time metav1.Time `json:"time"`
+
+	// FromHash fingerprints the credential that was replaced ("sha256:" and the first 16
+	// hex digits of its SHA-256). Empty when llmwarden did not write it
+	// +optional
+	FromHash string `json:"fromHash,omitempty"`
+
+	// ToHash fingerprints the credential that replaced it. Empty when llmwarden does not
+	// write the credential itself, as with externalSecret auth
+	// +optional
+	ToHash string `json:"toHash,omitempty"`
+
+	// Strategy is how the credential was rotated: the provider's rotation strategy for a
+	// scheduled rotation, or keyPromotion when the next API key replaced the current one
+	Strategy string `json:"strategy"`
+}
+
+// RotationStrategyKeyPromotion is the RotationRecord strategy of a rotation that promoted
+// the provider's next API key.
+const RotationStrategyKeyPromotion = "keyPromotion"
+
+// KeyRotationStatus records the progress of a rotation from apiKey to apiKeyNext
+type KeyRotationStatus struct {
+	// NextSecretRef is the provider's next key reference the window was opened for
+	NextSecretRef SecretReference `json:"nextSecretRef"`
+
+	// WindowStart is when both keys were first provisioned
+	WindowStart metav1.Time `json:"windowStart"`
+
+	// Promoted is true once the next key has replaced apiKey in the access secret
+	// +optional
+	Promoted bool `json:"promoted,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -138,6 +494,7 @@ type LLMAccessStatus struct {
 // +kubebuilder:printcolumn:name="Provider",type=string,JSONPath=`.spec.providerRef.name`
 // +kubebuilder:printcolumn:name="Secret",type=string,JSONPath=`.spec.secretName`
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
 // +kubebuilder:printcolumn:name="Last Rotation",type=date,JSONPath=`.status.lastRotation`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
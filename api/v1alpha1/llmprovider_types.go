@@ -76,6 +76,26 @@ type LLMProviderSpec struct {
 	// +optional
 	AllowedModels []string `json:"allowedModels,omitempty"`
 
+	// AllowedModelsRef loads additional allowed models from a ConfigMap key holding a
+	// newline- or comma-separated list. The result is merged with allowedModels and
+	// published as status.allowedModels
+	// +optional
+	AllowedModelsRef *ConfigMapReference `json:"allowedModelsRef,omitempty"`
+
+	// UpstreamProviderRefs names the LLMProviders a custom gateway provider fronts. Their
+	// allowed models are merged with allowedModels (and allowedModelsRef) and published
+	// as status.allowedModels, so the gateway doesn't repeat their lists. Only valid with
+	// provider custom.
+	// +listType=set
+	// +optional
+	UpstreamProviderRefs []string `json:"upstreamProviderRefs,omitempty"`
+
+	// ModelNamespaceRules further restricts which namespaces may request specific models.
+	// A requested model must first pass allowedModels; if any rule's models match it,
+	// the requesting namespace must also match at least one of those rules' selectors.
+	// +optional
+	ModelNamespaceRules []ModelNamespaceRule `json:"modelNamespaceRules,omitempty"`
+
 	// RateLimit defines rate limiting configuration (informational/enforced by webhook)
 	// +optional
 	RateLimit *RateLimitConfig `json:"rateLimit,omitempty"`
@@ -89,6 +109,49 @@ type LLMProviderSpec struct {
 	// (e.g., for proxies or private endpoints)
 	// +optional
 	Endpoint *EndpointConfig `json:"endpoint,omitempty"`
+
+	// SecretEncryptionClass is stamped as the llmwarden.io/encryption-class annotation on
+	// every target secret for this provider, for KMS or sealed-secret tooling and admission
+	// policies to act on. An LLMAccess may override it
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^\S+$`
+	// +optional
+	SecretEncryptionClass string `json:"secretEncryptionClass,omitempty"`
+
+	// PropagatedLabels are added to every secret and ExternalSecret llmwarden manages for
+	// this provider, e.g. for cost allocation by team or cost-center. Keys under the
+	// llmwarden.io/ prefix are reserved and ignored
+	// +optional
+	PropagatedLabels map[string]string `json:"propagatedLabels,omitempty"`
+
+	// ProducedSecretKeys declares keys access secrets carry beyond the ones llmwarden
+	// provisions for the auth type (e.g. keys an ExternalSecret template or other tooling
+	// adds). Together they form the keys LLMAccess env mappings may reference as secretKey
+	// +listType=set
+	// +optional
+	ProducedSecretKeys []string `json:"producedSecretKeys,omitempty"`
+
+	// DeprecationMessage marks the provider as deprecated, e.g. because its models or
+	// endpoint are being retired. It is reported on the provider's Deprecated condition
+	// and as a health warning of every access that uses it
+	// +optional
+	DeprecationMessage string `json:"deprecationMessage,omitempty"`
+
+	// SunsetDate is when the provider is expected to be removed. Setting it also marks the
+	// provider as deprecated
+	// +optional
+	SunsetDate *metav1.Time `json:"sunsetDate,omitempty"`
+}
+
+// ModelNamespaceRule restricts models matching a pattern to a set of namespaces
+type ModelNamespaceRule struct {
+	// Models is a list of model names or glob patterns (e.g., "o1*") this rule applies to
+	// +kubebuilder:validation:MinItems=1
+	Models []string `json:"models"`
+
+	// NamespaceSelector selects the namespaces allowed to request the matching models
+	// +kubebuilder:validation:Required
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
 }
 
 // AuthConfig defines the authentication configuration
@@ -97,6 +160,13 @@ type AuthConfig struct {
 	// +kubebuilder:validation:Required
 	Type AuthType `json:"type"`
 
+	// TargetKey is the key the credential is written under in every access secret, and
+	// the default secretKey for LLMAccess env injection. Defaults to "apiKey"
+	// +kubebuilder:validation:Pattern=`^[-._a-zA-Z0-9]+$`
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	TargetKey string `json:"targetKey,omitempty"`
+
 	// APIKey configuration for direct API key authentication
 	// Required when type is "apiKey"
 	// +optional
@@ -111,6 +181,33 @@ type AuthConfig struct {
 	// Required when type is "workloadIdentity"
 	// +optional
 	WorkloadIdentity *WorkloadIdentityAuth `json:"workloadIdentity,omitempty"`
+
+	// Fallbacks are alternative strategies tried in order when this one can't be
+	// provisioned on this cluster, e.g. an apiKey fallback for a preferred workloadIdentity.
+	// Each access records the strategy it uses in status.provisionedAuthType
+	// +kubebuilder:validation:MaxItems=4
+	// +optional
+	Fallbacks []AuthFallback `json:"fallbacks,omitempty"`
+}
+
+// AuthFallback is an alternative authentication strategy. It takes the same configuration
+// as AuthConfig and shares its targetKey.
+type AuthFallback struct {
+	// Type specifies the authentication strategy to use
+	// +kubebuilder:validation:Required
+	Type AuthType `json:"type"`
+
+	// APIKey configuration, required when type is "apiKey"
+	// +optional
+	APIKey *APIKeyAuth `json:"apiKey,omitempty"`
+
+	// ExternalSecret configuration, required when type is "externalSecret"
+	// +optional
+	ExternalSecret *ExternalSecretAuth `json:"externalSecret,omitempty"`
+
+	// WorkloadIdentity configuration, required when type is "workloadIdentity"
+	// +optional
+	WorkloadIdentity *WorkloadIdentityAuth `json:"workloadIdentity,omitempty"`
 }
 
 // APIKeyAuth defines API key authentication configuration
@@ -122,6 +219,18 @@ type APIKeyAuth struct {
 	// Rotation defines credential rotation policy
 	// +optional
 	Rotation *RotationConfig `json:"rotation,omitempty"`
+
+	// NextSecretRef references the incoming API key for a zero-downtime key rotation.
+	// While set, access secrets carry it as apiKeyNext alongside apiKey for the rotation
+	// window, after which it is promoted to apiKey and apiKeyNext is removed
+	// +optional
+	NextSecretRef *SecretReference `json:"nextSecretRef,omitempty"`
+
+	// RotationWindow is how long both keys are served before the next key is promoted
+	// (e.g., "1d", "12h"). Defaults to 24h
+	// +kubebuilder:validation:Pattern=`^\d+[dhm]$`
+	// +optional
+	RotationWindow string `json:"rotationWindow,omitempty"`
 }
 
 // SecretReference defines a reference to a Kubernetes Secret
@@ -137,6 +246,26 @@ type SecretReference struct {
 	// Key within the secret that contains the API key
 	// +kubebuilder:validation:Required
 	Key string `json:"key"`
+
+	// Property is a top-level field to extract when the value under Key is a JSON
+	// object. When empty, the raw value under Key is used as the API key
+	// +optional
+	Property string `json:"property,omitempty"`
+}
+
+// ConfigMapReference defines a reference to a key in a Kubernetes ConfigMap
+type ConfigMapReference struct {
+	// Name of the ConfigMap
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap
+	// +kubebuilder:validation:Required
+	Namespace string `json:"namespace"`
+
+	// Key within the ConfigMap
+	// +kubebuilder:validation:Required
+	Key string `json:"key"`
 }
 
 // RotationConfig defines credential rotation configuration
@@ -154,6 +283,39 @@ type RotationConfig struct {
 	// +kubebuilder:default=providerAPI
 	// +optional
 	Strategy RotationStrategy `json:"strategy,omitempty"`
+
+	// AllowOverride permits LLMAccess resources to set their own rotation interval and
+	// maintenance window. Set to false to pin every access to this provider's cadence
+	// +kubebuilder:default=true
+	// +optional
+	AllowOverride *bool `json:"allowOverride,omitempty"`
+
+	// MaintenanceWindow restricts scheduled rotations to a recurring window. A rotation
+	// that comes due outside it is deferred until the window next opens
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+}
+
+// Weekday is a day of the week a maintenance window may open on
+// +kubebuilder:validation:Enum=Mon;Tue;Wed;Thu;Fri;Sat;Sun
+type Weekday string
+
+// MaintenanceWindow is a recurring window, in UTC, in which scheduled rotations may run
+type MaintenanceWindow struct {
+	// Start is the time of day the window opens, as HH:MM in UTC
+	// +kubebuilder:validation:Pattern=`^([01]\d|2[0-3]):[0-5]\d$`
+	// +kubebuilder:validation:Required
+	Start string `json:"start"`
+
+	// Duration is how long the window stays open (e.g., "2h", "30m")
+	// +kubebuilder:validation:Pattern=`^\d+[dhm]$`
+	// +kubebuilder:validation:Required
+	Duration string `json:"duration"`
+
+	// Days limits the window to opening on these days. Empty means every day
+	// +listType=set
+	// +optional
+	Days []Weekday `json:"days,omitempty"`
 }
 
 // ExternalSecretAuth defines External Secrets Operator configuration
@@ -220,6 +382,17 @@ type AWSWorkloadIdentity struct {
 	// Region is the AWS region
 	// +kubebuilder:validation:Required
 	Region string `json:"region"`
+
+	// SourceRoleArn is the role the pod authenticates as before chaining to TargetRoleArn,
+	// for Bedrock access in another account
+	// +kubebuilder:validation:Pattern=`^arn:aws:iam::\d{12}:role/.*$`
+	// +optional
+	SourceRoleArn string `json:"sourceRoleArn,omitempty"`
+
+	// TargetRoleArn is the Bedrock role assumed from SourceRoleArn via role chaining
+	// +kubebuilder:validation:Pattern=`^arn:aws:iam::\d{12}:role/.*$`
+	// +optional
+	TargetRoleArn string `json:"targetRoleArn,omitempty"`
 }
 
 // AzureWorkloadIdentity defines Azure Workload Identity configuration
@@ -264,13 +437,57 @@ type RateLimitConfig struct {
 // EndpointConfig defines endpoint configuration
 type EndpointConfig struct {
 	// BaseURL is the base URL for the provider API
-	// Empty string means use provider default
+	// Empty string means derive it from the provider type's regional template below,
+	// or use the provider default when there is no template input
 	// +optional
 	BaseURL string `json:"baseURL,omitempty"`
+
+	// Region is the cloud region used to derive the aws-bedrock base URL
+	// (https://bedrock-runtime.{region}.amazonaws.com). Defaults to
+	// auth.workloadIdentity.aws.region
+	// +kubebuilder:validation:Pattern=`^[a-z0-9-]+$`
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// ResourceName is the Azure OpenAI resource used to derive the azure-openai base URL
+	// (https://{resourceName}.openai.azure.com)
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9][-a-zA-Z0-9]*$`
+	// +optional
+	ResourceName string `json:"resourceName,omitempty"`
+
+	// CASecretRef points at a PEM CA bundle that signs the endpoint's TLS certificate, for
+	// private endpoints and proxies with an internal CA. With apiKey auth it is copied into
+	// every access secret under caCert, where injection.caCert can mount it
+	// +optional
+	CASecretRef *SecretReference `json:"caSecretRef,omitempty"`
 }
 
+// Phase is a single-word summary of a resource's conditions
+// +kubebuilder:validation:Enum=Pending;Ready;Degraded;Error
+type Phase string
+
+const (
+	// PhasePending means the resource has not been fully reconciled yet
+	PhasePending Phase = "Pending"
+	// PhaseReady means the resource is ready and all other conditions are healthy
+	PhaseReady Phase = "Ready"
+	// PhaseDegraded means the resource is partially working
+	PhaseDegraded Phase = "Degraded"
+	// PhaseError means the resource is not working
+	PhaseError Phase = "Error"
+)
+
 // LLMProviderStatus defines the observed state of LLMProvider
 type LLMProviderStatus struct {
+	// Phase summarizes the conditions as Pending, Ready, Degraded or Error
+	// +optional
+	Phase Phase `json:"phase,omitempty"`
+
+	// Ready mirrors the Ready condition's status (true only when it is True) for
+	// automation that cannot easily query the conditions list
+	// +optional
+	Ready bool `json:"ready"`
+
 	// Conditions represent the current state of the LLMProvider resource
 	// +listType=map
 	// +listMapKey=type
@@ -284,6 +501,27 @@ type LLMProviderStatus struct {
 	// AccessCount is the number of LLMAccess resources referencing this provider
 	// +optional
 	AccessCount int32 `json:"accessCount,omitempty"`
+
+	// AllowedModels is the resolved allowlist: spec.allowedModels merged with the
+	// models loaded from spec.allowedModelsRef and the upstream providers. Only set when
+	// allowedModelsRef or upstreamProviderRefs is set
+	// +optional
+	AllowedModels []string `json:"allowedModels,omitempty"`
+
+	// ValidatedSecret records the apiKey source secret version that last passed
+	// validation. While the secret and the provider are unchanged, periodic reconciles
+	// reuse the result instead of re-validating the secret's contents
+	// +optional
+	ValidatedSecret *ValidatedSecret `json:"validatedSecret,omitempty"`
+}
+
+// ValidatedSecret identifies the source secret version an LLMProvider last validated
+type ValidatedSecret struct {
+	// ResourceVersion is the source secret's resourceVersion when it was validated
+	ResourceVersion string `json:"resourceVersion"`
+
+	// Generation is the provider's generation when the secret was validated
+	Generation int64 `json:"generation"`
 }
 
 // +kubebuilder:object:root=true
@@ -293,6 +531,7 @@ type LLMProviderStatus struct {
 // +kubebuilder:printcolumn:name="Auth Type",type=string,JSONPath=`.spec.auth.type`
 // +kubebuilder:printcolumn:name="Access Count",type=integer,JSONPath=`.status.accessCount`
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // LLMProvider is the Schema for the llmproviders API.
@@ -0,0 +1,116 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LLMWardenStatusName is the name of the singleton LLMWardenStatus the operator writes.
+const LLMWardenStatusName = "cluster"
+
+// ResourceSummary counts resources of one kind by phase
+type ResourceSummary struct {
+	// Total is the number of resources
+	Total int32 `json:"total"`
+
+	// Ready is the number of resources in the Ready phase
+	Ready int32 `json:"ready"`
+
+	// Degraded is the number of resources in the Degraded phase
+	Degraded int32 `json:"degraded"`
+
+	// Failing is the number of resources in the Error phase
+	Failing int32 `json:"failing"`
+
+	// Pending is the number of resources in the Pending phase, or without a phase yet
+	Pending int32 `json:"pending"`
+}
+
+// LLMWardenStatusSpec is empty; the resource is written by the operator
+type LLMWardenStatusSpec struct{}
+
+// LLMWardenStatusStatus is the fleet-wide summary computed by the operator
+type LLMWardenStatusStatus struct {
+	// LastUpdated is when the operator last computed the summary
+	// +optional
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+
+	// Providers counts LLMProvider and NamespacedLLMProvider resources
+	// +optional
+	Providers ResourceSummary `json:"providers"`
+
+	// Accesses counts LLMAccess resources
+	// +optional
+	Accesses ResourceSummary `json:"accesses"`
+
+	// ManagedSecrets is the number of Secrets the operator provisioned and manages
+	// +optional
+	ManagedSecrets int32 `json:"managedSecrets"`
+
+	// ProvidersByAuthType counts providers by spec.auth.type
+	// +optional
+	ProvidersByAuthType map[AuthType]int32 `json:"providersByAuthType,omitempty"`
+
+	// AccessesByAuthType counts accesses by the auth type their credentials were last
+	// provisioned with. Accesses that were never provisioned are not counted
+	// +optional
+	AccessesByAuthType map[AuthType]int32 `json:"accessesByAuthType,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=llmws
+// +kubebuilder:printcolumn:name="Providers",type=integer,JSONPath=`.status.providers.total`
+// +kubebuilder:printcolumn:name="Accesses",type=integer,JSONPath=`.status.accesses.total`
+// +kubebuilder:printcolumn:name="Ready Accesses",type=integer,JSONPath=`.status.accesses.ready`
+// +kubebuilder:printcolumn:name="Failing Accesses",type=integer,JSONPath=`.status.accesses.failing`
+// +kubebuilder:printcolumn:name="Secrets",type=integer,JSONPath=`.status.managedSecrets`
+// +kubebuilder:printcolumn:name="Updated",type=date,JSONPath=`.status.lastUpdated`
+
+// LLMWardenStatus is the Schema for the llmwardenstatuses API.
+// It is a cluster-wide singleton named "cluster" that the operator periodically updates
+// with aggregate provider, access and secret counts, so dashboards and CLIs can read one
+// object instead of listing every resource.
+type LLMWardenStatus struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec is unused; the resource is written by the operator
+	// +optional
+	Spec LLMWardenStatusSpec `json:"spec,omitempty"`
+
+	// status is the summary computed by the operator
+	// +optional
+	Status LLMWardenStatusStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LLMWardenStatusList contains a list of LLMWardenStatus
+type LLMWardenStatusList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LLMWardenStatus `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LLMWardenStatus{}, &LLMWardenStatusList{})
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=nllmp
+// +kubebuilder:printcolumn:name="Provider",type=string,JSONPath=`.spec.provider`
+// +kubebuilder:printcolumn:name="Auth Type",type=string,JSONPath=`.spec.auth.type`
+// +kubebuilder:printcolumn:name="Access Count",type=integer,JSONPath=`.status.accessCount`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// NamespacedLLMProvider is the Schema for the namespacedllmproviders API.
+// It is an LLMProvider that tenants can define without cluster-admin: only LLMAccess
+// resources in its own namespace can reference it, and every secret and ConfigMap it
+// references must live in that namespace.
+type NamespacedLLMProvider struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec defines the desired state of NamespacedLLMProvider
+	// +required
+	Spec LLMProviderSpec `json:"spec"`
+
+	// status defines the observed state of NamespacedLLMProvider
+	// +optional
+	Status LLMProviderStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NamespacedLLMProviderList contains a list of NamespacedLLMProvider
+type NamespacedLLMProviderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NamespacedLLMProvider `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NamespacedLLMProvider{}, &NamespacedLLMProviderList{})
+}
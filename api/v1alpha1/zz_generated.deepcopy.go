@@ -33,6 +33,11 @@ func (in *APIKeyAuth) DeepCopyInto(out *APIKeyAuth) {
 	if in.Rotation != nil {
 		in, out := &in.Rotation, &out.Rotation
 		*out = new(RotationConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NextSecretRef != nil {
+		in, out := &in.NextSecretRef, &out.NextSecretRef
+		*out = new(SecretReference)
 		**out = **in
 	}
 }
@@ -65,6 +70,11 @@ func (in *AWSWorkloadIdentity) DeepCopy() *AWSWorkloadIdentity {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccessRotationConfig) DeepCopyInto(out *AccessRotationConfig) {
 	*out = *in
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessRotationConfig.
@@ -77,6 +87,21 @@ func (in *AccessRotationConfig) DeepCopy() *AccessRotationConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AttributionInjection) DeepCopyInto(out *AttributionInjection) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AttributionInjection.
+func (in *AttributionInjection) DeepCopy() *AttributionInjection {
+	if in == nil {
+		return nil
+	}
+	out := new(AttributionInjection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthConfig) DeepCopyInto(out *AuthConfig) {
 	*out = *in
@@ -95,6 +120,13 @@ func (in *AuthConfig) DeepCopyInto(out *AuthConfig) {
 		*out = new(WorkloadIdentityAuth)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Fallbacks != nil {
+		in, out := &in.Fallbacks, &out.Fallbacks
+		*out = make([]AuthFallback, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthConfig.
@@ -107,6 +139,52 @@ func (in *AuthConfig) DeepCopy() *AuthConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthFallback) DeepCopyInto(out *AuthFallback) {
+	*out = *in
+	if in.APIKey != nil {
+		in, out := &in.APIKey, &out.APIKey
+		*out = new(APIKeyAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalSecret != nil {
+		in, out := &in.ExternalSecret, &out.ExternalSecret
+		*out = new(ExternalSecretAuth)
+		**out = **in
+	}
+	if in.WorkloadIdentity != nil {
+		in, out := &in.WorkloadIdentity, &out.WorkloadIdentity
+		*out = new(WorkloadIdentityAuth)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthFallback.
+func (in *AuthFallback) DeepCopy() *AuthFallback {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthFallback)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthTypeChange) DeepCopyInto(out *AuthTypeChange) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthTypeChange.
+func (in *AuthTypeChange) DeepCopy() *AuthTypeChange {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthTypeChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureWorkloadIdentity) DeepCopyInto(out *AzureWorkloadIdentity) {
 	*out = *in
@@ -122,9 +200,44 @@ func (in *AzureWorkloadIdentity) DeepCopy() *AzureWorkloadIdentity {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CACertInjection) DeepCopyInto(out *CACertInjection) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CACertInjection.
+func (in *CACertInjection) DeepCopy() *CACertInjection {
+	if in == nil {
+		return nil
+	}
+	out := new(CACertInjection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapReference) DeepCopyInto(out *ConfigMapReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapReference.
+func (in *ConfigMapReference) DeepCopy() *ConfigMapReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EndpointConfig) DeepCopyInto(out *EndpointConfig) {
 	*out = *in
+	if in.CASecretRef != nil {
+		in, out := &in.CASecretRef, &out.CASecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointConfig.
@@ -137,6 +250,21 @@ func (in *EndpointConfig) DeepCopy() *EndpointConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvFileInjection) DeepCopyInto(out *EnvFileInjection) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvFileInjection.
+func (in *EnvFileInjection) DeepCopy() *EnvFileInjection {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvFileInjection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnvVarMapping) DeepCopyInto(out *EnvVarMapping) {
 	*out = *in
@@ -192,9 +320,39 @@ func (in *InjectionConfig) DeepCopyInto(out *InjectionConfig) {
 		*out = make([]EnvVarMapping, len(*in))
 		copy(*out, *in)
 	}
+	if in.InitContainers != nil {
+		in, out := &in.InitContainers, &out.InitContainers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Volume != nil {
 		in, out := &in.Volume, &out.Volume
 		*out = new(VolumeInjection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnvFile != nil {
+		in, out := &in.EnvFile, &out.EnvFile
+		*out = new(EnvFileInjection)
+		**out = **in
+	}
+	if in.UsageSidecar != nil {
+		in, out := &in.UsageSidecar, &out.UsageSidecar
+		*out = new(UsageSidecarConfig)
+		**out = **in
+	}
+	if in.LazyProvisioning != nil {
+		in, out := &in.LazyProvisioning, &out.LazyProvisioning
+		*out = new(LazyProvisioningConfig)
+		**out = **in
+	}
+	if in.CACert != nil {
+		in, out := &in.CACert, &out.CACert
+		*out = new(CACertInjection)
+		**out = **in
+	}
+	if in.Attribution != nil {
+		in, out := &in.Attribution, &out.Attribution
+		*out = new(AttributionInjection)
 		**out = **in
 	}
 }
@@ -209,6 +367,23 @@ func (in *InjectionConfig) DeepCopy() *InjectionConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyRotationStatus) DeepCopyInto(out *KeyRotationStatus) {
+	*out = *in
+	out.NextSecretRef = in.NextSecretRef
+	in.WindowStart.DeepCopyInto(&out.WindowStart)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyRotationStatus.
+func (in *KeyRotationStatus) DeepCopy() *KeyRotationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyRotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LLMAccess) DeepCopyInto(out *LLMAccess) {
 	*out = *in
@@ -282,11 +457,23 @@ func (in *LLMAccessSpec) DeepCopyInto(out *LLMAccessSpec) {
 		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ServiceAccountSelector != nil {
+		in, out := &in.ServiceAccountSelector, &out.ServiceAccountSelector
+		*out = new(ServiceAccountSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AnnotationSelector != nil {
+		in, out := &in.AnnotationSelector, &out.AnnotationSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	in.Injection.DeepCopyInto(&out.Injection)
 	if in.Rotation != nil {
 		in, out := &in.Rotation, &out.Rotation
 		*out = new(AccessRotationConfig)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -315,19 +502,59 @@ func (in *LLMAccessStatus) DeepCopyInto(out *LLMAccessStatus) {
 		*out = new(corev1.ObjectReference)
 		**out = **in
 	}
+	if in.SourceSecretRef != nil {
+		in, out := &in.SourceSecretRef, &out.SourceSecretRef
+		*out = new(corev1.ObjectReference)
+		**out = **in
+	}
+	if in.LastAuthTypeChange != nil {
+		in, out := &in.LastAuthTypeChange, &out.LastAuthTypeChange
+		*out = new(AuthTypeChange)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResolvedStore != nil {
+		in, out := &in.ResolvedStore, &out.ResolvedStore
+		*out = new(StoreReference)
+		**out = **in
+	}
 	if in.LastRotation != nil {
 		in, out := &in.LastRotation, &out.LastRotation
 		*out = (*in).DeepCopy()
 	}
+	if in.RotationHistory != nil {
+		in, out := &in.RotationHistory, &out.RotationHistory
+		*out = make([]RotationRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.KeyRotation != nil {
+		in, out := &in.KeyRotation, &out.KeyRotation
+		*out = new(KeyRotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.NextRotation != nil {
 		in, out := &in.NextRotation, &out.NextRotation
 		*out = (*in).DeepCopy()
 	}
+	if in.IdleSince != nil {
+		in, out := &in.IdleSince, &out.IdleSince
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
 	if in.ProvisionedModels != nil {
 		in, out := &in.ProvisionedModels, &out.ProvisionedModels
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ValidatedGenerations != nil {
+		in, out := &in.ValidatedGenerations, &out.ValidatedGenerations
+		*out = new(ValidatedGenerations)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LLMAccessStatus.
@@ -408,6 +635,23 @@ func (in *LLMProviderSpec) DeepCopyInto(out *LLMProviderSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AllowedModelsRef != nil {
+		in, out := &in.AllowedModelsRef, &out.AllowedModelsRef
+		*out = new(ConfigMapReference)
+		**out = **in
+	}
+	if in.UpstreamProviderRefs != nil {
+		in, out := &in.UpstreamProviderRefs, &out.UpstreamProviderRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ModelNamespaceRules != nil {
+		in, out := &in.ModelNamespaceRules, &out.ModelNamespaceRules
+		*out = make([]ModelNamespaceRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.RateLimit != nil {
 		in, out := &in.RateLimit, &out.RateLimit
 		*out = new(RateLimitConfig)
@@ -421,7 +665,23 @@ func (in *LLMProviderSpec) DeepCopyInto(out *LLMProviderSpec) {
 	if in.Endpoint != nil {
 		in, out := &in.Endpoint, &out.Endpoint
 		*out = new(EndpointConfig)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PropagatedLabels != nil {
+		in, out := &in.PropagatedLabels, &out.PropagatedLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ProducedSecretKeys != nil {
+		in, out := &in.ProducedSecretKeys, &out.ProducedSecretKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SunsetDate != nil {
+		in, out := &in.SunsetDate, &out.SunsetDate
+		*out = (*in).DeepCopy()
 	}
 }
 
@@ -449,6 +709,16 @@ func (in *LLMProviderStatus) DeepCopyInto(out *LLMProviderStatus) {
 		in, out := &in.LastCredentialCheck, &out.LastCredentialCheck
 		*out = (*in).DeepCopy()
 	}
+	if in.AllowedModels != nil {
+		in, out := &in.AllowedModels, &out.AllowedModels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ValidatedSecret != nil {
+		in, out := &in.ValidatedSecret, &out.ValidatedSecret
+		*out = new(ValidatedSecret)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LLMProviderStatus.
@@ -461,6 +731,230 @@ func (in *LLMProviderStatus) DeepCopy() *LLMProviderStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LLMWardenStatus) DeepCopyInto(out *LLMWardenStatus) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LLMWardenStatus.
+func (in *LLMWardenStatus) DeepCopy() *LLMWardenStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LLMWardenStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LLMWardenStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LLMWardenStatusList) DeepCopyInto(out *LLMWardenStatusList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LLMWardenStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LLMWardenStatusList.
+func (in *LLMWardenStatusList) DeepCopy() *LLMWardenStatusList {
+	if in == nil {
+		return nil
+	}
+	out := new(LLMWardenStatusList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LLMWardenStatusList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LLMWardenStatusSpec) DeepCopyInto(out *LLMWardenStatusSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LLMWardenStatusSpec.
+func (in *LLMWardenStatusSpec) DeepCopy() *LLMWardenStatusSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LLMWardenStatusSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LLMWardenStatusStatus) DeepCopyInto(out *LLMWardenStatusStatus) {
+	*out = *in
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+	out.Providers = in.Providers
+	out.Accesses = in.Accesses
+	if in.ProvidersByAuthType != nil {
+		in, out := &in.ProvidersByAuthType, &out.ProvidersByAuthType
+		*out = make(map[AuthType]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AccessesByAuthType != nil {
+		in, out := &in.AccessesByAuthType, &out.AccessesByAuthType
+		*out = make(map[AuthType]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LLMWardenStatusStatus.
+func (in *LLMWardenStatusStatus) DeepCopy() *LLMWardenStatusStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LLMWardenStatusStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LazyProvisioningConfig) DeepCopyInto(out *LazyProvisioningConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LazyProvisioningConfig.
+func (in *LazyProvisioningConfig) DeepCopy() *LazyProvisioningConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LazyProvisioningConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]Weekday, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelNamespaceRule) DeepCopyInto(out *ModelNamespaceRule) {
+	*out = *in
+	if in.Models != nil {
+		in, out := &in.Models, &out.Models
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelNamespaceRule.
+func (in *ModelNamespaceRule) DeepCopy() *ModelNamespaceRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelNamespaceRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedLLMProvider) DeepCopyInto(out *NamespacedLLMProvider) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedLLMProvider.
+func (in *NamespacedLLMProvider) DeepCopy() *NamespacedLLMProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedLLMProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NamespacedLLMProvider) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedLLMProviderList) DeepCopyInto(out *NamespacedLLMProviderList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NamespacedLLMProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedLLMProviderList.
+func (in *NamespacedLLMProviderList) DeepCopy() *NamespacedLLMProviderList {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedLLMProviderList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NamespacedLLMProviderList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderReference) DeepCopyInto(out *ProviderReference) {
 	*out = *in
@@ -516,9 +1010,34 @@ func (in *RemoteReference) DeepCopy() *RemoteReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceSummary) DeepCopyInto(out *ResourceSummary) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceSummary.
+func (in *ResourceSummary) DeepCopy() *ResourceSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RotationConfig) DeepCopyInto(out *RotationConfig) {
 	*out = *in
+	if in.AllowOverride != nil {
+		in, out := &in.AllowOverride, &out.AllowOverride
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RotationConfig.
@@ -531,6 +1050,22 @@ func (in *RotationConfig) DeepCopy() *RotationConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RotationRecord) DeepCopyInto(out *RotationRecord) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RotationRecord.
+func (in *RotationRecord) DeepCopy() *RotationRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(RotationRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretReference) DeepCopyInto(out *SecretReference) {
 	*out = *in
@@ -546,6 +1081,26 @@ func (in *SecretReference) DeepCopy() *SecretReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountSelector) DeepCopyInto(out *ServiceAccountSelector) {
+	*out = *in
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountSelector.
+func (in *ServiceAccountSelector) DeepCopy() *ServiceAccountSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StoreReference) DeepCopyInto(out *StoreReference) {
 	*out = *in
@@ -561,9 +1116,59 @@ func (in *StoreReference) DeepCopy() *StoreReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UsageSidecarConfig) DeepCopyInto(out *UsageSidecarConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UsageSidecarConfig.
+func (in *UsageSidecarConfig) DeepCopy() *UsageSidecarConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(UsageSidecarConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidatedGenerations) DeepCopyInto(out *ValidatedGenerations) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidatedGenerations.
+func (in *ValidatedGenerations) DeepCopy() *ValidatedGenerations {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidatedGenerations)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidatedSecret) DeepCopyInto(out *ValidatedSecret) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidatedSecret.
+func (in *ValidatedSecret) DeepCopy() *ValidatedSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidatedSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeInjection) DeepCopyInto(out *VolumeInjection) {
 	*out = *in
+	if in.DefaultMode != nil {
+		in, out := &in.DefaultMode, &out.DefaultMode
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeInjection.
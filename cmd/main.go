@@ -17,18 +17,29 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"flag"
+	"fmt"
+	"io"
+	"net/http"
 	"os"
+	"slices"
+	"strings"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
@@ -36,10 +47,21 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/backlog"
+	"github.com/llmwarden/llmwarden/internal/bypass"
 	"github.com/llmwarden/llmwarden/internal/controller"
+	"github.com/llmwarden/llmwarden/internal/credcopy"
+	"github.com/llmwarden/llmwarden/internal/debug"
 	"github.com/llmwarden/llmwarden/internal/eso"
-	_ "github.com/llmwarden/llmwarden/internal/metrics" // Import to register metrics
+	"github.com/llmwarden/llmwarden/internal/health"
+	"github.com/llmwarden/llmwarden/internal/labelkeys"
+	"github.com/llmwarden/llmwarden/internal/metrics"
+	"github.com/llmwarden/llmwarden/internal/notify"
 	"github.com/llmwarden/llmwarden/internal/provisioner"
+	"github.com/llmwarden/llmwarden/internal/summary"
+	"github.com/llmwarden/llmwarden/internal/tracing"
+	"github.com/llmwarden/llmwarden/internal/usage"
+	"github.com/llmwarden/llmwarden/internal/version"
 	webhookv1alpha1 "github.com/llmwarden/llmwarden/internal/webhook/v1alpha1"
 	// +kubebuilder:scaffold:imports
 )
@@ -58,6 +80,16 @@ func init() {
 
 // nolint:gocyclo
 func main() {
+	// The init containers the pod injector adds to workload pods run this binary to copy
+	// their credentials.
+	if len(os.Args) > 1 && os.Args[1] == credcopy.Command {
+		if err := credcopy.Run(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	var metricsAddr string
 	var metricsCertPath, metricsCertName, metricsCertKey string
 	var webhookCertPath, webhookCertName, webhookCertKey string
@@ -65,6 +97,30 @@ func main() {
 	var probeAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var enableAccessDebugEndpoint bool
+	var cleanupInjectedAnnotations bool
+	var exportProvisionResult bool
+	var usageScrapeInterval time.Duration
+	var uninjectedPodCheckInterval time.Duration
+	var statusSummaryInterval time.Duration
+	var reconcileBacklogInterval time.Duration
+	var providerChangeDebounce time.Duration
+	var enableLazyProvisioning bool
+	var maxInjectedEnvVars int
+	var maxInjectedVolumes int
+	var watchNamespacesFlag string
+	var rotationNotifyURL string
+	var credentialCopyImage string
+	var allowedSecretStoreKindsFlag string
+	var printExternalSecretRef string
+	var printRecordingRules bool
+	var tracingEndpoint string
+	var tracingInsecure bool
+	var accessFinalizer string
+	var disableAccessFinalizer bool
+	var matchWorkflowOwnerLabels bool
+	var validateExternalSecrets bool
+	var labelPrefix string
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -83,6 +139,76 @@ func main() {
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.BoolVar(&enableAccessDebugEndpoint, "enable-access-debug-endpoint", false,
+		"If set, serve read-only LLMAccess summaries at "+debug.AccessPath+"{namespace}/{name} on the metrics server.")
+	flag.BoolVar(&cleanupInjectedAnnotations, "cleanup-injected-annotations", false,
+		"If set, remove a deleted LLMAccess's provider from the injected-providers annotation of matching pods.")
+	flag.BoolVar(&exportProvisionResult, "export-provision-result", false,
+		"If set, record each LLMAccess's last provisioning result (secret name, keys, timestamps, metadata; "+
+			"never credentials) as JSON in its llmwarden.io/provision-result annotation.")
+	flag.DurationVar(&usageScrapeInterval, "usage-scrape-interval", time.Minute,
+		"How often to scrape usage sidecars for request and token counts. Set to 0 to disable scraping.")
+	flag.DurationVar(&uninjectedPodCheckInterval, "uninjected-pod-check-interval", time.Minute,
+		"How often to count pods matching an LLMAccess that the pod injector did not inject "+
+			"(llmwarden_uninjected_matching_pods). Set to 0 to disable the check.")
+	flag.IntVar(&maxInjectedEnvVars, "max-injected-env-vars", 0,
+		"Maximum number of env vars the pod injector adds to one pod across all matching LLMAccess resources. "+
+			"Accesses that would exceed it are not injected. 0 means unlimited.")
+	flag.IntVar(&maxInjectedVolumes, "max-injected-volumes", 0,
+		"Maximum number of volumes the pod injector adds to one pod across all matching LLMAccess resources. "+
+			"Accesses that would exceed it are not injected. 0 means unlimited.")
+	flag.DurationVar(&reconcileBacklogInterval, "reconcile-backlog-interval", time.Minute,
+		"How often to count objects whose current generation has not been reconciled yet "+
+			"(llmwarden_reconcile_backlog, llmwarden_reconcile_backlog_oldest_seconds). Set to 0 to disable the sweep.")
+	flag.DurationVar(&providerChangeDebounce, "provider-change-debounce", 2*time.Second,
+		"Coalesce the LLMAccess reconciles a provider change triggers: each dependent access is enqueued "+
+			"once this long after the first change, however many follow. Set to 0 to enqueue on every change.")
+	flag.BoolVar(&enableLazyProvisioning, "enable-lazy-provisioning", false,
+		"If set, honour LLMAccess injection.lazyProvisioning by watching pod metadata. "+
+			"Without it no pods are watched and such accesses are provisioned eagerly.")
+	flag.DurationVar(&statusSummaryInterval, "status-summary-interval", time.Minute,
+		"How often to update the cluster-wide LLMWardenStatus \"cluster\" with provider, access and "+
+			"secret counts. Set to 0 to disable the summary.")
+	flag.StringVar(&watchNamespacesFlag, "watch-namespaces", "",
+		"Comma-separated namespaces to reconcile LLMAccess resources and inject pods in. "+
+			"Empty watches all namespaces. Must include the namespaces holding provider secrets.")
+	flag.StringVar(&rotationNotifyURL, "rotation-notify-url", "",
+		"If set, POST a JSON notification (access, namespace, provider, rotatedAt; never the credential) "+
+			"to this URL after each successful credential rotation.")
+	flag.StringVar(&credentialCopyImage, "credential-copy-image", "",
+		"Image of the init containers that copy credentials into memory-medium volumes and write merged env files. "+
+			"Must be the llmwarden image, whose manager binary does the copy; the Helm chart sets it to the image it deploys. "+
+			"Empty mounts the secret volume directly and injects env file mappings as env vars instead.")
+	flag.StringVar(&allowedSecretStoreKindsFlag, "allowed-secret-store-kinds", "SecretStore,ClusterSecretStore",
+		"Comma-separated ESO store kinds LLMProviders may reference with externalSecret auth. "+
+			"Set to ClusterSecretStore to mandate centrally managed stores.")
+	flag.StringVar(&printExternalSecretRef, "print-externalsecret", "",
+		"If set to <namespace>/<name> of an LLMAccess, print the ExternalSecret the controller would apply "+
+			"for it as YAML and exit without applying anything or starting the manager.")
+	flag.BoolVar(&printRecordingRules, "print-recording-rules", false,
+		"If set, print Prometheus recording rules for llmwarden's metrics as a YAML rule file and exit "+
+			"without starting the manager.")
+	flag.StringVar(&tracingEndpoint, "tracing-endpoint", "",
+		"If set, export OpenTelemetry traces of LLMAccess reconciles and pod admission to this OTLP/gRPC "+
+			"collector (host:port). Empty disables tracing.")
+	flag.BoolVar(&tracingInsecure, "tracing-insecure", false,
+		"If set, connect to the --tracing-endpoint collector without TLS.")
+	flag.StringVar(&accessFinalizer, "access-finalizer", "llmwarden.io/finalizer",
+		"Finalizer added to LLMAccess resources to clean up on deletion. Accesses carrying "+
+			"llmwarden.io/finalizer are migrated to it.")
+	flag.BoolVar(&disableAccessFinalizer, "disable-access-finalizer", false,
+		"If set, add no finalizer to LLMAccess resources and rely on owner references to delete their "+
+			"Secrets and ExternalSecrets, so deletion never waits for the operator. Existing finalizers are removed.")
+	flag.BoolVar(&matchWorkflowOwnerLabels, "match-workflow-owner-labels", false,
+		"If set, workload selectors also match the labels of the Argo Workflow or Tekton TaskRun that owns "+
+			"a pod. Costs one apiserver read per admitted workflow pod.")
+	flag.BoolVar(&validateExternalSecrets, "validate-externalsecrets", false,
+		"If set, validate each new ExternalSecret with a server-side dry-run create before creating it, and "+
+			"report schema or admission errors as an ExternalSecretRejected condition on the LLMAccess.")
+	flag.StringVar(&labelPrefix, "label-prefix", labelkeys.DefaultPrefix,
+		"Prefix of every label and annotation key llmwarden reads or writes, on managed Secrets and "+
+			"ExternalSecrets, LLMAccess resources, namespaces and pods. Give each llmwarden installation "+
+			"sharing a cluster its own prefix.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -91,6 +217,36 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	if err := labelkeys.SetPrefix(labelPrefix); err != nil {
+		setupLog.Error(err, "unable to set the label prefix")
+		os.Exit(1)
+	}
+
+	if printRecordingRules {
+		if err := metrics.WriteRecordingRules(os.Stdout); err != nil {
+			setupLog.Error(err, "unable to print recording rules")
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	if printExternalSecretRef != "" {
+		if err := printExternalSecret(context.Background(), printExternalSecretRef, os.Stdout); err != nil {
+			setupLog.Error(err, "unable to print ExternalSecret", "access", printExternalSecretRef)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	shutdownTracing, err := tracing.Setup(context.Background(), tracingEndpoint, tracingInsecure)
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing")
+		os.Exit(1)
+	}
+	if tracingEndpoint != "" {
+		setupLog.Info("exporting traces", "endpoint", tracingEndpoint)
+	}
+
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
 	// prevent from being vulnerable to the HTTP/2 Stream Cancellation and
@@ -167,8 +323,33 @@ func main() {
 		metricsServerOptions.KeyName = metricsCertKey
 	}
 
+	if errs := validation.IsQualifiedName(accessFinalizer); len(errs) > 0 || !strings.Contains(accessFinalizer, "/") {
+		setupLog.Error(fmt.Errorf("must be a domain-qualified name such as example.com/finalizer: %s",
+			strings.Join(errs, "; ")), "invalid --access-finalizer", "finalizer", accessFinalizer)
+		os.Exit(1)
+	}
+
+	allowedSecretStoreKinds, err := parseSecretStoreKinds(allowedSecretStoreKindsFlag)
+	if err != nil {
+		setupLog.Error(err, "invalid --allowed-secret-store-kinds")
+		os.Exit(1)
+	}
+
+	// Restrict the cache to the watched namespaces. Cluster-scoped objects such as
+	// LLMProvider and Namespace are still cached cluster-wide.
+	watchNamespaces := parseWatchNamespaces(watchNamespacesFlag)
+	var cacheOptions cache.Options
+	if len(watchNamespaces) > 0 {
+		setupLog.Info("Restricting watches to namespaces", "namespaces", watchNamespaces)
+		cacheOptions.DefaultNamespaces = make(map[string]cache.Config, len(watchNamespaces))
+		for _, ns := range watchNamespaces {
+			cacheOptions.DefaultNamespaces[ns] = cache.Config{}
+		}
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                 scheme,
+		Cache:                  cacheOptions,
 		Metrics:                metricsServerOptions,
 		WebhookServer:          webhookServer,
 		HealthProbeBindAddress: probeAddr,
@@ -199,42 +380,118 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "LLMProvider")
 		os.Exit(1)
 	}
-	// Select the ESO adapter version. Default to v1 (ESO v0.17+).
-	// Set ESO_API_VERSION=v1beta1 if running against an older ESO installation.
-	esoAdapter := eso.Adapter(eso.NewV1Adapter())
-	if os.Getenv("ESO_API_VERSION") == "v1beta1" {
-		setupLog.Info("Using ESO v1beta1 adapter (set ESO_API_VERSION=v1 to use the current API)")
-		esoAdapter = eso.NewV1Beta1Adapter()
+	if err := (&controller.NamespacedLLMProviderReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("namespacedllmprovider-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "NamespacedLLMProvider")
+		os.Exit(1)
 	}
+	esoAdapter := selectESOAdapter()
+
+	var rotationNotifier *notify.RotationNotifier
+	if rotationNotifyURL != "" {
+		rotationNotifier = &notify.RotationNotifier{
+			URL:        rotationNotifyURL,
+			HTTPClient: &http.Client{Timeout: 5 * time.Second},
+		}
+	}
+
+	externalSecretProvisioner := provisioner.NewExternalSecretProvisioner(mgr.GetClient(), mgr.GetScheme(), esoAdapter)
+	externalSecretProvisioner.DryRunValidation = validateExternalSecrets
+	apiKeyProvisioner := provisioner.NewApiKeyProvisioner(mgr.GetClient(), mgr.GetScheme())
+	apiKeyProvisioner.PodReader = mgr.GetAPIReader()
 
 	if err := (&controller.LLMAccessReconciler{
-		Client:            mgr.GetClient(),
-		Scheme:            mgr.GetScheme(),
-		Recorder:          mgr.GetEventRecorderFor("llmaccess-controller"),
-		ApiKeyProvisioner: provisioner.NewApiKeyProvisioner(mgr.GetClient(), mgr.GetScheme()),
-		ExternalSecretProvisioner: provisioner.NewExternalSecretProvisioner(
-			mgr.GetClient(),
-			mgr.GetScheme(),
-			esoAdapter,
-		),
+		Client:                     mgr.GetClient(),
+		Scheme:                     mgr.GetScheme(),
+		Recorder:                   mgr.GetEventRecorderFor("llmaccess-controller"),
+		ApiKeyProvisioner:          apiKeyProvisioner,
+		ExternalSecretProvisioner:  externalSecretProvisioner,
+		CleanupInjectedAnnotations: cleanupInjectedAnnotations,
+		RotationNotifier:           rotationNotifier,
+		ExportProvisionResult:      exportProvisionResult,
+		Finalizer:                  accessFinalizer,
+		DisableFinalizer:           disableAccessFinalizer,
+		ProviderChangeDebounce:     providerChangeDebounce,
+		LazyProvisioning:           enableLazyProvisioning,
+		PodReader:                  mgr.GetAPIReader(),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "LLMAccess")
 		os.Exit(1)
 	}
 	// nolint:goconst
 	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
-		if err := webhookv1alpha1.SetupLLMAccessWebhookWithManager(mgr); err != nil {
+		if err := webhookv1alpha1.SetupLLMAccessWebhookWithManager(mgr, watchNamespaces); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "LLMAccess")
 			os.Exit(1)
 		}
+		if err := webhookv1alpha1.SetupLLMProviderWebhookWithManager(mgr, allowedSecretStoreKinds); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "LLMProvider")
+			os.Exit(1)
+		}
 		// Register pod injector webhook
-		if err := webhookv1alpha1.SetupPodInjectorWebhookWithManager(mgr); err != nil {
+		if err := webhookv1alpha1.SetupPodInjectorWebhookWithManager(mgr, watchNamespaces, credentialCopyImage,
+			webhookv1alpha1.InjectionLimits{EnvVars: maxInjectedEnvVars, Volumes: maxInjectedVolumes},
+			matchWorkflowOwnerLabels); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "PodInjector")
 			os.Exit(1)
 		}
 	}
 	// +kubebuilder:scaffold:builder
 
+	if enableAccessDebugEndpoint {
+		// Served behind the same authn/authz filter as /metrics when --metrics-secure is set.
+		if err := mgr.AddMetricsServerExtraHandler(debug.AccessPath, &debug.AccessHandler{Reader: mgr.GetClient()}); err != nil {
+			setupLog.Error(err, "unable to register access debug endpoint")
+			os.Exit(1)
+		}
+	}
+
+	if usageScrapeInterval > 0 {
+		if err := mgr.Add(&usage.Scraper{
+			Client:     mgr.GetClient(),
+			PodReader:  mgr.GetAPIReader(),
+			HTTPClient: &http.Client{Timeout: 5 * time.Second},
+			Interval:   usageScrapeInterval,
+		}); err != nil {
+			setupLog.Error(err, "unable to set up usage scraper")
+			os.Exit(1)
+		}
+	}
+
+	if uninjectedPodCheckInterval > 0 {
+		if err := mgr.Add(&bypass.Detector{
+			Client:    mgr.GetClient(),
+			PodReader: mgr.GetAPIReader(),
+			Interval:  uninjectedPodCheckInterval,
+		}); err != nil {
+			setupLog.Error(err, "unable to set up uninjected pod detector")
+			os.Exit(1)
+		}
+	}
+
+	if reconcileBacklogInterval > 0 {
+		if err := mgr.Add(&backlog.Monitor{
+			Client:   mgr.GetClient(),
+			Interval: reconcileBacklogInterval,
+		}); err != nil {
+			setupLog.Error(err, "unable to set up reconcile backlog monitor")
+			os.Exit(1)
+		}
+	}
+
+	if statusSummaryInterval > 0 {
+		if err := mgr.Add(&summary.Writer{
+			Client:   mgr.GetClient(),
+			Interval: statusSummaryInterval,
+		}); err != nil {
+			setupLog.Error(err, "unable to set up status summary writer")
+			os.Exit(1)
+		}
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
@@ -243,10 +500,101 @@ func main() {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
+	esoCheck := &health.ExternalSecretCheck{
+		Reader: mgr.GetClient(),
+		Mapper: mgr.GetRESTMapper(),
+		GVK:    esoAdapter.GVK(),
+	}
+	if err := mgr.AddReadyzCheck("external-secrets", esoCheck.Check); err != nil {
+		setupLog.Error(err, "unable to set up external-secrets ready check")
+		os.Exit(1)
+	}
 
-	setupLog.Info("starting manager")
+	metrics.SetBuildInfo(version.Version, version.GitCommit)
+	setupLog.Info("starting manager", "version", version.Version, "gitCommit", version.GitCommit)
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
+
+	// Flush the spans still batched for export.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := shutdownTracing(ctx); err != nil {
+		setupLog.Error(err, "unable to flush traces")
+	}
+}
+
+// parseWatchNamespaces splits the --watch-namespaces value into namespace names,
+// dropping blanks and duplicates.
+func parseWatchNamespaces(value string) []string {
+	var namespaces []string
+	for _, ns := range strings.Split(value, ",") {
+		ns = strings.TrimSpace(ns)
+		if ns == "" || slices.Contains(namespaces, ns) {
+			continue
+		}
+		namespaces = append(namespaces, ns)
+	}
+	return namespaces
+}
+
+// parseSecretStoreKinds parses the comma-separated --allowed-secret-store-kinds value.
+func parseSecretStoreKinds(value string) ([]llmwardenv1alpha1.SecretStoreKind, error) {
+	var kinds []llmwardenv1alpha1.SecretStoreKind
+	for _, kind := range strings.Split(value, ",") {
+		kind = strings.TrimSpace(kind)
+		if kind == "" {
+			continue
+		}
+		switch k := llmwardenv1alpha1.SecretStoreKind(kind); k {
+		case llmwardenv1alpha1.SecretStoreKindSecretStore, llmwardenv1alpha1.SecretStoreKindClusterSecretStore:
+			if !slices.Contains(kinds, k) {
+				kinds = append(kinds, k)
+			}
+		default:
+			return nil, fmt.Errorf("unknown secret store kind %q", kind)
+		}
+	}
+	return kinds, nil
+}
+
+// selectESOAdapter selects the ESO adapter version. Default to v1 (ESO v0.17+).
+// Set ESO_API_VERSION=v1beta1 if running against an older ESO installation.
+func selectESOAdapter() eso.Adapter {
+	if os.Getenv("ESO_API_VERSION") == "v1beta1" {
+		setupLog.Info("Using ESO v1beta1 adapter (set ESO_API_VERSION=v1 to use the current API)")
+		return eso.NewV1Beta1Adapter()
+	}
+	return eso.NewV1Adapter()
+}
+
+// printExternalSecret writes the ExternalSecret the controller would apply for the
+// LLMAccess named by ref ("<namespace>/<name>") to w, reading the access and its
+// provider from the cluster.
+func printExternalSecret(ctx context.Context, ref string, w io.Writer) error {
+	namespace, name, ok := strings.Cut(ref, "/")
+	if !ok || namespace == "" || name == "" {
+		return fmt.Errorf("--print-externalsecret must be <namespace>/<name>, got %q", ref)
+	}
+
+	c, err := client.New(ctrl.GetConfigOrDie(), client.Options{Scheme: scheme})
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+	access := &llmwardenv1alpha1.LLMAccess{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, access); err != nil {
+		return fmt.Errorf("getting LLMAccess %s: %w", ref, err)
+	}
+	provider, err := provisioner.ResolveProvider(ctx, c, access)
+	if err != nil {
+		return err
+	}
+
+	out, err := provisioner.NewExternalSecretProvisioner(c, scheme, selectESOAdapter()).RenderYAML(provider, access)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(out)
+	return err
 }
@@ -0,0 +1,169 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package backlog reports how far the controllers are behind: how many objects have a
+// generation they haven't reconciled yet, and how long the oldest has been waiting.
+package backlog
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/metrics"
+)
+
+var backloglog = logf.Log.WithName("reconcile-backlog")
+
+// conditionTypeReady is the condition every controller stamps with the generation it
+// reconciled.
+const conditionTypeReady = "Ready"
+
+// pending identifies one unreconciled generation of an object.
+type pending struct {
+	uid        types.UID
+	generation int64
+}
+
+// Monitor periodically lists the objects each controller reconciles and exports the
+// ReconcileBacklog and ReconcileBacklogOldestAge gauges. An object is unreconciled while
+// its Ready condition is missing or records an older generation than the object's.
+type Monitor struct {
+	Client   client.Reader
+	Interval time.Duration
+
+	// now returns the current time; overridden in tests.
+	now func() time.Time
+
+	// firstSeen is when a sweep first saw each unreconciled generation. The API keeps no
+	// timestamp for a spec change, so this is how long an update has been waiting.
+	firstSeen map[pending]time.Time
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Only the leader exports
+// the gauges so replicas don't report conflicting series.
+func (m *Monitor) NeedLeaderElection() bool {
+	return true
+}
+
+// Start implements manager.Runnable.
+func (m *Monitor) Start(ctx context.Context) error {
+	ticker := time.NewTicker(m.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := m.SweepOnce(ctx); err != nil {
+				backloglog.Error(err, "Reconcile backlog sweep failed")
+			}
+		}
+	}
+}
+
+// backlog is the unreconciled objects of one controller.
+type backlog struct {
+	count  int
+	oldest time.Time
+}
+
+// SweepOnce recomputes the backlog gauges for every controller.
+func (m *Monitor) SweepOnce(ctx context.Context) error {
+	now := m.clock()
+	seen := make(map[pending]time.Time)
+	backlogs := map[string]*backlog{"llmaccess": {}, "llmprovider": {}, "namespacedllmprovider": {}}
+	track := func(controller string, obj *metav1.ObjectMeta, conditions []metav1.Condition) {
+		since, ok := m.unreconciledSince(obj, conditions, now)
+		if !ok {
+			return
+		}
+		seen[pending{uid: obj.UID, generation: obj.Generation}] = since
+		b := backlogs[controller]
+		b.count++
+		if b.oldest.IsZero() || since.Before(b.oldest) {
+			b.oldest = since
+		}
+	}
+
+	accesses := &llmwardenv1alpha1.LLMAccessList{}
+	if err := m.Client.List(ctx, accesses); err != nil {
+		return fmt.Errorf("listing LLMAccess: %w", err)
+	}
+	for i := range accesses.Items {
+		track("llmaccess", &accesses.Items[i].ObjectMeta, accesses.Items[i].Status.Conditions)
+	}
+	providers := &llmwardenv1alpha1.LLMProviderList{}
+	if err := m.Client.List(ctx, providers); err != nil {
+		return fmt.Errorf("listing LLMProviders: %w", err)
+	}
+	for i := range providers.Items {
+		track("llmprovider", &providers.Items[i].ObjectMeta, providers.Items[i].Status.Conditions)
+	}
+	namespacedProviders := &llmwardenv1alpha1.NamespacedLLMProviderList{}
+	if err := m.Client.List(ctx, namespacedProviders); err != nil {
+		return fmt.Errorf("listing NamespacedLLMProviders: %w", err)
+	}
+	for i := range namespacedProviders.Items {
+		track("namespacedllmprovider", &namespacedProviders.Items[i].ObjectMeta, namespacedProviders.Items[i].Status.Conditions)
+	}
+
+	// Forget generations that have since been reconciled or deleted.
+	m.firstSeen = seen
+	for controller, b := range backlogs {
+		var age float64
+		if b.count > 0 {
+			age = now.Sub(b.oldest).Seconds()
+		}
+		metrics.ReconcileBacklog.WithLabelValues(controller).Set(float64(b.count))
+		metrics.ReconcileBacklogOldestAge.WithLabelValues(controller).Set(age)
+	}
+	return nil
+}
+
+// unreconciledSince reports whether the object's current generation is unreconciled and,
+// if so, since when: its creation if it was never reconciled, otherwise the first sweep
+// that saw the generation. Objects being deleted are not counted.
+func (m *Monitor) unreconciledSince(obj *metav1.ObjectMeta, conditions []metav1.Condition, now time.Time) (time.Time, bool) {
+	if obj.DeletionTimestamp != nil {
+		return time.Time{}, false
+	}
+	ready := apimeta.FindStatusCondition(conditions, conditionTypeReady)
+	if ready != nil && ready.ObservedGeneration >= obj.Generation {
+		return time.Time{}, false
+	}
+	if ready == nil && !obj.CreationTimestamp.IsZero() {
+		return obj.CreationTimestamp.Time, true
+	}
+	if since, ok := m.firstSeen[pending{uid: obj.UID, generation: obj.Generation}]; ok {
+		return since, true
+	}
+	return now, true
+}
+
+func (m *Monitor) clock() time.Time {
+	if m.now != nil {
+		return m.now()
+	}
+	return time.Now()
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backlog
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/metrics"
+)
+
+func TestMonitor_SweepOnce(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+	start := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	ready := func(observed int64) []metav1.Condition {
+		return []metav1.Condition{{
+			Type: conditionTypeReady, Status: metav1.ConditionTrue, Reason: "CredentialProvisioned",
+			ObservedGeneration: observed, LastTransitionTime: metav1.NewTime(start),
+		}}
+	}
+	access := func(name string, generation int64, created time.Time, conditions []metav1.Condition) *llmwardenv1alpha1.LLMAccess {
+		return &llmwardenv1alpha1.LLMAccess{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name, Namespace: "team-a", UID: types.UID(name), Generation: generation,
+				CreationTimestamp: metav1.NewTime(created),
+			},
+			Status: llmwardenv1alpha1.LLMAccessStatus{Conditions: conditions},
+		}
+	}
+
+	// A reconciled access, one whose latest spec change is unreconciled, and one the
+	// controller never got to since it was created ten minutes ago.
+	reconciled := access("reconciled", 2, start.Add(-time.Hour), ready(2))
+	stale := access("stale", 3, start.Add(-time.Hour), ready(2))
+	neverReconciled := access("never-reconciled", 1, start.Add(-10*time.Minute), nil)
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai", UID: "openai", Generation: 1},
+		Status:     llmwardenv1alpha1.LLMProviderStatus{Conditions: ready(1)},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(reconciled, stale, neverReconciled, provider).
+		WithStatusSubresource(&llmwardenv1alpha1.LLMAccess{}).
+		Build()
+
+	now := start
+	m := &Monitor{Client: fakeClient, now: func() time.Time { return now }}
+	sweep := func() {
+		t.Helper()
+		if err := m.SweepOnce(ctx); err != nil {
+			t.Fatalf("SweepOnce() error = %v", err)
+		}
+	}
+	markReconciled := func(name string, generation int64) {
+		t.Helper()
+		current := &llmwardenv1alpha1.LLMAccess{}
+		if err := fakeClient.Get(ctx, types.NamespacedName{Name: name, Namespace: "team-a"}, current); err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		current.Status.Conditions = ready(generation)
+		if err := fakeClient.Status().Update(ctx, current); err != nil {
+			t.Fatalf("Status().Update() error = %v", err)
+		}
+	}
+	assertBacklog := func(controller string, wantCount, wantAge float64) {
+		t.Helper()
+		if got := testutil.ToFloat64(metrics.ReconcileBacklog.WithLabelValues(controller)); got != wantCount {
+			t.Errorf("%s backlog = %v, want %v", controller, got, wantCount)
+		}
+		if got := testutil.ToFloat64(metrics.ReconcileBacklogOldestAge.WithLabelValues(controller)); got != wantAge {
+			t.Errorf("%s oldest backlog age = %v, want %v", controller, got, wantAge)
+		}
+	}
+
+	sweep()
+	assertBacklog("llmaccess", 2, (10 * time.Minute).Seconds())
+	assertBacklog("llmprovider", 0, 0)
+
+	// Once the new access is reconciled, the stale one is the oldest, waiting since the
+	// first sweep saw its generation.
+	now = start.Add(30 * time.Second)
+	markReconciled(neverReconciled.Name, 1)
+	sweep()
+	assertBacklog("llmaccess", 1, 30)
+
+	markReconciled(stale.Name, 3)
+	sweep()
+	assertBacklog("llmaccess", 0, 0)
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package bypass detects pods that match an LLMAccess but were admitted without the
+// pod injector, e.g. while the fail-open webhook was unavailable.
+package bypass
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/metrics"
+	webhookv1alpha1 "github.com/llmwarden/llmwarden/internal/webhook/v1alpha1"
+)
+
+var bypasslog = logf.Log.WithName("bypass-detector")
+
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+
+// Detector periodically counts the live pods each LLMAccess matches that the pod injector
+// didn't inject it into, and exports the counts as the UninjectedMatchingPods gauge.
+type Detector struct {
+	Client   client.Reader
+	Interval time.Duration
+
+	// PodReader lists the pods to check. The manager's API reader keeps full pod objects
+	// out of the cache; nil uses Client.
+	PodReader client.Reader
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Only the leader exports
+// the gauge so replicas don't report conflicting series.
+func (d *Detector) NeedLeaderElection() bool {
+	return true
+}
+
+// Start implements manager.Runnable.
+func (d *Detector) Start(ctx context.Context) error {
+	ticker := time.NewTicker(d.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := d.CheckOnce(ctx); err != nil {
+				bypasslog.Error(err, "Uninjected pod check failed")
+			}
+		}
+	}
+}
+
+// CheckOnce recomputes the UninjectedMatchingPods gauge for every LLMAccess.
+func (d *Detector) CheckOnce(ctx context.Context) error {
+	accessList := &llmwardenv1alpha1.LLMAccessList{}
+	if err := d.Client.List(ctx, accessList); err != nil {
+		return fmt.Errorf("listing LLMAccess: %w", err)
+	}
+
+	podsByNamespace := make(map[string][]corev1.Pod)
+	counts := make(map[*llmwardenv1alpha1.LLMAccess]int, len(accessList.Items))
+	for i := range accessList.Items {
+		access := &accessList.Items[i]
+		pods, ok := podsByNamespace[access.Namespace]
+		if !ok {
+			podList := &corev1.PodList{}
+			if err := d.podReader().List(ctx, podList, client.InNamespace(access.Namespace)); err != nil {
+				return fmt.Errorf("listing pods in %s: %w", access.Namespace, err)
+			}
+			pods = podList.Items
+			podsByNamespace[access.Namespace] = pods
+		}
+
+		counts[access] = 0
+		for j := range pods {
+			pod := &pods[j]
+			if podTerminal(pod) || !webhookv1alpha1.PodMatchesAccess(pod, access) || injected(pod, access) {
+				continue
+			}
+			counts[access]++
+			bypasslog.V(1).Info("Pod matches LLMAccess but was not injected",
+				"pod", pod.Name, "namespace", pod.Namespace, "access", access.Name)
+		}
+	}
+
+	// Rebuild the gauge so series of deleted accesses disappear.
+	metrics.UninjectedMatchingPods.Reset()
+	for access, count := range counts {
+		metrics.UninjectedMatchingPods.WithLabelValues(access.Namespace, access.Name, access.Spec.ProviderRef.Name).
+			Set(float64(count))
+	}
+	return nil
+}
+
+// podReader returns PodReader, or Client when it is unset.
+func (d *Detector) podReader() client.Reader {
+	if d.PodReader != nil {
+		return d.PodReader
+	}
+	return d.Client
+}
+
+// injected reports whether the pod injector processed the pod and injected the access's
+// provider. A pod admitted before the access existed counts as not injected too: it runs
+// without the credentials until it is recreated.
+func injected(pod *corev1.Pod, access *llmwardenv1alpha1.LLMAccess) bool {
+	if pod.Annotations[webhookv1alpha1.InjectionStatusAnnotation()] != "injected" {
+		return false
+	}
+	providers := strings.Split(pod.Annotations[webhookv1alpha1.InjectedProvidersAnnotation()], ",")
+	return slices.Contains(providers, access.Spec.ProviderRef.Name)
+}
+
+// podTerminal reports whether the pod has finished and no longer needs credentials.
+func podTerminal(pod *corev1.Pod) bool {
+	return pod.DeletionTimestamp != nil ||
+		pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bypass
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/metrics"
+	webhookv1alpha1 "github.com/llmwarden/llmwarden/internal/webhook/v1alpha1"
+)
+
+func TestDetector_CheckOnce(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: "chatbot-access", Namespace: "bypass-ns"},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai-prod"},
+			SecretName:  "openai-creds",
+			WorkloadSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "chatbot"},
+			},
+		},
+	}
+	pod := func(name string, labels, annotations map[string]string, phase corev1.PodPhase) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "bypass-ns", Labels: labels, Annotations: annotations},
+			Status:     corev1.PodStatus{Phase: phase},
+		}
+	}
+	chatbot := map[string]string{"app": "chatbot"}
+	injectedWith := func(providers string) map[string]string {
+		return map[string]string{
+			webhookv1alpha1.InjectionStatusAnnotation():   "injected",
+			webhookv1alpha1.InjectedProvidersAnnotation(): providers,
+		}
+	}
+
+	// Pods are listed through PodReader only, as the manager's uncached API reader.
+	pods := []client.Object{
+		pod("injected", chatbot, injectedWith("anthropic-prod,openai-prod"), corev1.PodRunning),
+		pod("bypassed", chatbot, nil, corev1.PodRunning),
+		pod("other-provider", chatbot, injectedWith("anthropic-prod"), corev1.PodPending),
+		pod("completed", chatbot, nil, corev1.PodSucceeded),
+		pod("unrelated", map[string]string{"app": "batch"}, nil, corev1.PodRunning),
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(access).Build()
+	podReader := fake.NewClientBuilder().WithScheme(scheme).WithObjects(pods...).Build()
+
+	d := &Detector{Client: fakeClient, PodReader: podReader}
+	if err := d.CheckOnce(context.Background()); err != nil {
+		t.Fatalf("CheckOnce() error = %v", err)
+	}
+
+	got := testutil.ToFloat64(metrics.UninjectedMatchingPods.WithLabelValues("bypass-ns", "chatbot-access", "openai-prod"))
+	if got != 2 {
+		t.Errorf("uninjected matching pods = %v, want 2", got)
+	}
+
+	// Once the access is gone its series is dropped.
+	if err := fakeClient.Delete(context.Background(), access); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if err := d.CheckOnce(context.Background()); err != nil {
+		t.Fatalf("CheckOnce() error = %v", err)
+	}
+	if n := testutil.CollectAndCount(metrics.UninjectedMatchingPods); n != 0 {
+		t.Errorf("series after access deletion = %d, want 0", n)
+	}
+}
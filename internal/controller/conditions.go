@@ -19,12 +19,16 @@ package controller
 import (
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
 )
 
 // setCondition sets or updates a status condition using the standard apimeta helper.
 // It handles LastTransitionTime correctly (only updated when Status changes) and
 // records ObservedGeneration so controllers can detect stale conditions.
-func setCondition(conditions *[]metav1.Condition, generation int64, conditionType string, status metav1.ConditionStatus, reason, message string) {
+// ready is the resource's status.ready field; it is recomputed from the Ready condition
+// on every call so the two can never diverge.
+func setCondition(conditions *[]metav1.Condition, ready *bool, generation int64, conditionType string, status metav1.ConditionStatus, reason, message string) {
 	apimeta.SetStatusCondition(conditions, metav1.Condition{
 		Type:               conditionType,
 		Status:             status,
@@ -32,4 +36,49 @@ func setCondition(conditions *[]metav1.Condition, generation int64, conditionTyp
 		Message:            message,
 		ObservedGeneration: generation,
 	})
+	*ready = apimeta.IsStatusConditionTrue(*conditions, ConditionTypeReady)
+}
+
+// computePhase derives a single-word phase from a resource's conditions so tooling
+// can switch on one field instead of scanning the conditions slice:
+//   - Pending: no Ready condition yet, Ready is Unknown, or Ready is False only
+//     because an external system (ESO) has not caught up yet
+//   - Ready: Ready is True and no other condition is False
+//   - Degraded: Ready is True but another condition is False, or Ready is False
+//     while another condition is still True (e.g. credentials remain provisioned)
+//   - Error: Ready is False and no other condition is True
+//
+// Advisory conditions such as NamespacedSecretStore and Deprecated don't affect the phase.
+func computePhase(conditions []metav1.Condition) llmwardenv1alpha1.Phase {
+	ready := apimeta.FindStatusCondition(conditions, ConditionTypeReady)
+	if ready == nil || ready.Status == metav1.ConditionUnknown {
+		return llmwardenv1alpha1.PhasePending
+	}
+	if ready.Status == metav1.ConditionFalse && ready.Reason == ReasonExternalSecretNotSynced {
+		return llmwardenv1alpha1.PhasePending
+	}
+
+	var anyTrue, anyFalse bool
+	for _, c := range conditions {
+		if c.Type == ConditionTypeReady || c.Type == ConditionTypeNamespacedSecretStore || c.Type == ConditionTypeDeprecated {
+			continue
+		}
+		switch c.Status {
+		case metav1.ConditionTrue:
+			anyTrue = true
+		case metav1.ConditionFalse:
+			anyFalse = true
+		}
+	}
+
+	if ready.Status == metav1.ConditionTrue {
+		if anyFalse {
+			return llmwardenv1alpha1.PhaseDegraded
+		}
+		return llmwardenv1alpha1.PhaseReady
+	}
+	if anyTrue {
+		return llmwardenv1alpha1.PhaseDegraded
+	}
+	return llmwardenv1alpha1.PhaseError
 }
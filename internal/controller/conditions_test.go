@@ -0,0 +1,147 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+)
+
+func TestComputePhase(t *testing.T) {
+	cond := func(conditionType string, status metav1.ConditionStatus) metav1.Condition {
+		return metav1.Condition{Type: conditionType, Status: status, Reason: "Test"}
+	}
+
+	tests := []struct {
+		name       string
+		conditions []metav1.Condition
+		want       llmwardenv1alpha1.Phase
+	}{
+		// LLMAccess condition sets
+		{
+			name:       "access without conditions is pending",
+			conditions: nil,
+			want:       llmwardenv1alpha1.PhasePending,
+		},
+		{
+			name: "access with credentials provisioned is ready",
+			conditions: []metav1.Condition{
+				cond(ConditionTypeReady, metav1.ConditionTrue),
+				cond(ConditionTypeCredentialProvisioned, metav1.ConditionTrue),
+			},
+			want: llmwardenv1alpha1.PhaseReady,
+		},
+		{
+			name: "access not ready but still holding provisioned credentials is degraded",
+			conditions: []metav1.Condition{
+				cond(ConditionTypeReady, metav1.ConditionFalse),
+				cond(ConditionTypeCredentialProvisioned, metav1.ConditionTrue),
+			},
+			want: llmwardenv1alpha1.PhaseDegraded,
+		},
+		{
+			name: "access ready with a failing condition is degraded",
+			conditions: []metav1.Condition{
+				cond(ConditionTypeReady, metav1.ConditionTrue),
+				cond(ConditionTypeCredentialProvisioned, metav1.ConditionFalse),
+			},
+			want: llmwardenv1alpha1.PhaseDegraded,
+		},
+		{
+			name: "access whose provisioning failed is in error",
+			conditions: []metav1.Condition{
+				cond(ConditionTypeReady, metav1.ConditionFalse),
+				cond(ConditionTypeCredentialProvisioned, metav1.ConditionFalse),
+			},
+			want: llmwardenv1alpha1.PhaseError,
+		},
+		{
+			name: "access waiting for ESO to sync is pending",
+			conditions: []metav1.Condition{
+				{Type: ConditionTypeReady, Status: metav1.ConditionFalse, Reason: ReasonExternalSecretNotSynced},
+				cond(ConditionTypeCredentialProvisioned, metav1.ConditionTrue),
+			},
+			want: llmwardenv1alpha1.PhasePending,
+		},
+		// LLMProvider condition sets
+		{
+			name:       "provider with a healthy secret is ready",
+			conditions: []metav1.Condition{cond(ConditionTypeReady, metav1.ConditionTrue)},
+			want:       llmwardenv1alpha1.PhaseReady,
+		},
+		{
+			name:       "provider with a missing secret is in error",
+			conditions: []metav1.Condition{cond(ConditionTypeReady, metav1.ConditionFalse)},
+			want:       llmwardenv1alpha1.PhaseError,
+		},
+		{
+			name:       "provider with unknown readiness is pending",
+			conditions: []metav1.Condition{cond(ConditionTypeReady, metav1.ConditionUnknown)},
+			want:       llmwardenv1alpha1.PhasePending,
+		},
+		{
+			name: "provider referencing a namespaced store stays in error on a missing config",
+			conditions: []metav1.Condition{
+				cond(ConditionTypeReady, metav1.ConditionFalse),
+				cond(ConditionTypeNamespacedSecretStore, metav1.ConditionTrue),
+			},
+			want: llmwardenv1alpha1.PhaseError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := computePhase(tt.conditions); got != tt.want {
+				t.Errorf("computePhase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetConditionMaintainsReady(t *testing.T) {
+	// Each step is applied in order, so the sequence covers every transition in and out
+	// of Ready=True, including updates to conditions other than Ready.
+	steps := []struct {
+		conditionType string
+		status        metav1.ConditionStatus
+		wantReady     bool
+	}{
+		{ConditionTypeCredentialProvisioned, metav1.ConditionTrue, false},
+		{ConditionTypeReady, metav1.ConditionTrue, true},
+		{ConditionTypeCredentialProvisioned, metav1.ConditionFalse, true},
+		{ConditionTypeReady, metav1.ConditionFalse, false},
+		{ConditionTypeReady, metav1.ConditionUnknown, false},
+		{ConditionTypeReady, metav1.ConditionTrue, true},
+	}
+
+	access := &llmwardenv1alpha1.LLMAccess{ObjectMeta: metav1.ObjectMeta{Generation: 1}}
+	provider := &llmwardenv1alpha1.LLMProvider{ObjectMeta: metav1.ObjectMeta{Generation: 1}}
+	for i, step := range steps {
+		setCondition(&access.Status.Conditions, &access.Status.Ready, access.Generation, step.conditionType, step.status, "Test", "")
+		setCondition(&provider.Status.Conditions, &provider.Status.Ready, provider.Generation, step.conditionType, step.status, "Test", "")
+
+		if access.Status.Ready != step.wantReady {
+			t.Errorf("step %d: LLMAccess status.ready = %v, want %v", i, access.Status.Ready, step.wantReady)
+		}
+		if provider.Status.Ready != step.wantReady {
+			t.Errorf("step %d: LLMProvider status.ready = %v, want %v", i, provider.Status.Ready, step.wantReady)
+		}
+	}
+}
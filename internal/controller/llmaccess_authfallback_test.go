@@ -0,0 +1,233 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/provisioner"
+)
+
+func TestLLMAccessReconciler_AuthFallback(t *testing.T) {
+	workloadIdentity := &llmwardenv1alpha1.WorkloadIdentityAuth{
+		AWS: &llmwardenv1alpha1.AWSWorkloadIdentity{RoleArn: "arn:aws:iam::123456789012:role/bedrock", Region: "us-east-1"},
+	}
+	apiKey := &llmwardenv1alpha1.APIKeyAuth{
+		SecretRef: llmwardenv1alpha1.SecretReference{Name: "bedrock-master", Namespace: "vault-sync", Key: "api-key"},
+	}
+
+	tests := []struct {
+		name         string
+		auth         llmwardenv1alpha1.AuthConfig
+		wantReady    metav1.ConditionStatus
+		wantReason   string
+		wantAuthType llmwardenv1alpha1.AuthType
+		wantFallback bool
+	}{
+		{
+			name: "workloadIdentity preferred with apiKey fallback",
+			auth: llmwardenv1alpha1.AuthConfig{
+				Type:             llmwardenv1alpha1.AuthTypeWorkloadIdentity,
+				WorkloadIdentity: workloadIdentity,
+				Fallbacks: []llmwardenv1alpha1.AuthFallback{
+					{Type: llmwardenv1alpha1.AuthTypeAPIKey, APIKey: apiKey},
+				},
+			},
+			wantReady:    metav1.ConditionTrue,
+			wantReason:   ReasonCredentialProvisioned,
+			wantAuthType: llmwardenv1alpha1.AuthTypeAPIKey,
+			wantFallback: true,
+		},
+		{
+			name: "unavailable fallbacks are skipped",
+			auth: llmwardenv1alpha1.AuthConfig{
+				Type:             llmwardenv1alpha1.AuthTypeWorkloadIdentity,
+				WorkloadIdentity: workloadIdentity,
+				Fallbacks: []llmwardenv1alpha1.AuthFallback{
+					{
+						Type: llmwardenv1alpha1.AuthTypeExternalSecret,
+						ExternalSecret: &llmwardenv1alpha1.ExternalSecretAuth{
+							Store:     llmwardenv1alpha1.StoreReference{Name: "vault", Kind: llmwardenv1alpha1.SecretStoreKindClusterSecretStore},
+							RemoteRef: llmwardenv1alpha1.RemoteReference{Key: "secret/bedrock"},
+						},
+					},
+					{Type: llmwardenv1alpha1.AuthTypeAPIKey, APIKey: apiKey},
+				},
+			},
+			wantReady:    metav1.ConditionTrue,
+			wantReason:   ReasonCredentialProvisioned,
+			wantAuthType: llmwardenv1alpha1.AuthTypeAPIKey,
+			wantFallback: true,
+		},
+		{
+			name: "preferred strategy is used when available",
+			auth: llmwardenv1alpha1.AuthConfig{
+				Type:   llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: apiKey,
+				Fallbacks: []llmwardenv1alpha1.AuthFallback{
+					{Type: llmwardenv1alpha1.AuthTypeWorkloadIdentity, WorkloadIdentity: workloadIdentity},
+				},
+			},
+			wantReady:    metav1.ConditionTrue,
+			wantReason:   ReasonCredentialProvisioned,
+			wantAuthType: llmwardenv1alpha1.AuthTypeAPIKey,
+		},
+		{
+			name: "no usable strategy",
+			auth: llmwardenv1alpha1.AuthConfig{
+				Type:             llmwardenv1alpha1.AuthTypeWorkloadIdentity,
+				WorkloadIdentity: workloadIdentity,
+				Fallbacks: []llmwardenv1alpha1.AuthFallback{
+					{
+						Type: llmwardenv1alpha1.AuthTypeExternalSecret,
+						ExternalSecret: &llmwardenv1alpha1.ExternalSecretAuth{
+							Store:     llmwardenv1alpha1.StoreReference{Name: "vault", Kind: llmwardenv1alpha1.SecretStoreKindClusterSecretStore},
+							RemoteRef: llmwardenv1alpha1.RemoteReference{Key: "secret/bedrock"},
+						},
+					},
+				},
+			},
+			wantReady:  metav1.ConditionFalse,
+			wantReason: ReasonAuthTypeNotSupported,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			scheme := runtime.NewScheme()
+			_ = clientgoscheme.AddToScheme(scheme)
+			_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+			provider := &llmwardenv1alpha1.LLMProvider{
+				ObjectMeta: metav1.ObjectMeta{Name: "bedrock"},
+				Spec: llmwardenv1alpha1.LLMProviderSpec{
+					Provider: llmwardenv1alpha1.ProviderAWSBedrock,
+					Auth:     tt.auth,
+				},
+			}
+			access := &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "bedrock-access",
+					Namespace:  "team-a",
+					Finalizers: []string{llmAccessFinalizer},
+				},
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "bedrock"},
+					SecretName:  "bedrock-credentials",
+					Injection: llmwardenv1alpha1.InjectionConfig{
+						Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "BEDROCK_API_KEY", SecretKey: "apiKey"}},
+					},
+				},
+			}
+			sourceSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "bedrock-master", Namespace: "vault-sync"},
+				Data:       map[string][]byte{"api-key": []byte("sk-bedrock")},
+			}
+
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(provider, access, sourceSecret).
+				WithStatusSubresource(&llmwardenv1alpha1.LLMAccess{}).
+				Build()
+
+			// Only the apiKey provisioner is configured; workloadIdentity has none.
+			recorder := record.NewFakeRecorder(10)
+			r := &LLMAccessReconciler{
+				Client:            fakeClient,
+				Scheme:            scheme,
+				Recorder:          recorder,
+				ApiKeyProvisioner: provisioner.NewApiKeyProvisioner(fakeClient, scheme),
+			}
+
+			key := types.NamespacedName{Name: access.Name, Namespace: access.Namespace}
+			if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+
+			updated := &llmwardenv1alpha1.LLMAccess{}
+			if err := fakeClient.Get(ctx, key, updated); err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			ready := apimeta.FindStatusCondition(updated.Status.Conditions, ConditionTypeReady)
+			if ready == nil || ready.Status != tt.wantReady || ready.Reason != tt.wantReason {
+				t.Fatalf("Ready = %+v, want %s/%s", ready, tt.wantReady, tt.wantReason)
+			}
+			if updated.Status.ProvisionedAuthType != tt.wantAuthType {
+				t.Errorf("ProvisionedAuthType = %q, want %q", updated.Status.ProvisionedAuthType, tt.wantAuthType)
+			}
+
+			var sawFallback bool
+			for len(recorder.Events) > 0 {
+				if strings.Contains(<-recorder.Events, ReasonAuthFallback) {
+					sawFallback = true
+				}
+			}
+			if sawFallback != tt.wantFallback {
+				t.Errorf("%s event emitted = %v, want %v", ReasonAuthFallback, sawFallback, tt.wantFallback)
+			}
+		})
+	}
+}
+
+func TestProviderWithAuthType(t *testing.T) {
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "bedrock"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type:      llmwardenv1alpha1.AuthTypeWorkloadIdentity,
+				TargetKey: "token",
+				Fallbacks: []llmwardenv1alpha1.AuthFallback{{
+					Type: llmwardenv1alpha1.AuthTypeAPIKey,
+					APIKey: &llmwardenv1alpha1.APIKeyAuth{
+						SecretRef: llmwardenv1alpha1.SecretReference{Name: "bedrock-master", Namespace: "vault-sync", Key: "api-key"},
+					},
+				}},
+			},
+		},
+	}
+
+	effective := providerWithAuthType(provider, llmwardenv1alpha1.AuthTypeAPIKey)
+	if effective.Spec.Auth.Type != llmwardenv1alpha1.AuthTypeAPIKey || effective.Spec.Auth.APIKey == nil {
+		t.Errorf("Auth = %+v, want the apiKey fallback", effective.Spec.Auth)
+	}
+	if effective.Spec.Auth.TargetKey != "token" {
+		t.Errorf("TargetKey = %q, want the provider's targetKey", effective.Spec.Auth.TargetKey)
+	}
+	if provider.Spec.Auth.Type != llmwardenv1alpha1.AuthTypeWorkloadIdentity {
+		t.Error("providerWithAuthType modified the provider")
+	}
+
+	for _, authType := range []llmwardenv1alpha1.AuthType{"", llmwardenv1alpha1.AuthTypeWorkloadIdentity, llmwardenv1alpha1.AuthTypeExternalSecret} {
+		if got := providerWithAuthType(provider, authType); got != provider {
+			t.Errorf("providerWithAuthType(%q) = %+v, want the provider itself", authType, got.Spec.Auth)
+		}
+	}
+}
@@ -18,34 +18,56 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
-	"strconv"
+	"maps"
+	"path"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/duration"
+	"github.com/llmwarden/llmwarden/internal/labelkeys"
 	"github.com/llmwarden/llmwarden/internal/metrics"
+	"github.com/llmwarden/llmwarden/internal/notify"
 	"github.com/llmwarden/llmwarden/internal/provisioner"
+	"github.com/llmwarden/llmwarden/internal/tracing"
+	webhookv1alpha1 "github.com/llmwarden/llmwarden/internal/webhook/v1alpha1"
 )
 
 const (
 	// Condition types
 	ConditionTypeReady                 = "Ready"
 	ConditionTypeCredentialProvisioned = "CredentialProvisioned"
+	// ConditionTypeModelsProvisioned reports whether every model in spec.models is among
+	// status.provisionedModels. It is only set on accesses that request models explicitly.
+	ConditionTypeModelsProvisioned = "ModelsProvisioned"
 
 	// Condition reasons
 	ReasonProviderNotFound      = "ProviderNotFound"
@@ -57,11 +79,94 @@ const (
 	ReasonSecretUpdateFailed    = "SecretUpdateFailed"
 	ReasonCredentialProvisioned = "CredentialProvisioned"
 	ReasonReconciliationError   = "ReconciliationError"
+	ReasonDriftRepaired         = "DriftRepaired"
+	ReasonEndpointUpdated       = "EndpointUpdated"
+	ReasonExpired               = "Expired"
+	ReasonInvalidTTL            = "InvalidTTL"
+	// ReasonExternalSecretNotSynced means the ExternalSecret exists but ESO has not
+	// yet reported it synced, so the target secret may be missing or stale.
+	ReasonExternalSecretNotSynced = "ExternalSecretNotSynced"
+	ReasonAuthTypeChanged         = "AuthTypeChanged"
+	ReasonKeyRotationStarted      = "KeyRotationStarted"
+	ReasonNextKeyPromoted         = "NextKeyPromoted"
+	// ReasonSourceSecretForbidden means RBAC denies the controller access to the provider's
+	// source secret; retrying won't help until permissions change.
+	ReasonSourceSecretForbidden = "SourceSecretForbidden"
+	// ReasonSecretInUse means cleanup of a previous auth type's secret is waiting for the
+	// running pods that still reference it.
+	ReasonSecretInUse = "SecretInUse"
+	// ReasonTargetEqualsSourceSecret means the access's secretName names the provider's
+	// source secret in the same namespace, so provisioning is refused to protect it.
+	ReasonTargetEqualsSourceSecret = "TargetEqualsSourceSecret"
+	// ReasonSecretTooLarge means the target secret would exceed the apiserver's size limit
+	// for secret data, usually because the provider's source value is too large.
+	ReasonSecretTooLarge = "SecretTooLarge"
+	// ReasonAwaitingWorkload means lazy provisioning is holding off the target secret
+	// because no running pod matches the access.
+	ReasonAwaitingWorkload = "AwaitingWorkload"
+	// ReasonIdleSecretRemoved is the event emitted when lazy provisioning deletes a target
+	// secret that no pod has matched for the idle grace period.
+	ReasonIdleSecretRemoved = "IdleSecretRemoved"
+	// ReasonLazyProvisioningDisabled is the event emitted when an access asks for lazy
+	// provisioning but the controller runs without --enable-lazy-provisioning.
+	ReasonLazyProvisioningDisabled = "LazyProvisioningDisabled"
+	// ReasonAuthFallback is the event emitted when an access is provisioned with one of the
+	// provider's auth fallbacks because the preferred strategy isn't available.
+	ReasonAuthFallback = "AuthFallback"
+	// ReasonSyncedButKeyMissing means ESO reports the ExternalSecret synced but the target
+	// secret lacks the credential key, usually because the remoteRef points at the wrong entry.
+	ReasonSyncedButKeyMissing = provisioner.ReasonSyncedButKeyMissing
+	// ReasonProviderOutOfNamespace means the access references a NamespacedLLMProvider that
+	// reaches outside its namespace for secrets, ConfigMaps or secret stores.
+	ReasonProviderOutOfNamespace = "ProviderOutOfNamespace"
+	// ReasonRefreshIntervalUpdated is the event emitted when an existing ExternalSecret's
+	// refreshInterval is updated in place, e.g. after the access's rotation.interval changed.
+	ReasonRefreshIntervalUpdated = "RefreshIntervalUpdated"
+	// ReasonSecretRetained is the event emitted when a deleted access leaves its target
+	// secret behind because of the Retain reclaim policy.
+	ReasonSecretRetained = "SecretRetained"
+	// ReasonRotationDeferred is the event emitted when a scheduled rotation comes due outside
+	// the maintenance window and waits for the window to open.
+	ReasonRotationDeferred = "RotationDeferred"
+	// ReasonExternalSecretRejected means the dry-run create of the access's ExternalSecret
+	// failed, usually because ESO's admission webhook rejects the rendered spec.
+	ReasonExternalSecretRejected = "ExternalSecretRejected"
+	// ReasonModelsProvisioned means every requested model is provisioned.
+	ReasonModelsProvisioned = "ModelsProvisioned"
+	// ReasonModelsNotProvisioned means some requested models are not provisioned, e.g.
+	// because spec.models gained a model the provider doesn't allow.
+	ReasonModelsNotProvisioned = "ModelsNotProvisioned"
 
 	// Finalizer
+	// llmAccessFinalizer is the default finalizer, used unless LLMAccessReconciler.Finalizer
+	// overrides it.
 	llmAccessFinalizer = "llmwarden.io/finalizer"
 )
 
+// externalSecretSyncRequeueInterval caps how long an access waits between re-checks while
+// its ExternalSecret is waiting for ESO to sync.
+const externalSecretSyncRequeueInterval = 15 * time.Second
+
+// unsupportedAuthTypeRequeueInterval is how often an access whose provider auth type has no
+// provisioner is re-checked.
+const unsupportedAuthTypeRequeueInterval = 6 * time.Hour
+
+// sourceSecretForbiddenRequeueInterval is how often an access is re-checked while RBAC denies
+// reading the provider's source secret.
+const sourceSecretForbiddenRequeueInterval = 10 * time.Minute
+
+// secretTooLargeRequeueInterval is how often an access is re-checked while its target
+// secret would be too large; the source secret may be trimmed without a spec change.
+const secretTooLargeRequeueInterval = 10 * time.Minute
+
+// externalSecretRejectedRequeueInterval is how often an access is re-checked while the
+// dry-run create of its ExternalSecret is rejected.
+const externalSecretRejectedRequeueInterval = 10 * time.Minute
+
+// defaultKeyRotationWindow is how long apiKey and apiKeyNext are both served when the
+// provider doesn't set a rotationWindow.
+const defaultKeyRotationWindow = 24 * time.Hour
+
 // LLMAccessReconciler reconciles a LLMAccess object
 type LLMAccessReconciler struct {
 	client.Client
@@ -69,6 +174,43 @@ type LLMAccessReconciler struct {
 	Recorder                  record.EventRecorder
 	ApiKeyProvisioner         *provisioner.ApiKeyProvisioner
 	ExternalSecretProvisioner *provisioner.ExternalSecretProvisioner
+
+	// CleanupInjectedAnnotations enables best-effort removal of this access's provider
+	// from the injected-providers annotation of matching pods when the access is deleted.
+	CleanupInjectedAnnotations bool
+
+	// RotationNotifier, when set, is told about every successful credential rotation.
+	// Delivery failures are logged and counted but never fail the reconcile.
+	RotationNotifier *notify.RotationNotifier
+
+	// ExportProvisionResult enables the ProvisionResultAnnotation on each provisioned access.
+	ExportProvisionResult bool
+
+	// Finalizer is the finalizer added to accesses to clean up on deletion. Empty means
+	// llmAccessFinalizer. Accesses still carrying llmAccessFinalizer after a rename are
+	// cleaned up and migrated to the new name.
+	Finalizer string
+
+	// DisableFinalizer stops adding a finalizer, so deleting an access never waits for the
+	// controller and its Secret or ExternalSecret is removed by owner references alone.
+	// Existing finalizers are removed from live accesses and still honoured on deletion.
+	DisableFinalizer bool
+
+	// ProviderChangeDebounce coalesces the reconciles of dependent accesses that provider
+	// changes trigger: an access is enqueued once this long after the first change, however
+	// many further changes arrive in between. Zero enqueues them on every change.
+	ProviderChangeDebounce time.Duration
+
+	// LazyProvisioning enables spec.injection.lazyProvisioning. Only then does the controller
+	// watch pods, and only their metadata; without it such accesses are provisioned eagerly.
+	LazyProvisioning bool
+
+	// PodReader lists the pods lazy provisioning and injected-annotation cleanup look at. The
+	// manager's API reader keeps full pod objects out of the cache; nil uses the client.
+	PodReader client.Reader
+
+	// now returns the current time; overridden in tests.
+	now func() time.Time
 }
 
 // +kubebuilder:rbac:groups=llmwarden.io,resources=llmaccesses,verbs=get;list;watch;create;update;patch;delete
@@ -78,14 +220,19 @@ type LLMAccessReconciler struct {
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;patch
 // +kubebuilder:rbac:groups=external-secrets.io,resources=externalsecrets,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
-func (r *LLMAccessReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *LLMAccessReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, retErr error) {
 	logger := log.FromContext(ctx)
 	startTime := time.Now()
 
+	ctx, span := tracing.Tracer().Start(ctx, tracing.SpanLLMAccessReconcile, trace.WithAttributes(
+		attribute.String("llmaccess.namespace", req.Namespace), attribute.String("llmaccess.name", req.Name)))
+	defer func() { tracing.End(span, retErr) }()
+
 	// Fetch the LLMAccess instance
 	llmAccess := &llmwardenv1alpha1.LLMAccess{}
 	if err := r.Get(ctx, req.NamespacedName, llmAccess); err != nil {
@@ -101,12 +248,20 @@ func (r *LLMAccessReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 
 	// Handle deletion
 	if !llmAccess.DeletionTimestamp.IsZero() {
-		if controllerutil.ContainsFinalizer(llmAccess, llmAccessFinalizer) {
+		if r.hasFinalizer(llmAccess) {
 			// Fetch the provider to determine which provisioner to call for cleanup.
 			// The provider may already be deleted; if so, skip cleanup (owner references
 			// on the owned Secret/ExternalSecret will GC them via Kubernetes).
-			provider := &llmwardenv1alpha1.LLMProvider{}
-			if err := r.Get(ctx, types.NamespacedName{Name: llmAccess.Spec.ProviderRef.Name}, provider); err == nil {
+			if provisioner.RetainSecret(llmAccess) {
+				// Keep the target secret: detach it so garbage collection leaves it behind
+				// and skip the provisioner cleanup that would delete it.
+				if err := provisioner.ReleaseSecret(ctx, r.Client, llmAccess); err != nil {
+					return ctrl.Result{}, err
+				}
+				r.Recorder.Event(llmAccess, corev1.EventTypeNormal, ReasonSecretRetained,
+					fmt.Sprintf("Secret %s retained by the %s reclaim policy", llmAccess.Spec.SecretName, provisioner.SecretReclaimPolicyRetain))
+			} else if provider, err := provisioner.ResolveProvider(ctx, r.Client, llmAccess); err == nil {
+				provider = providerWithAuthType(provider, llmAccess.Status.ProvisionedAuthType)
 				if prov, err := r.selectProvisioner(provider.Spec.Auth.Type); err == nil {
 					if cleanupErr := prov.Cleanup(ctx, provider, llmAccess); cleanupErr != nil {
 						logger.Error(cleanupErr, "Failed to cleanup provisioner resources during deletion")
@@ -115,7 +270,16 @@ func (r *LLMAccessReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 					}
 				}
 			}
-			controllerutil.RemoveFinalizer(llmAccess, llmAccessFinalizer)
+			if r.CleanupInjectedAnnotations {
+				if err := r.cleanupInjectedAnnotations(ctx, llmAccess); err != nil {
+					// Best-effort: stale annotations are cosmetic and must not block deletion.
+					logger.Error(err, "Failed to clean up injected-providers annotations")
+				}
+			}
+			metrics.UnsupportedAuthTypeAccesses.DeletePartialMatch(prometheus.Labels{
+				"provider": llmAccess.Spec.ProviderRef.Name, "namespace": llmAccess.Namespace, "access": llmAccess.Name,
+			})
+			r.removeFinalizers(llmAccess)
 			if err := r.Update(ctx, llmAccess); err != nil {
 				return ctrl.Result{}, fmt.Errorf("failed to remove finalizer: %w", err)
 			}
@@ -123,122 +287,447 @@ func (r *LLMAccessReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return ctrl.Result{}, nil
 	}
 
-	// Add finalizer if not present
-	if !controllerutil.ContainsFinalizer(llmAccess, llmAccessFinalizer) {
-		controllerutil.AddFinalizer(llmAccess, llmAccessFinalizer)
+	// Add the finalizer if not present, or remove ours when finalizers are disabled
+	if r.syncFinalizers(llmAccess) {
 		if err := r.Update(ctx, llmAccess); err != nil {
-			return ctrl.Result{}, fmt.Errorf("failed to add finalizer: %w", err)
+			return ctrl.Result{}, fmt.Errorf("failed to update finalizers: %w", err)
 		}
 		return ctrl.Result{Requeue: true}, nil
 	}
 
-	// Fetch referenced LLMProvider
-	provider := &llmwardenv1alpha1.LLMProvider{}
-	providerKey := types.NamespacedName{Name: llmAccess.Spec.ProviderRef.Name}
-	if err := r.Get(ctx, providerKey, provider); err != nil {
+	// Branches below only record the status they want; it is written once, when the
+	// reconcile returns, and only if it changed.
+	original := llmAccess.DeepCopy()
+	persistStatus := false
+	defer func() {
+		if !persistStatus {
+			return
+		}
+		if err := r.patchStatus(ctx, original, llmAccess); err != nil {
+			result, retErr = ctrl.Result{}, errors.Join(retErr, fmt.Errorf("failed to update status: %w", err))
+		}
+	}()
+
+	// Expire the access once its TTL has elapsed. The expiry is always derived from the
+	// creation timestamp, so editing the TTL extends or shortens the lifetime accordingly.
+	llmAccess.Status.ExpiresAt = nil
+	if llmAccess.Spec.TTL != "" {
+		ttl, err := parseDuration(llmAccess.Spec.TTL)
+		if err != nil {
+			setCondition(&llmAccess.Status.Conditions, &llmAccess.Status.Ready, llmAccess.Generation, ConditionTypeReady, metav1.ConditionFalse, ReasonInvalidTTL,
+				fmt.Sprintf("Invalid spec.ttl: %v", err))
+			persistStatus = true
+			// Permanent error — don't requeue until the spec changes.
+			return ctrl.Result{}, nil
+		}
+		expiresAt := metav1.NewTime(llmAccess.CreationTimestamp.Add(ttl))
+		if !r.clock().Before(expiresAt.Time) {
+			logger.Info("LLMAccess TTL elapsed, deleting", "ttl", llmAccess.Spec.TTL)
+			r.Recorder.Event(llmAccess, corev1.EventTypeNormal, ReasonExpired,
+				fmt.Sprintf("TTL %s elapsed at %s, deleting LLMAccess", llmAccess.Spec.TTL, expiresAt.UTC().Format(time.RFC3339)))
+			if err := r.Delete(ctx, llmAccess); err != nil && !apierrors.IsNotFound(err) {
+				return ctrl.Result{}, fmt.Errorf("failed to delete expired LLMAccess: %w", err)
+			}
+			return ctrl.Result{}, nil
+		}
+		llmAccess.Status.ExpiresAt = &expiresAt
+		// Make sure we come back in time to expire the access, whatever else is scheduled.
+		defer func() {
+			if retErr == nil {
+				result = r.requeueBeforeExpiry(result, expiresAt.Time)
+			}
+		}()
+	}
+
+	// Fetch referenced LLMProvider or NamespacedLLMProvider
+	fetchCtx, fetchSpan := tracing.Tracer().Start(ctx, tracing.SpanLLMAccessFetchProvider)
+	provider, err := provisioner.ResolveProvider(fetchCtx, r.Client, llmAccess)
+	tracing.End(fetchSpan, err)
+	if err != nil {
 		if apierrors.IsNotFound(err) {
 			logger.Error(err, "Referenced LLMProvider not found", "provider", llmAccess.Spec.ProviderRef.Name)
 			r.Recorder.Event(llmAccess, corev1.EventTypeWarning, ReasonProviderNotFound,
-				fmt.Sprintf("LLMProvider %s not found", llmAccess.Spec.ProviderRef.Name))
-			setCondition(&llmAccess.Status.Conditions, llmAccess.Generation, ConditionTypeReady, metav1.ConditionFalse, ReasonProviderNotFound,
-				fmt.Sprintf("LLMProvider %s not found", llmAccess.Spec.ProviderRef.Name))
-			if err := r.Status().Update(ctx, llmAccess); err != nil {
-				return ctrl.Result{}, fmt.Errorf("failed to update status: %w", err)
-			}
+				fmt.Sprintf("%s not found", describeProviderRef(llmAccess)))
+			setCondition(&llmAccess.Status.Conditions, &llmAccess.Status.Ready, llmAccess.Generation, ConditionTypeReady, metav1.ConditionFalse, ReasonProviderNotFound,
+				fmt.Sprintf("%s not found", describeProviderRef(llmAccess)))
+			persistStatus = true
 			return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
 		}
+		if errors.Is(err, provisioner.ErrProviderOutOfNamespace) {
+			r.Recorder.Event(llmAccess, corev1.EventTypeWarning, ReasonProviderOutOfNamespace, err.Error())
+			setCondition(&llmAccess.Status.Conditions, &llmAccess.Status.Ready, llmAccess.Generation, ConditionTypeReady, metav1.ConditionFalse, ReasonProviderOutOfNamespace,
+				err.Error())
+			persistStatus = true
+			// Permanent until the provider changes, which re-triggers reconciliation.
+			return ctrl.Result{}, nil
+		}
 		return ctrl.Result{}, fmt.Errorf("failed to get LLMProvider: %w", err)
 	}
 
+	// Skip the namespace and model checks when they already passed for this access and
+	// provider generation.
+	validateCtx, validateSpan := tracing.Tracer().Start(ctx, tracing.SpanLLMAccessValidate)
+	validated := validationCached(llmAccess, provider)
+	validateSpan.SetAttributes(attribute.Bool("llmaccess.validation_cached", validated))
+	if validated {
+		logger.V(1).Info("Access and provider unchanged since last validation, skipping namespace and model checks",
+			"provider", provider.Name, "providerGeneration", provider.Generation)
+	}
+
 	// Validate namespace is allowed
-	if !r.isNamespaceAllowed(ctx, llmAccess.Namespace, provider) {
+	if !validated && !r.isNamespaceAllowed(validateCtx, llmAccess.Namespace, provider) {
 		logger.Info("Namespace not allowed by provider", "namespace", llmAccess.Namespace, "provider", provider.Name)
 		r.Recorder.Event(llmAccess, corev1.EventTypeWarning, ReasonNamespaceNotAllowed,
 			fmt.Sprintf("Namespace %s is not allowed by LLMProvider %s", llmAccess.Namespace, provider.Name))
-		setCondition(&llmAccess.Status.Conditions, llmAccess.Generation, ConditionTypeReady, metav1.ConditionFalse, ReasonNamespaceNotAllowed,
+		setCondition(&llmAccess.Status.Conditions, &llmAccess.Status.Ready, llmAccess.Generation, ConditionTypeReady, metav1.ConditionFalse, ReasonNamespaceNotAllowed,
 			fmt.Sprintf("Namespace %s is not allowed by LLMProvider %s", llmAccess.Namespace, provider.Name))
-		if err := r.Status().Update(ctx, llmAccess); err != nil {
-			metrics.ReconciliationDuration.WithLabelValues("llmaccess", "error").Observe(time.Since(startTime).Seconds())
-			return ctrl.Result{}, fmt.Errorf("failed to update status: %w", err)
-		}
+		persistStatus = true
 		metrics.LLMAccessTotal.WithLabelValues(provider.Name, llmAccess.Namespace, "namespace_not_allowed").Set(1)
 		metrics.ReconciliationDuration.WithLabelValues("llmaccess", "error").Observe(time.Since(startTime).Seconds())
+		tracing.End(validateSpan, nil)
 		// Don't requeue - this is a permanent error until user fixes the provider or moves namespace
 		return ctrl.Result{}, nil
 	}
 
-	// Validate requested models
-	if err := r.validateModels(llmAccess.Spec.Models, provider); err != nil {
-		logger.Error(err, "Model validation failed")
-		r.Recorder.Event(llmAccess, corev1.EventTypeWarning, ReasonModelNotAllowed, err.Error())
-		setCondition(&llmAccess.Status.Conditions, llmAccess.Generation, ConditionTypeReady, metav1.ConditionFalse, ReasonModelNotAllowed, err.Error())
-		if err := r.Status().Update(ctx, llmAccess); err != nil {
-			return ctrl.Result{}, fmt.Errorf("failed to update status: %w", err)
+	// Validate requested models. Namespace labels are only needed for per-namespace model rules.
+	var nsLabels labels.Set
+	if len(provider.Spec.ModelNamespaceRules) > 0 {
+		ns := &corev1.Namespace{}
+		if err := r.Get(validateCtx, types.NamespacedName{Name: llmAccess.Namespace}, ns); err != nil {
+			err = fmt.Errorf("failed to get namespace %s: %w", llmAccess.Namespace, err)
+			tracing.End(validateSpan, err)
+			return ctrl.Result{}, err
+		}
+		nsLabels = labels.Set(ns.Labels)
+	}
+	if !validated {
+		if err := r.validateModels(llmAccess.Spec.Models, provider, nsLabels); err != nil {
+			logger.Error(err, "Model validation failed")
+			r.Recorder.Event(llmAccess, corev1.EventTypeWarning, ReasonModelNotAllowed, err.Error())
+			setCondition(&llmAccess.Status.Conditions, &llmAccess.Status.Ready, llmAccess.Generation, ConditionTypeReady, metav1.ConditionFalse, ReasonModelNotAllowed, err.Error())
+			// The previously provisioned models stay in place; report which requested ones aren't.
+			r.setModelsCondition(llmAccess)
+			persistStatus = true
+			tracing.End(validateSpan, err)
+			// Don't requeue - this is a permanent error until user fixes the spec
+			return ctrl.Result{}, nil
+		}
+		llmAccess.Status.ValidatedGenerations = &llmwardenv1alpha1.ValidatedGenerations{
+			Access:      llmAccess.Generation,
+			Provider:    provider.Generation,
+			ProviderUID: provider.UID,
 		}
-		// Don't requeue - this is a permanent error until user fixes the spec
-		return ctrl.Result{}, nil
 	}
+	tracing.End(validateSpan, nil)
 
-	// Select the provisioner based on the provider's auth type.
-	prov, err := r.selectProvisioner(provider.Spec.Auth.Type)
+	// Select the provisioner based on the provider's auth type, or the first fallback that
+	// has one. From here on the provider is seen through the selected strategy.
+	effective, prov, err := r.selectAuth(provider)
 	if err != nil {
-		logger.Info("Auth type not supported", "authType", provider.Spec.Auth.Type)
-		setCondition(&llmAccess.Status.Conditions, llmAccess.Generation, ConditionTypeReady, metav1.ConditionFalse, ReasonAuthTypeNotSupported, err.Error())
-		if statusErr := r.Status().Update(ctx, llmAccess); statusErr != nil {
-			return ctrl.Result{}, fmt.Errorf("failed to update status: %w", statusErr)
+		metrics.UnsupportedAuthTypeAccesses.WithLabelValues(provider.Name, llmAccess.Namespace, llmAccess.Name, string(provider.Spec.Auth.Type)).Set(1)
+		// Only write the condition when it changes so repeated reconciles don't churn the object.
+		ready := apimeta.FindStatusCondition(llmAccess.Status.Conditions, ConditionTypeReady)
+		if ready == nil || ready.Reason != ReasonAuthTypeNotSupported || ready.Message != err.Error() ||
+			ready.ObservedGeneration != llmAccess.Generation {
+			logger.Info("Auth type not supported", "authType", provider.Spec.Auth.Type)
+			setCondition(&llmAccess.Status.Conditions, &llmAccess.Status.Ready, llmAccess.Generation, ConditionTypeReady, metav1.ConditionFalse, ReasonAuthTypeNotSupported, err.Error())
+			persistStatus = true
+		}
+		// Provider and access changes re-trigger reconciliation; the slow requeue is only a backstop.
+		return ctrl.Result{RequeueAfter: unsupportedAuthTypeRequeueInterval}, nil
+	}
+	metrics.UnsupportedAuthTypeAccesses.DeletePartialMatch(prometheus.Labels{
+		"provider": provider.Name, "namespace": llmAccess.Namespace, "access": llmAccess.Name,
+	})
+	if effective.Spec.Auth.Type != provider.Spec.Auth.Type && llmAccess.Status.ProvisionedAuthType != effective.Spec.Auth.Type {
+		logger.Info("Falling back to alternative auth type", "preferred", provider.Spec.Auth.Type, "fallback", effective.Spec.Auth.Type)
+		r.Recorder.Event(llmAccess, corev1.EventTypeNormal, ReasonAuthFallback,
+			fmt.Sprintf("LLMProvider %s auth type %s is not available; using fallback %s",
+				provider.Name, provider.Spec.Auth.Type, effective.Spec.Auth.Type))
+	}
+	provider = effective
+
+	// When the provider switched auth type, remove what the previous provisioner created so
+	// the old Secret or ExternalSecret doesn't leak or block the new provisioner.
+	if previous := llmAccess.Status.ProvisionedAuthType; previous != "" && previous != provider.Spec.Auth.Type {
+		if err := r.cleanupPreviousAuthType(ctx, provider, llmAccess, previous); err != nil {
+			metrics.ReconciliationDuration.WithLabelValues("llmaccess", "error").Observe(time.Since(startTime).Seconds())
+			return ctrl.Result{}, err
 		}
-		// Permanent error — don't requeue until the spec changes.
-		return ctrl.Result{}, nil
+	}
+
+	// With lazy provisioning the target secret only exists while matching pods do.
+	lazy := llmAccess.Spec.Injection.LazyProvisioning
+	if lazy != nil && !r.LazyProvisioning {
+		r.Recorder.Event(llmAccess, corev1.EventTypeWarning, ReasonLazyProvisioningDisabled,
+			"spec.injection.lazyProvisioning is ignored because the controller runs without --enable-lazy-provisioning; the secret is provisioned eagerly")
+		lazy = nil
+	}
+	if lazy != nil {
+		idleDeadline, done, err := r.reconcileLazyProvisioning(ctx, prov, provider, llmAccess, lazy)
+		if err != nil {
+			metrics.ReconciliationDuration.WithLabelValues("llmaccess", "error").Observe(time.Since(startTime).Seconds())
+			return ctrl.Result{}, err
+		}
+		if done {
+			persistStatus = true
+			metrics.LLMAccessTotal.WithLabelValues(provider.Name, llmAccess.Namespace, "pending").Set(1)
+			metrics.ReconciliationDuration.WithLabelValues("llmaccess", "success").Observe(time.Since(startTime).Seconds())
+			return ctrl.Result{}, nil
+		}
+		if !idleDeadline.IsZero() {
+			// Come back when the grace period ends, whatever else is scheduled.
+			defer func() {
+				if retErr == nil {
+					result = r.requeueBeforeExpiry(result, idleDeadline)
+				}
+			}()
+		}
+	} else {
+		llmAccess.Status.IdleSince = nil
+	}
+
+	// Detect manual edits of the target secret before re-provisioning overwrites them.
+	// ESO owns the target secret for externalSecret auth, so we must not fight it.
+	drifted := false
+	if provider.Spec.Auth.Type != llmwardenv1alpha1.AuthTypeExternalSecret {
+		health, err := prov.HealthCheck(ctx, provider, llmAccess)
+		if err != nil {
+			logger.Error(err, "Drift check failed")
+		} else {
+			drifted = health.Drifted
+		}
+	}
+
+	// A rotation is a scheduled re-provision that has come due, or promoting apiKeyNext.
+	rotating := llmAccess.Status.NextRotation != nil && !r.clock().Before(llmAccess.Status.NextRotation.Time)
+
+	// A scheduled rotation that comes due outside the maintenance window waits for it.
+	window := getMaintenanceWindow(llmAccess, provider)
+	if window != nil {
+		if _, err := nextWindowOpening(window, r.clock()); err != nil {
+			logger.Error(err, "Ignoring invalid rotation maintenance window")
+			window = nil
+		}
+	}
+	var deferredUntil time.Time
+	if rotating && window != nil {
+		if opens, _ := nextWindowOpening(window, r.clock()); opens.After(r.clock()) {
+			rotating = false
+			deferredUntil = opens
+		}
+	}
+	wasPromoted := llmAccess.Status.KeyRotation != nil && llmAccess.Status.KeyRotation.Promoted
+
+	// Open or close the apiKey/apiKeyNext rotation window. This must follow the drift
+	// check, which compares the secret against the key it was last provisioned with.
+	promotionAt := r.advanceKeyRotation(llmAccess, provider)
+	promoting := !wasPromoted && llmAccess.Status.KeyRotation != nil && llmAccess.Status.KeyRotation.Promoted
+	if promoting {
+		rotating = true
 	}
 
 	// Provision credentials via the selected provisioner.
-	if _, err := prov.Provision(ctx, provider, llmAccess); err != nil {
+	provisionCtx, provisionSpan := tracing.Tracer().Start(ctx, tracing.SpanLLMAccessProvision,
+		trace.WithAttributes(attribute.String("llmwarden.auth_type", string(provider.Spec.Auth.Type))))
+	provisionResult, err := prov.Provision(provisionCtx, provider, llmAccess)
+	tracing.End(provisionSpan, err)
+	if errors.Is(err, provisioner.ErrSourceSecretForbidden) {
+		// A permissions problem won't fix itself on retry, so don't return the error and
+		// back off instead of hammering the apiserver.
+		logger.Error(err, "Forbidden from reading the provider's source secret")
+		message := fmt.Sprintf("llmwarden is not allowed to read the source secret of LLMProvider %s; "+
+			"grant the controller's ServiceAccount get on secrets in the source secret's namespace: %v", provider.Name, err)
+		r.Recorder.Event(llmAccess, corev1.EventTypeWarning, ReasonSourceSecretForbidden, message)
+		setCondition(&llmAccess.Status.Conditions, &llmAccess.Status.Ready, llmAccess.Generation, ConditionTypeReady, metav1.ConditionFalse, ReasonSourceSecretForbidden, message)
+		setCondition(&llmAccess.Status.Conditions, &llmAccess.Status.Ready, llmAccess.Generation, ConditionTypeCredentialProvisioned, metav1.ConditionFalse, ReasonSourceSecretForbidden, message)
+		persistStatus = true
+		metrics.SecretProvisioningTotal.WithLabelValues(provider.Name, llmAccess.Namespace, "error").Inc()
+		metrics.LLMAccessTotal.WithLabelValues(provider.Name, llmAccess.Namespace, "error").Set(1)
+		metrics.ReconciliationDuration.WithLabelValues("llmaccess", "error").Observe(time.Since(startTime).Seconds())
+		return ctrl.Result{RequeueAfter: sourceSecretForbiddenRequeueInterval}, nil
+	}
+	if errors.Is(err, provisioner.ErrTargetEqualsSourceSecret) {
+		// Only a spec change can resolve this, and that triggers a reconcile on its own.
+		logger.Error(err, "Refusing to overwrite the provider's source secret")
+		r.Recorder.Event(llmAccess, corev1.EventTypeWarning, ReasonTargetEqualsSourceSecret, err.Error())
+		setCondition(&llmAccess.Status.Conditions, &llmAccess.Status.Ready, llmAccess.Generation, ConditionTypeReady, metav1.ConditionFalse, ReasonTargetEqualsSourceSecret, err.Error())
+		setCondition(&llmAccess.Status.Conditions, &llmAccess.Status.Ready, llmAccess.Generation, ConditionTypeCredentialProvisioned, metav1.ConditionFalse, ReasonTargetEqualsSourceSecret, err.Error())
+		persistStatus = true
+		metrics.SecretProvisioningTotal.WithLabelValues(provider.Name, llmAccess.Namespace, "error").Inc()
+		metrics.LLMAccessTotal.WithLabelValues(provider.Name, llmAccess.Namespace, "error").Set(1)
+		metrics.ReconciliationDuration.WithLabelValues("llmaccess", "error").Observe(time.Since(startTime).Seconds())
+		return ctrl.Result{}, nil
+	}
+	if errors.Is(err, provisioner.ErrSecretTooLarge) {
+		// Retrying right away would fail the same way until the source value shrinks.
+		logger.Error(err, "Refusing to write an oversized target secret")
+		r.Recorder.Event(llmAccess, corev1.EventTypeWarning, ReasonSecretTooLarge, err.Error())
+		setCondition(&llmAccess.Status.Conditions, &llmAccess.Status.Ready, llmAccess.Generation, ConditionTypeReady, metav1.ConditionFalse, ReasonSecretTooLarge, err.Error())
+		setCondition(&llmAccess.Status.Conditions, &llmAccess.Status.Ready, llmAccess.Generation, ConditionTypeCredentialProvisioned, metav1.ConditionFalse, ReasonSecretTooLarge, err.Error())
+		persistStatus = true
+		metrics.SecretProvisioningTotal.WithLabelValues(provider.Name, llmAccess.Namespace, "error").Inc()
+		metrics.LLMAccessTotal.WithLabelValues(provider.Name, llmAccess.Namespace, "error").Set(1)
+		metrics.ReconciliationDuration.WithLabelValues("llmaccess", "error").Observe(time.Since(startTime).Seconds())
+		return ctrl.Result{RequeueAfter: secretTooLargeRequeueInterval}, nil
+	}
+	if errors.Is(err, provisioner.ErrExternalSecretRejected) {
+		// Creating it for real would fail the same way on every retry; wait for the provider
+		// or ESO's configuration to change instead.
+		logger.Error(err, "ExternalSecret failed dry-run validation")
+		r.Recorder.Event(llmAccess, corev1.EventTypeWarning, ReasonExternalSecretRejected, err.Error())
+		setCondition(&llmAccess.Status.Conditions, &llmAccess.Status.Ready, llmAccess.Generation, ConditionTypeReady, metav1.ConditionFalse, ReasonExternalSecretRejected, err.Error())
+		setCondition(&llmAccess.Status.Conditions, &llmAccess.Status.Ready, llmAccess.Generation, ConditionTypeCredentialProvisioned, metav1.ConditionFalse, ReasonExternalSecretRejected, err.Error())
+		persistStatus = true
+		metrics.SecretProvisioningTotal.WithLabelValues(provider.Name, llmAccess.Namespace, "error").Inc()
+		metrics.LLMAccessTotal.WithLabelValues(provider.Name, llmAccess.Namespace, "error").Set(1)
+		metrics.ReconciliationDuration.WithLabelValues("llmaccess", "error").Observe(time.Since(startTime).Seconds())
+		return ctrl.Result{RequeueAfter: externalSecretRejectedRequeueInterval}, nil
+	}
+	if err != nil {
 		logger.Error(err, "Failed to provision secret")
-		r.Recorder.Event(llmAccess, corev1.EventTypeWarning, ReasonSecretUpdateFailed,
-			fmt.Sprintf("Failed to provision credentials: %v", err))
-		setCondition(&llmAccess.Status.Conditions, llmAccess.Generation, ConditionTypeReady, metav1.ConditionFalse, ReasonReconciliationError,
-			fmt.Sprintf("Failed to provision credentials: %v", err))
-		setCondition(&llmAccess.Status.Conditions, llmAccess.Generation, ConditionTypeCredentialProvisioned, metav1.ConditionFalse, ReasonSecretUpdateFailed, err.Error())
-		if err := r.Status().Update(ctx, llmAccess); err != nil {
-			metrics.ReconciliationDuration.WithLabelValues("llmaccess", "error").Observe(time.Since(startTime).Seconds())
-			return ctrl.Result{}, fmt.Errorf("failed to update status: %w", err)
+		message := err.Error()
+		if hint := provisioner.Remediation(err); hint != "" {
+			message = fmt.Sprintf("%s. %s", message, hint)
 		}
+		r.Recorder.Event(llmAccess, corev1.EventTypeWarning, ReasonSecretUpdateFailed,
+			"Failed to provision credentials: "+message)
+		setCondition(&llmAccess.Status.Conditions, &llmAccess.Status.Ready, llmAccess.Generation, ConditionTypeReady, metav1.ConditionFalse, ReasonReconciliationError,
+			"Failed to provision credentials: "+message)
+		setCondition(&llmAccess.Status.Conditions, &llmAccess.Status.Ready, llmAccess.Generation, ConditionTypeCredentialProvisioned, metav1.ConditionFalse, ReasonSecretUpdateFailed, message)
+		persistStatus = true
 		metrics.SecretProvisioningTotal.WithLabelValues(provider.Name, llmAccess.Namespace, "error").Inc()
 		metrics.LLMAccessTotal.WithLabelValues(provider.Name, llmAccess.Namespace, "error").Set(1)
 		metrics.ReconciliationDuration.WithLabelValues("llmaccess", "error").Observe(time.Since(startTime).Seconds())
 		return ctrl.Result{RequeueAfter: 30 * time.Second}, err
 	}
 
+	if previous := provisionResult.PreviousRefreshInterval; previous != "" {
+		r.Recorder.Event(llmAccess, corev1.EventTypeNormal, ReasonRefreshIntervalUpdated,
+			fmt.Sprintf("Updated refreshInterval of ExternalSecret %s from %s to %s in place",
+				llmAccess.Spec.SecretName, previous, provisionResult.Metadata["refreshInterval"]))
+	}
+
+	// ESO populates the target secret asynchronously; don't report Ready until it has.
+	// ESO doesn't trigger our watches when it syncs, so poll until it does.
+	llmAccess.Status.SourceSecretRef = provisionResult.Source
+	if previous := llmAccess.Status.ProvisionedAuthType; previous != "" && previous != provider.Spec.Auth.Type {
+		llmAccess.Status.LastAuthTypeChange = &llmwardenv1alpha1.AuthTypeChange{
+			From: previous, To: provider.Spec.Auth.Type, Time: metav1.NewTime(r.clock()),
+		}
+	}
+	llmAccess.Status.ProvisionedAuthType = provider.Spec.Auth.Type
+	// Only the ExternalSecret provisioner reports a refresh interval; other auth types clear it.
+	llmAccess.Status.EffectiveRefreshInterval = provisionResult.Metadata["refreshInterval"]
+	llmAccess.Status.ResolvedStore = resolvedStore(provisionResult.Metadata)
+	if provisionResult.Pending {
+		logger.Info("Waiting for ExternalSecret to sync", "externalSecret", llmAccess.Spec.SecretName, "message", provisionResult.PendingMessage)
+		r.setProvisionedModels(llmAccess, provider, nsLabels)
+		setCondition(&llmAccess.Status.Conditions, &llmAccess.Status.Ready, llmAccess.Generation, ConditionTypeCredentialProvisioned, metav1.ConditionTrue, ReasonSecretCreated,
+			"ExternalSecret created/updated successfully")
+		setCondition(&llmAccess.Status.Conditions, &llmAccess.Status.Ready, llmAccess.Generation, ConditionTypeReady, metav1.ConditionFalse, ReasonExternalSecretNotSynced,
+			fmt.Sprintf("Waiting for ESO to sync ExternalSecret %s: %s", llmAccess.Spec.SecretName, provisionResult.PendingMessage))
+		persistStatus = true
+		metrics.LLMAccessTotal.WithLabelValues(provider.Name, llmAccess.Namespace, "pending").Set(1)
+		metrics.ReconciliationDuration.WithLabelValues("llmaccess", "success").Observe(time.Since(startTime).Seconds())
+		return ctrl.Result{RequeueAfter: externalSecretSyncRequeue(provisionResult.Metadata["refreshInterval"])}, nil
+	}
+
+	// ESO's Ready condition doesn't say whether the synced secret has the key we need.
+	if provider.Spec.Auth.Type == llmwardenv1alpha1.AuthTypeExternalSecret {
+		health, err := prov.HealthCheck(ctx, provider, llmAccess)
+		if err != nil {
+			logger.Error(err, "Failed to check the synced secret")
+		} else if !health.Healthy && health.Reason == ReasonSyncedButKeyMissing {
+			logger.Info("Synced secret is missing the credential key", "secret", llmAccess.Spec.SecretName)
+			r.Recorder.Event(llmAccess, corev1.EventTypeWarning, ReasonSyncedButKeyMissing, health.Message)
+			r.setProvisionedModels(llmAccess, provider, nsLabels)
+			setCondition(&llmAccess.Status.Conditions, &llmAccess.Status.Ready, llmAccess.Generation, ConditionTypeCredentialProvisioned, metav1.ConditionFalse, ReasonSyncedButKeyMissing, health.Message)
+			setCondition(&llmAccess.Status.Conditions, &llmAccess.Status.Ready, llmAccess.Generation, ConditionTypeReady, metav1.ConditionFalse, ReasonSyncedButKeyMissing, health.Message)
+			persistStatus = true
+			metrics.LLMAccessTotal.WithLabelValues(provider.Name, llmAccess.Namespace, "error").Set(1)
+			metrics.ReconciliationDuration.WithLabelValues("llmaccess", "error").Observe(time.Since(startTime).Seconds())
+			// ESO may pick up a fixed remote entry on its next refresh without any change here.
+			return ctrl.Result{RequeueAfter: externalSecretSyncRequeue(provisionResult.Metadata["refreshInterval"])}, nil
+		}
+	}
+
 	// Update status - credentials provisioned successfully
-	now := metav1.Now()
+	now := metav1.NewTime(r.clock())
 	llmAccess.Status.SecretRef = &corev1.ObjectReference{
 		Kind:      "Secret",
 		Namespace: llmAccess.Namespace,
 		Name:      llmAccess.Spec.SecretName,
 	}
 	llmAccess.Status.LastRotation = &now
-	llmAccess.Status.ProvisionedModels = llmAccess.Spec.Models
+	r.setProvisionedModels(llmAccess, provider, nsLabels)
 
 	// Calculate next rotation time
 	rotationInterval := r.getRotationInterval(llmAccess, provider)
-	if rotationInterval > 0 {
+	switch {
+	case !deferredUntil.IsZero():
+		// Keep the deferred rotation rather than pushing it a whole interval out.
+		llmAccess.Status.NextRotation = &metav1.Time{Time: deferredUntil}
+		r.Recorder.Event(llmAccess, corev1.EventTypeNormal, ReasonRotationDeferred,
+			fmt.Sprintf("Rotation deferred until the maintenance window opens at %s", deferredUntil.Format(time.RFC3339)))
+	case rotationInterval > 0 && window != nil:
+		// Keep a rotation planned or deferred by an earlier reconcile, so reconciles in
+		// between don't push it out; otherwise plan it for the first window after the
+		// interval.
+		nextRotation := now.Add(rotationInterval)
+		pending := llmAccess.Status.NextRotation
+		if rotating || pending == nil || !pending.Time.After(now.Time) || !pending.Time.Before(nextRotation) {
+			nextRotation, _ = nextWindowOpening(window, nextRotation)
+			llmAccess.Status.NextRotation = &metav1.Time{Time: nextRotation}
+		}
+	case rotationInterval > 0:
 		nextRotation := metav1.NewTime(now.Add(rotationInterval))
 		llmAccess.Status.NextRotation = &nextRotation
 	}
 
-	setCondition(&llmAccess.Status.Conditions, llmAccess.Generation, ConditionTypeCredentialProvisioned, metav1.ConditionTrue, ReasonSecretCreated,
+	setCondition(&llmAccess.Status.Conditions, &llmAccess.Status.Ready, llmAccess.Generation, ConditionTypeCredentialProvisioned, metav1.ConditionTrue, ReasonSecretCreated,
 		"Secret created/updated successfully")
-	setCondition(&llmAccess.Status.Conditions, llmAccess.Generation, ConditionTypeReady, metav1.ConditionTrue, ReasonCredentialProvisioned,
+	setCondition(&llmAccess.Status.Conditions, &llmAccess.Status.Ready, llmAccess.Generation, ConditionTypeReady, metav1.ConditionTrue, ReasonCredentialProvisioned,
 		"Credentials provisioned and ready")
 
-	if err := r.Status().Update(ctx, llmAccess); err != nil {
-		metrics.ReconciliationDuration.WithLabelValues("llmaccess", "error").Observe(time.Since(startTime).Seconds())
-		return ctrl.Result{}, fmt.Errorf("failed to update status: %w", err)
+	persistStatus = true
+
+	// Mirror where the credential came from onto the access for kubectl describe.
+	metadata := surfacedMetadata(provisionResult.Metadata)
+	var exported string
+	if r.ExportProvisionResult {
+		if exported, err = exportProvisionResult(provisionResult, metadata); err != nil {
+			logger.Error(err, "Failed to serialize provision result")
+		}
+	}
+	if err := r.syncMetadataAnnotations(ctx, llmAccess, metadata, exported); err != nil {
+		logger.Error(err, "Failed to annotate LLMAccess with provisioner metadata")
 	}
 
 	// Emit success event
-	r.Recorder.Event(llmAccess, corev1.EventTypeNormal, ReasonCredentialProvisioned,
-		fmt.Sprintf("Successfully provisioned credentials for provider %s", provider.Name))
+	message := fmt.Sprintf("Successfully provisioned credentials for provider %s", provider.Name)
+	if summary := metadataSummary(metadata); summary != "" {
+		message += " (" + summary + ")"
+	}
+	r.Recorder.Event(llmAccess, corev1.EventTypeNormal, ReasonCredentialProvisioned, message)
+
+	if provisionResult.EndpointChanged {
+		r.Recorder.Event(llmAccess, corev1.EventTypeNormal, ReasonEndpointUpdated,
+			fmt.Sprintf("Updated endpoint in secret %s after LLMProvider %s changed", llmAccess.Spec.SecretName, provider.Name))
+	}
+
+	if rotating {
+		metrics.CredentialRotationsTotal.WithLabelValues(provider.Name, llmAccess.Namespace).Inc()
+		recordRotation(llmAccess, provider, provisionResult, now, promoting)
+		r.notifyRotation(ctx, provider, llmAccess, now.Time)
+	}
+
+	if drifted {
+		logger.Info("Repaired drifted target secret", "secret", llmAccess.Spec.SecretName)
+		r.Recorder.Event(llmAccess, corev1.EventTypeWarning, ReasonDriftRepaired,
+			fmt.Sprintf("Secret %s was modified outside llmwarden and has been restored", llmAccess.Spec.SecretName))
+		metrics.DriftRepairsTotal.WithLabelValues(provider.Name, llmAccess.Namespace).Inc()
+	}
 
 	// Update metrics for successful reconciliation
 	metrics.SecretProvisioningTotal.WithLabelValues(provider.Name, llmAccess.Namespace, "success").Inc()
@@ -259,16 +748,470 @@ func (r *LLMAccessReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	metrics.ReconciliationDuration.WithLabelValues("llmaccess", "success").Observe(time.Since(startTime).Seconds())
 	logger.Info("Successfully reconciled LLMAccess", "namespace", llmAccess.Namespace, "name", llmAccess.Name)
 
+	// With a maintenance window the next rotation isn't a whole interval away.
+	untilRotation := rotationInterval
+	if rotationInterval > 0 && window != nil {
+		untilRotation = max(llmAccess.Status.NextRotation.Sub(r.clock()), time.Second)
+	}
+
+	// Come back to promote the next key when its rotation window closes
+	if !promotionAt.IsZero() {
+		untilPromotion := max(promotionAt.Sub(r.clock()), time.Second)
+		if untilRotation == 0 || untilPromotion < untilRotation {
+			return ctrl.Result{RequeueAfter: untilPromotion}, nil
+		}
+	}
+
 	// Requeue before next rotation
-	if rotationInterval > 0 {
-		return ctrl.Result{RequeueAfter: rotationInterval}, nil
+	if untilRotation > 0 {
+		return ctrl.Result{RequeueAfter: untilRotation}, nil
 	}
 
 	return ctrl.Result{}, nil
 }
 
-// selectProvisioner returns the Provisioner implementation for the given auth type.
+// finalizer returns the finalizer the reconciler adds to accesses.
+func (r *LLMAccessReconciler) finalizer() string {
+	if r.Finalizer != "" {
+		return r.Finalizer
+	}
+	return llmAccessFinalizer
+}
+
+// hasFinalizer reports whether the access carries the configured finalizer or the default
+// one, which accesses created before a rename or opt-out may still have.
+func (r *LLMAccessReconciler) hasFinalizer(llmAccess *llmwardenv1alpha1.LLMAccess) bool {
+	return controllerutil.ContainsFinalizer(llmAccess, r.finalizer()) ||
+		controllerutil.ContainsFinalizer(llmAccess, llmAccessFinalizer)
+}
+
+// removeFinalizers removes the configured and the default finalizer from the access and
+// reports whether it changed.
+func (r *LLMAccessReconciler) removeFinalizers(llmAccess *llmwardenv1alpha1.LLMAccess) bool {
+	removed := controllerutil.RemoveFinalizer(llmAccess, r.finalizer())
+	return controllerutil.RemoveFinalizer(llmAccess, llmAccessFinalizer) || removed
+}
+
+// syncFinalizers leaves a live access with exactly the configured finalizer, or none when
+// finalizers are disabled, and reports whether it changed.
+func (r *LLMAccessReconciler) syncFinalizers(llmAccess *llmwardenv1alpha1.LLMAccess) bool {
+	if r.DisableFinalizer {
+		return r.removeFinalizers(llmAccess)
+	}
+	changed := controllerutil.AddFinalizer(llmAccess, r.finalizer())
+	if r.finalizer() != llmAccessFinalizer {
+		changed = controllerutil.RemoveFinalizer(llmAccess, llmAccessFinalizer) || changed
+	}
+	return changed
+}
+
+// notifyRotation posts a rotation event to the configured notifier. Failures are logged and
+// counted; they never fail the reconcile.
+func (r *LLMAccessReconciler) notifyRotation(ctx context.Context, provider *llmwardenv1alpha1.LLMProvider, llmAccess *llmwardenv1alpha1.LLMAccess, rotatedAt time.Time) {
+	if r.RotationNotifier == nil {
+		return
+	}
+	err := r.RotationNotifier.Notify(ctx, notify.RotationEvent{
+		Access:    llmAccess.Name,
+		Namespace: llmAccess.Namespace,
+		Provider:  provider.Name,
+		RotatedAt: rotatedAt.UTC(),
+	})
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to send rotation notification")
+		metrics.RotationNotificationFailures.WithLabelValues(provider.Name, llmAccess.Namespace).Inc()
+	}
+}
+
+// rotationHistoryLimit caps status.rotationHistory, matching its MaxItems; the oldest
+// records are dropped first.
+const rotationHistoryLimit = 10
+
+// recordRotation appends the rotation that was just provisioned to the access's rotation
+// history. promotion says whether it promoted the provider's next API key rather than
+// being a scheduled rotation.
+func recordRotation(llmAccess *llmwardenv1alpha1.LLMAccess, provider *llmwardenv1alpha1.LLMProvider,
+	result *provisioner.ProvisionResult, at metav1.Time, promotion bool) {
+	strategy := llmwardenv1alpha1.RotationStrategyKeyPromotion
+	if !promotion {
+		strategy = string(llmwardenv1alpha1.RotationStrategyProviderAPI)
+		if auth := provider.Spec.Auth.APIKey; auth != nil && auth.Rotation != nil && auth.Rotation.Strategy != "" {
+			strategy = string(auth.Rotation.Strategy)
+		}
+	}
+	history := append(llmAccess.Status.RotationHistory, llmwardenv1alpha1.RotationRecord{
+		Time:     at,
+		FromHash: result.PreviousCredentialHash,
+		ToHash:   result.CredentialHash,
+		Strategy: strategy,
+	})
+	if excess := len(history) - rotationHistoryLimit; excess > 0 {
+		history = history[excess:]
+	}
+	llmAccess.Status.RotationHistory = history
+}
+
+// advanceKeyRotation moves the access's key rotation through its window: it opens a window
+// when the provider configures a new nextSecretRef, promotes the next key once the window
+// has elapsed, and clears the state when the provider has no next key. It returns when the
+// open window ends, or the zero time when no promotion is pending.
+func (r *LLMAccessReconciler) advanceKeyRotation(llmAccess *llmwardenv1alpha1.LLMAccess, provider *llmwardenv1alpha1.LLMProvider) time.Time {
+	auth := provider.Spec.Auth.APIKey
+	if provider.Spec.Auth.Type != llmwardenv1alpha1.AuthTypeAPIKey || auth == nil || auth.NextSecretRef == nil {
+		llmAccess.Status.KeyRotation = nil
+		return time.Time{}
+	}
+
+	rotation := llmAccess.Status.KeyRotation
+	window := defaultKeyRotationWindow
+	if auth.RotationWindow != "" {
+		if d, err := parseDuration(auth.RotationWindow); err == nil {
+			window = d
+		}
+	}
+	if rotation == nil || rotation.NextSecretRef != *auth.NextSecretRef {
+		rotation = &llmwardenv1alpha1.KeyRotationStatus{
+			NextSecretRef: *auth.NextSecretRef,
+			WindowStart:   metav1.NewTime(r.clock()),
+		}
+		llmAccess.Status.KeyRotation = rotation
+		r.Recorder.Event(llmAccess, corev1.EventTypeNormal, ReasonKeyRotationStarted,
+			fmt.Sprintf("Serving apiKey and apiKeyNext in secret %s until %s", llmAccess.Spec.SecretName,
+				rotation.WindowStart.Add(window).UTC().Format(time.RFC3339)))
+	}
+	if rotation.Promoted {
+		return time.Time{}
+	}
+
+	windowEnd := rotation.WindowStart.Add(window)
+	if r.clock().Before(windowEnd) {
+		return windowEnd
+	}
+	rotation.Promoted = true
+	r.Recorder.Event(llmAccess, corev1.EventTypeNormal, ReasonNextKeyPromoted,
+		fmt.Sprintf("Promoted apiKeyNext to apiKey in secret %s", llmAccess.Spec.SecretName))
+	return time.Time{}
+}
+
+// externalSecretSyncRequeue returns how long to wait before re-checking an ExternalSecret
+// that has not synced yet: its refresh interval, since ESO retries no sooner, capped at
+// externalSecretSyncRequeueInterval so a long interval doesn't delay reporting a sync
+// that ESO completes right after creation.
+func externalSecretSyncRequeue(refreshInterval string) time.Duration {
+	interval, err := parseDuration(refreshInterval)
+	if err != nil {
+		// ESO also accepts Go durations such as "90s" or "1h30m".
+		interval, err = time.ParseDuration(refreshInterval)
+	}
+	if err != nil || interval <= 0 || interval > externalSecretSyncRequeueInterval {
+		return externalSecretSyncRequeueInterval
+	}
+	return max(interval, time.Second)
+}
+
+// requeueBeforeExpiry shortens result's requeue so the next reconcile happens no later than expiresAt.
+func (r *LLMAccessReconciler) requeueBeforeExpiry(result ctrl.Result, expiresAt time.Time) ctrl.Result {
+	untilExpiry := expiresAt.Sub(r.clock())
+	if untilExpiry < time.Second {
+		untilExpiry = time.Second
+	}
+	if result.RequeueAfter == 0 || untilExpiry < result.RequeueAfter {
+		result.RequeueAfter = untilExpiry
+	}
+	return result
+}
+
+func (r *LLMAccessReconciler) clock() time.Time {
+	if r.now != nil {
+		return r.now()
+	}
+	return time.Now()
+}
+
+// patchStatus derives the phase from the current conditions and, when the status differs
+// from original's, persists it with a single merge patch. The patch carries no
+// resourceVersion, so metadata writes earlier in the reconcile don't make it conflict.
+func (r *LLMAccessReconciler) patchStatus(ctx context.Context, original, llmAccess *llmwardenv1alpha1.LLMAccess) error {
+	llmAccess.Status.Phase = computePhase(llmAccess.Status.Conditions)
+	if equality.Semantic.DeepEqual(original.Status, llmAccess.Status) {
+		return nil
+	}
+	ctx, span := tracing.Tracer().Start(ctx, tracing.SpanLLMAccessUpdateStatus)
+	err := r.Status().Patch(ctx, llmAccess, client.MergeFrom(original))
+	tracing.End(span, err)
+	return err
+}
+
+// defaultIdleGracePeriod is how long lazy provisioning keeps an unused secret when the
+// access doesn't set idleGracePeriod.
+const defaultIdleGracePeriod = 24 * time.Hour
+
+// reconcileLazyProvisioning applies lazy provisioning to an access. done reports that the
+// reconcile should stop, with the status updated to say so, because no matching pod exists
+// and the secret either hasn't been provisioned yet or has just been deleted after the
+// idle grace period. Otherwise
+// provisioning continues, and a non-zero idleDeadline is when the idle secret is due for
+// deletion.
+func (r *LLMAccessReconciler) reconcileLazyProvisioning(ctx context.Context, prov provisioner.Provisioner, provider *llmwardenv1alpha1.LLMProvider,
+	llmAccess *llmwardenv1alpha1.LLMAccess, lazy *llmwardenv1alpha1.LazyProvisioningConfig) (idleDeadline time.Time, done bool, err error) {
+	logger := log.FromContext(ctx)
+
+	matching, err := r.hasMatchingPods(ctx, llmAccess)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	if matching {
+		llmAccess.Status.IdleSince = nil
+		return time.Time{}, false, nil
+	}
+
+	// Nothing has been provisioned yet, so wait for the first matching pod.
+	if llmAccess.Status.ProvisionedAuthType == "" {
+		llmAccess.Status.IdleSince = nil
+		message := "No running pod matches this LLMAccess; the secret is provisioned once one does"
+		setCondition(&llmAccess.Status.Conditions, &llmAccess.Status.Ready, llmAccess.Generation, ConditionTypeCredentialProvisioned, metav1.ConditionFalse, ReasonAwaitingWorkload, message)
+		setCondition(&llmAccess.Status.Conditions, &llmAccess.Status.Ready, llmAccess.Generation, ConditionTypeReady, metav1.ConditionFalse, ReasonAwaitingWorkload, message)
+		return time.Time{}, true, nil
+	}
+
+	grace := defaultIdleGracePeriod
+	if lazy.IdleGracePeriod != "" {
+		if parsed, err := parseDuration(lazy.IdleGracePeriod); err == nil {
+			grace = parsed
+		} else {
+			logger.Error(err, "Invalid lazyProvisioning.idleGracePeriod, using the default", "default", defaultIdleGracePeriod)
+		}
+	}
+	if llmAccess.Status.IdleSince == nil {
+		idleSince := metav1.NewTime(r.clock())
+		llmAccess.Status.IdleSince = &idleSince
+	}
+	idleDeadline = llmAccess.Status.IdleSince.Add(grace)
+	if r.clock().Before(idleDeadline) {
+		return idleDeadline, false, nil
+	}
+
+	if err := prov.Cleanup(ctx, provider, llmAccess); err != nil {
+		return time.Time{}, false, fmt.Errorf("failed to delete idle secret: %w", err)
+	}
+	logger.Info("Deleted idle secret", "secret", llmAccess.Spec.SecretName, "idleSince", llmAccess.Status.IdleSince.Time)
+	r.Recorder.Event(llmAccess, corev1.EventTypeNormal, ReasonIdleSecretRemoved,
+		fmt.Sprintf("Deleted secret %s after no pod matched for %s", llmAccess.Spec.SecretName, grace))
+
+	llmAccess.Status.ProvisionedAuthType = ""
+	llmAccess.Status.SecretRef = nil
+	llmAccess.Status.SourceSecretRef = nil
+	llmAccess.Status.EffectiveRefreshInterval = ""
+	llmAccess.Status.ResolvedStore = nil
+	llmAccess.Status.NextRotation = nil
+	llmAccess.Status.IdleSince = nil
+	message := fmt.Sprintf("Secret %s was deleted after no pod matched for %s; it is provisioned again once one does",
+		llmAccess.Spec.SecretName, grace)
+	setCondition(&llmAccess.Status.Conditions, &llmAccess.Status.Ready, llmAccess.Generation, ConditionTypeCredentialProvisioned, metav1.ConditionFalse, ReasonAwaitingWorkload, message)
+	setCondition(&llmAccess.Status.Conditions, &llmAccess.Status.Ready, llmAccess.Generation, ConditionTypeReady, metav1.ConditionFalse, ReasonAwaitingWorkload, message)
+	return time.Time{}, true, nil
+}
+
+// hasMatchingPods reports whether a running or pending pod in the access's namespace
+// matches it the way the pod injector does.
+func (r *LLMAccessReconciler) hasMatchingPods(ctx context.Context, llmAccess *llmwardenv1alpha1.LLMAccess) (bool, error) {
+	podList := &corev1.PodList{}
+	if err := r.podReader().List(ctx, podList, client.InNamespace(llmAccess.Namespace)); err != nil {
+		return false, fmt.Errorf("listing pods: %w", err)
+	}
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if podTerminal(pod) || !pod.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if webhookv1alpha1.PodMatchesAccess(pod, llmAccess) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// podReader returns PodReader, or the client when it is unset.
+func (r *LLMAccessReconciler) podReader() client.Reader {
+	if r.PodReader != nil {
+		return r.PodReader
+	}
+	return r.Client
+}
+
+// podTerminal reports whether a pod has finished and will never run containers again.
+func podTerminal(pod *corev1.Pod) bool {
+	return pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed
+}
+
+// podMatchChanged passes the pod events that can change whether a pod counts towards
+// lazy provisioning: creation, deletion, and label or annotation changes. The watch only
+// sees pod metadata, so a pod that finishes stops counting at the access's next reconcile
+// or when it is deleted.
+var podMatchChanged = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		if e.ObjectOld == nil || e.ObjectNew == nil {
+			return false
+		}
+		// Any annotation may be named by an annotationSelector, besides the access annotation.
+		return !maps.Equal(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels()) ||
+			!maps.Equal(e.ObjectOld.GetAnnotations(), e.ObjectNew.GetAnnotations())
+	},
+	GenericFunc: func(event.GenericEvent) bool { return false },
+}
+
+// ProvisionMetadataAnnotationPrefix prefixes the LLMAccess annotations that mirror selected
+// provisioner result metadata, e.g. provision.llmwarden.io/sourceSecret, or
+// provision.<prefix>/sourceSecret under a custom label prefix.
+func ProvisionMetadataAnnotationPrefix() string { return "provision." + labelkeys.Prefix() + "/" }
+
+// surfacedMetadataKeys are the ProvisionResult.Metadata keys surfaced in events and
+// annotations, in display order. They name resources and settings, never secret values.
+var surfacedMetadataKeys = []string{"sourceSecret", "targetSecret", "store", "storeKind", "refreshInterval", "syncReady"}
+
+// surfacedMetadata returns the subset of a provisioner's result metadata that is safe and
+// useful to show users.
+func surfacedMetadata(metadata map[string]string) map[string]string {
+	surfaced := make(map[string]string, len(surfacedMetadataKeys))
+	for _, key := range surfacedMetadataKeys {
+		if value := metadata[key]; value != "" {
+			surfaced[key] = value
+		}
+	}
+	return surfaced
+}
+
+// metadataSummary renders surfaced metadata as "key=value, ..." in a stable order.
+func metadataSummary(metadata map[string]string) string {
+	parts := make([]string, 0, len(metadata))
+	for _, key := range surfacedMetadataKeys {
+		if value, ok := metadata[key]; ok {
+			parts = append(parts, key+"="+value)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ProvisionResultAnnotation holds the access's last provisioning result as JSON when the
+// controller runs with --export-provision-result. See exportedProvisionResult for its shape.
+func ProvisionResultAnnotation() string { return labelkeys.Key("provision-result") }
+
+// exportedProvisionResult is the JSON shape of ProvisionResultAnnotation, a stable surface for
+// GitOps tooling and other controllers: fields may be added but are never renamed. It is
+// built from names and settings only and never carries a credential.
+type exportedProvisionResult struct {
+	SecretName      string            `json:"secretName"`
+	SecretNamespace string            `json:"secretNamespace"`
+	SecretKeys      []string          `json:"secretKeys,omitempty"`
+	ProvisionedAt   time.Time         `json:"provisionedAt"`
+	ExpiresAt       *time.Time        `json:"expiresAt,omitempty"`
+	NeedsRotation   bool              `json:"needsRotation"`
+	Metadata        map[string]string `json:"metadata,omitempty"`
+}
+
+// exportProvisionResult renders a provisioning result for ProvisionResultAnnotation. Only the
+// surfaced metadata is included, since provisioners may put free-form messages in the rest.
+func exportProvisionResult(result *provisioner.ProvisionResult, surfaced map[string]string) (string, error) {
+	exported := exportedProvisionResult{
+		SecretName:      result.SecretName,
+		SecretNamespace: result.SecretNamespace,
+		SecretKeys:      result.SecretKeys,
+		ProvisionedAt:   result.ProvisionedAt.UTC().Truncate(time.Second),
+		ExpiresAt:       result.ExpiresAt,
+		NeedsRotation:   result.NeedsRotation,
+	}
+	if len(surfaced) > 0 {
+		exported.Metadata = surfaced
+	}
+	data, err := json.Marshal(exported)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// syncMetadataAnnotations sets a ProvisionMetadataAnnotationPrefix annotation per surfaced
+// metadata key and removes the ones a previous provisioner set that no longer apply. It sets
+// ProvisionResultAnnotation to exported, or removes it when exported is empty. It only
+// patches when something changed.
+func (r *LLMAccessReconciler) syncMetadataAnnotations(ctx context.Context, llmAccess *llmwardenv1alpha1.LLMAccess,
+	metadata map[string]string, exported string) error {
+	original := llmAccess.DeepCopy()
+	changed := false
+	if current, ok := llmAccess.Annotations[ProvisionResultAnnotation()]; ok && exported == "" {
+		delete(llmAccess.Annotations, ProvisionResultAnnotation())
+		changed = true
+	} else if exported != "" && current != exported {
+		if llmAccess.Annotations == nil {
+			llmAccess.Annotations = make(map[string]string)
+		}
+		llmAccess.Annotations[ProvisionResultAnnotation()] = exported
+		changed = true
+	}
+	for name := range llmAccess.Annotations {
+		key, ok := strings.CutPrefix(name, ProvisionMetadataAnnotationPrefix())
+		if _, keep := metadata[key]; ok && !keep {
+			delete(llmAccess.Annotations, name)
+			changed = true
+		}
+	}
+	for key, value := range metadata {
+		name := ProvisionMetadataAnnotationPrefix() + key
+		if llmAccess.Annotations[name] == value {
+			continue
+		}
+		if llmAccess.Annotations == nil {
+			llmAccess.Annotations = make(map[string]string)
+		}
+		llmAccess.Annotations[name] = value
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	// Patch a copy: the response carries the stored status, which would overwrite the one
+	// the reconcile has yet to write.
+	return r.Patch(ctx, llmAccess.DeepCopy(), client.MergeFrom(original))
+}
+
+// cleanupPreviousAuthType calls Cleanup on the provisioner for the auth type the access was
+// last provisioned with. Both provisioners name their resources after spec.secretName, so the
+// current provider is enough to locate them.
+func (r *LLMAccessReconciler) cleanupPreviousAuthType(ctx context.Context, provider *llmwardenv1alpha1.LLMProvider, llmAccess *llmwardenv1alpha1.LLMAccess, previous llmwardenv1alpha1.AuthType) error {
+	logger := log.FromContext(ctx)
+
+	prev, err := r.selectProvisioner(previous)
+	if err != nil {
+		// Nothing we know how to clean up; proceed with the new auth type.
+		logger.Info("No provisioner for previous auth type, skipping cleanup", "authType", previous)
+		return nil
+	}
+	// The built-in provisioners write spec.secretName, so pods referencing the old secret
+	// pick up the new one and must not hold up the switch.
+	if _, custom := provisioner.Lookup(provider.Spec.Auth.Type); !custom &&
+		(provider.Spec.Auth.Type == llmwardenv1alpha1.AuthTypeAPIKey || provider.Spec.Auth.Type == llmwardenv1alpha1.AuthTypeExternalSecret) {
+		ctx = provisioner.WithSecretReplaced(ctx)
+	}
+	if err := prev.Cleanup(ctx, providerWithAuthType(provider, previous), llmAccess); err != nil {
+		if errors.Is(err, provisioner.ErrSecretInUse) {
+			r.Recorder.Event(llmAccess, corev1.EventTypeWarning, ReasonSecretInUse, err.Error())
+		}
+		return fmt.Errorf("failed to clean up %s resources after auth type change: %w", previous, err)
+	}
+
+	logger.Info("Cleaned up resources of previous auth type", "from", previous, "to", provider.Spec.Auth.Type)
+	r.Recorder.Event(llmAccess, corev1.EventTypeNormal, ReasonAuthTypeChanged,
+		fmt.Sprintf("LLMProvider %s switched auth type from %s to %s; removed resources created for %s",
+			provider.Name, previous, provider.Spec.Auth.Type, previous))
+	return nil
+}
+
+// selectProvisioner returns the Provisioner implementation for the given auth type. A
+// factory registered with provisioner.Register takes precedence over the built-ins.
 func (r *LLMAccessReconciler) selectProvisioner(authType llmwardenv1alpha1.AuthType) (provisioner.Provisioner, error) {
+	if factory, ok := provisioner.Lookup(authType); ok {
+		return factory(r.Client, r.Scheme), nil
+	}
 	switch authType {
 	case llmwardenv1alpha1.AuthTypeAPIKey:
 		if r.ApiKeyProvisioner == nil {
@@ -285,6 +1228,131 @@ func (r *LLMAccessReconciler) selectProvisioner(authType llmwardenv1alpha1.AuthT
 	}
 }
 
+// selectAuth returns the provider as seen through the first of its auth strategies, spec.auth
+// followed by spec.auth.fallbacks, that has a provisioner, together with that provisioner.
+// The provider itself is returned when spec.auth is usable.
+func (r *LLMAccessReconciler) selectAuth(provider *llmwardenv1alpha1.LLMProvider) (*llmwardenv1alpha1.LLMProvider, provisioner.Provisioner, error) {
+	prov, err := r.selectProvisioner(provider.Spec.Auth.Type)
+	if err == nil {
+		return provider, prov, nil
+	}
+	for _, fallback := range provider.Spec.Auth.Fallbacks {
+		prov, fallbackErr := r.selectProvisioner(fallback.Type)
+		if fallbackErr == nil {
+			return providerWithAuthType(provider, fallback.Type), prov, nil
+		}
+		err = fmt.Errorf("%w; fallback %w", err, fallbackErr)
+	}
+	return nil, nil, err
+}
+
+// providerWithAuthType returns a copy of provider whose spec.auth is the fallback of the given
+// type, keeping spec.auth.targetKey. The provider itself is returned when spec.auth already
+// has that type, the type is empty, or no fallback has it.
+func providerWithAuthType(provider *llmwardenv1alpha1.LLMProvider, authType llmwardenv1alpha1.AuthType) *llmwardenv1alpha1.LLMProvider {
+	if authType == "" || authType == provider.Spec.Auth.Type {
+		return provider
+	}
+	for _, fallback := range provider.Spec.Auth.Fallbacks {
+		if fallback.Type != authType {
+			continue
+		}
+		effective := provider.DeepCopy()
+		effective.Spec.Auth = llmwardenv1alpha1.AuthConfig{
+			Type:             fallback.Type,
+			TargetKey:        provider.Spec.Auth.TargetKey,
+			APIKey:           fallback.APIKey,
+			ExternalSecret:   fallback.ExternalSecret,
+			WorkloadIdentity: fallback.WorkloadIdentity,
+		}
+		return effective
+	}
+	return provider
+}
+
+// cleanupInjectedAnnotations removes the access's provider from the injected-providers
+// annotation of pods it applies to, through its selectors or the pod's access annotation,
+// and its entry from their injection-detail annotation. A provider is kept when another
+// live LLMAccess in the namespace still injects it into the same pod.
+func (r *LLMAccessReconciler) cleanupInjectedAnnotations(ctx context.Context, llmAccess *llmwardenv1alpha1.LLMAccess) error {
+	// Pods bound through the access annotation needn't match the selectors, so the whole
+	// namespace is listed.
+	podList := &corev1.PodList{}
+	if err := r.podReader().List(ctx, podList, client.InNamespace(llmAccess.Namespace)); err != nil {
+		return fmt.Errorf("listing pods: %w", err)
+	}
+	podList.Items = slices.DeleteFunc(podList.Items, func(pod corev1.Pod) bool {
+		return !webhookv1alpha1.PodMatchesAccess(&pod, llmAccess)
+	})
+	if len(podList.Items) == 0 {
+		return nil
+	}
+
+	accessList := &llmwardenv1alpha1.LLMAccessList{}
+	if err := r.List(ctx, accessList, client.InNamespace(llmAccess.Namespace)); err != nil {
+		return fmt.Errorf("listing LLMAccess resources: %w", err)
+	}
+
+	provider := llmAccess.Spec.ProviderRef.Name
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		injected := strings.Split(pod.Annotations[webhookv1alpha1.InjectedProvidersAnnotation()], ",")
+		if !slices.Contains(injected, provider) || stillInjectedBy(pod, provider, llmAccess, accessList.Items) {
+			continue
+		}
+		remaining := slices.DeleteFunc(injected, func(p string) bool { return p == "" || p == provider })
+
+		patch := client.MergeFrom(pod.DeepCopy())
+		if len(remaining) == 0 {
+			delete(pod.Annotations, webhookv1alpha1.InjectedProvidersAnnotation())
+			delete(pod.Annotations, webhookv1alpha1.InjectionStatusAnnotation())
+			delete(pod.Annotations, webhookv1alpha1.InjectionDetailAnnotation())
+		} else {
+			pod.Annotations[webhookv1alpha1.InjectedProvidersAnnotation()] = strings.Join(remaining, ",")
+			removeInjectionDetail(pod, llmAccess.Name)
+		}
+		if err := r.Patch(ctx, pod, patch); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("patching pod %s/%s: %w", pod.Namespace, pod.Name, err)
+		}
+	}
+	return nil
+}
+
+// removeInjectionDetail drops the entry of the named access from the pod's injection-detail
+// annotation. An annotation that doesn't parse is left as it is.
+func removeInjectionDetail(pod *corev1.Pod, access string) {
+	raw, ok := pod.Annotations[webhookv1alpha1.InjectionDetailAnnotation()]
+	if !ok {
+		return
+	}
+	var details []webhookv1alpha1.InjectionDetail
+	if err := json.Unmarshal([]byte(raw), &details); err != nil {
+		return
+	}
+	details = slices.DeleteFunc(details, func(d webhookv1alpha1.InjectionDetail) bool { return d.Access == access })
+	if len(details) == 0 {
+		delete(pod.Annotations, webhookv1alpha1.InjectionDetailAnnotation())
+		return
+	}
+	if updated, err := json.Marshal(details); err == nil {
+		pod.Annotations[webhookv1alpha1.InjectionDetailAnnotation()] = string(updated)
+	}
+}
+
+// stillInjectedBy reports whether another live LLMAccess referencing provider applies to pod.
+func stillInjectedBy(pod *corev1.Pod, provider string, deleted *llmwardenv1alpha1.LLMAccess, accesses []llmwardenv1alpha1.LLMAccess) bool {
+	for _, other := range accesses {
+		if other.Name == deleted.Name || !other.DeletionTimestamp.IsZero() ||
+			other.Spec.ProviderRef.Name != provider {
+			continue
+		}
+		if webhookv1alpha1.PodMatchesAccess(pod, &other) {
+			return true
+		}
+	}
+	return false
+}
+
 // isNamespaceAllowed checks if the namespace is allowed by the provider's namespace selector
 func (r *LLMAccessReconciler) isNamespaceAllowed(ctx context.Context, namespace string, provider *llmwardenv1alpha1.LLMProvider) bool {
 	// If no selector is defined, all namespaces are allowed
@@ -306,39 +1374,171 @@ func (r *LLMAccessReconciler) isNamespaceAllowed(ctx context.Context, namespace
 	return selector.Matches(labels.Set(ns.Labels))
 }
 
-// validateModels checks if requested models are allowed by the provider
-func (r *LLMAccessReconciler) validateModels(requestedModels []string, provider *llmwardenv1alpha1.LLMProvider) error {
-	// If no models are restricted (empty allowedModels), all models are allowed
-	if len(provider.Spec.AllowedModels) == 0 {
-		return nil
+// validationCached reports whether the namespace and model checks already passed for the
+// access's and provider's current generations. Providers whose checks also depend on
+// state without a generation, namespace labels or a model list resolved from a ConfigMap
+// or upstream providers, are always re-validated.
+func validationCached(access *llmwardenv1alpha1.LLMAccess, provider *llmwardenv1alpha1.LLMProvider) bool {
+	validated := access.Status.ValidatedGenerations
+	if validated == nil || validated.Access != access.Generation ||
+		validated.Provider != provider.Generation || validated.ProviderUID != provider.UID {
+		return false
 	}
+	return provider.Spec.NamespaceSelector == nil && len(provider.Spec.ModelNamespaceRules) == 0 &&
+		provider.Spec.AllowedModelsRef == nil && len(provider.Spec.UpstreamProviderRefs) == 0
+}
 
-	// Check each requested model is in the allowed list
-	allowedMap := make(map[string]bool)
-	for _, model := range provider.Spec.AllowedModels {
-		allowedMap[model] = true
+// effectiveModels returns the models an access is granted: the requested models the
+// provider allows in the namespace, or, for an empty request, every allowed model
+// available to the namespace. A nil result with an empty request means the provider
+// does not restrict models.
+func effectiveModels(requestedModels []string, provider *llmwardenv1alpha1.LLMProvider, nsLabels labels.Set) []string {
+	// validateModels has already rejected a provider whose allowlist is unresolved.
+	allowed, _ := allowedModels(provider)
+	candidates := requestedModels
+	if len(candidates) == 0 {
+		candidates = allowed
 	}
+	var models []string
+	for _, model := range candidates {
+		if len(requestedModels) > 0 && len(allowed) > 0 && !slices.Contains(allowed, model) {
+			continue
+		}
+		if isModelAllowedInNamespace(model, provider.Spec.ModelNamespaceRules, nsLabels) {
+			models = append(models, model)
+		}
+	}
+	return models
+}
 
-	var notAllowed []string
-	for _, model := range requestedModels {
-		if !allowedMap[model] {
-			notAllowed = append(notAllowed, model)
+// setProvisionedModels records the models the access was provisioned with and whether
+// they cover everything it requested.
+func (r *LLMAccessReconciler) setProvisionedModels(access *llmwardenv1alpha1.LLMAccess, provider *llmwardenv1alpha1.LLMProvider, nsLabels labels.Set) {
+	access.Status.ProvisionedModels = effectiveModels(access.Spec.Models, provider, nsLabels)
+	r.setModelsCondition(access)
+}
+
+// setModelsCondition sets ModelsProvisioned from the difference between spec.models and
+// status.provisionedModels, recording a warning event when requested models stop being
+// provisioned. Accesses without an explicit model request don't carry the condition.
+func (r *LLMAccessReconciler) setModelsCondition(access *llmwardenv1alpha1.LLMAccess) {
+	if len(access.Spec.Models) == 0 {
+		apimeta.RemoveStatusCondition(&access.Status.Conditions, ConditionTypeModelsProvisioned)
+		return
+	}
+	var missing []string
+	for _, model := range access.Spec.Models {
+		if !slices.Contains(access.Status.ProvisionedModels, model) {
+			missing = append(missing, model)
 		}
 	}
+	if len(missing) == 0 {
+		setCondition(&access.Status.Conditions, &access.Status.Ready, access.Generation, ConditionTypeModelsProvisioned, metav1.ConditionTrue, ReasonModelsProvisioned,
+			"All requested models are provisioned")
+		return
+	}
+	message := fmt.Sprintf("Requested models not provisioned: %s", strings.Join(missing, ", "))
+	if !apimeta.IsStatusConditionFalse(access.Status.Conditions, ConditionTypeModelsProvisioned) {
+		r.Recorder.Event(access, corev1.EventTypeWarning, ReasonModelsNotProvisioned, message)
+	}
+	setCondition(&access.Status.Conditions, &access.Status.Ready, access.Generation, ConditionTypeModelsProvisioned, metav1.ConditionFalse, ReasonModelsNotProvisioned, message)
+}
+
+// allowedModels returns the provider's model allowlist. With allowedModelsRef or
+// upstreamProviderRefs set this is the merged list the provider controller resolved into
+// status; until it has resolved the current generation an error is returned, so an
+// unloaded list never means "all models".
+func allowedModels(provider *llmwardenv1alpha1.LLMProvider) ([]string, error) {
+	if provider.Spec.AllowedModelsRef == nil && len(provider.Spec.UpstreamProviderRefs) == 0 {
+		return provider.Spec.AllowedModels, nil
+	}
+	resolved := apimeta.FindStatusCondition(provider.Status.Conditions, ConditionTypeAllowedModelsResolved)
+	if resolved == nil || resolved.Status != metav1.ConditionTrue || resolved.ObservedGeneration != provider.Generation {
+		return nil, fmt.Errorf("allowed models from %s have not been resolved by provider %s",
+			allowedModelsSources(provider), provider.Name)
+	}
+	return provider.Status.AllowedModels, nil
+}
 
-	if len(notAllowed) > 0 {
-		return fmt.Errorf("models not allowed: %s (allowed models: %s)",
-			strings.Join(notAllowed, ", "),
-			strings.Join(provider.Spec.AllowedModels, ", "))
+// validateModels checks if requested models are allowed by the provider. The flat
+// allowedModels list is applied first; modelNamespaceRules then restrict matching
+// models to the namespaces their selectors admit, evaluated against nsLabels.
+// An empty request is always valid and means "every model the provider allows".
+func (r *LLMAccessReconciler) validateModels(requestedModels []string, provider *llmwardenv1alpha1.LLMProvider, nsLabels labels.Set) error {
+	allowed, err := allowedModels(provider)
+	if err != nil {
+		return err
+	}
+
+	// If no models are restricted (empty allowedModels), all models are allowed
+	if len(allowed) > 0 {
+		// Check each requested model is in the allowed list
+		allowedMap := make(map[string]bool)
+		for _, model := range allowed {
+			allowedMap[model] = true
+		}
+
+		var notAllowed []string
+		for _, model := range requestedModels {
+			if !allowedMap[model] {
+				notAllowed = append(notAllowed, model)
+			}
+		}
+
+		if len(notAllowed) > 0 {
+			return fmt.Errorf("models not allowed: %s (allowed models: %s)",
+				strings.Join(notAllowed, ", "),
+				strings.Join(allowed, ", "))
+		}
+	}
+
+	var deniedInNamespace []string
+	for _, model := range requestedModels {
+		if !isModelAllowedInNamespace(model, provider.Spec.ModelNamespaceRules, nsLabels) {
+			deniedInNamespace = append(deniedInNamespace, model)
+		}
+	}
+	if len(deniedInNamespace) > 0 {
+		return fmt.Errorf("models not allowed in this namespace: %s", strings.Join(deniedInNamespace, ", "))
 	}
 
 	return nil
 }
 
+// isModelAllowedInNamespace reports whether model may be requested from a namespace with
+// nsLabels. Models not covered by any rule are allowed; covered models need at least one
+// covering rule whose namespaceSelector matches.
+func isModelAllowedInNamespace(model string, rules []llmwardenv1alpha1.ModelNamespaceRule, nsLabels labels.Set) bool {
+	covered := false
+	for _, rule := range rules {
+		if !slices.ContainsFunc(rule.Models, func(pattern string) bool {
+			matched, err := path.Match(pattern, model)
+			return err == nil && matched
+		}) {
+			continue
+		}
+		covered = true
+		selector, err := metav1.LabelSelectorAsSelector(&rule.NamespaceSelector)
+		if err != nil {
+			continue
+		}
+		if selector.Matches(nsLabels) {
+			return true
+		}
+	}
+	return !covered
+}
+
 // getRotationInterval calculates the rotation interval for this LLMAccess
 func (r *LLMAccessReconciler) getRotationInterval(llmAccess *llmwardenv1alpha1.LLMAccess, provider *llmwardenv1alpha1.LLMProvider) time.Duration {
-	// Check if LLMAccess has a rotation override
-	if llmAccess.Spec.Rotation != nil && llmAccess.Spec.Rotation.Interval != "" {
+	// Check if LLMAccess has a rotation override. The webhook rejects overrides the provider
+	// forbids, but the provider may have been tightened after the access was admitted.
+	overrideAllowed := true
+	if provider.Spec.Auth.APIKey != nil && provider.Spec.Auth.APIKey.Rotation != nil &&
+		provider.Spec.Auth.APIKey.Rotation.AllowOverride != nil {
+		overrideAllowed = *provider.Spec.Auth.APIKey.Rotation.AllowOverride
+	}
+	if overrideAllowed && llmAccess.Spec.Rotation != nil && llmAccess.Spec.Rotation.Interval != "" {
 		if duration, err := parseDuration(llmAccess.Spec.Rotation.Interval); err == nil {
 			return duration
 		}
@@ -358,62 +1558,264 @@ func (r *LLMAccessReconciler) getRotationInterval(llmAccess *llmwardenv1alpha1.L
 	return 0
 }
 
-// parseDuration parses duration strings like "30d", "7d", "24h"
-// Maximum allowed: 365 days to prevent DoS via excessive durations
+// resolvedStore returns the secret store the ExternalSecret provisioner reported in its
+// metadata, or nil for provisioners that don't use one.
+func resolvedStore(metadata map[string]string) *llmwardenv1alpha1.StoreReference {
+	if metadata["store"] == "" || metadata["storeKind"] == "" {
+		return nil
+	}
+	return &llmwardenv1alpha1.StoreReference{
+		Name: metadata["store"],
+		Kind: llmwardenv1alpha1.SecretStoreKind(metadata["storeKind"]),
+	}
+}
+
+// getMaintenanceWindow returns the window scheduled rotations of this access are held to,
+// or nil. Like the rotation interval, the access's window overrides the provider's unless
+// the provider disallows overrides.
+func getMaintenanceWindow(llmAccess *llmwardenv1alpha1.LLMAccess, provider *llmwardenv1alpha1.LLMProvider) *llmwardenv1alpha1.MaintenanceWindow {
+	var providerRotation *llmwardenv1alpha1.RotationConfig
+	if provider.Spec.Auth.APIKey != nil {
+		providerRotation = provider.Spec.Auth.APIKey.Rotation
+	}
+	overrideAllowed := providerRotation == nil || providerRotation.AllowOverride == nil || *providerRotation.AllowOverride
+	if overrideAllowed && llmAccess.Spec.Rotation != nil && llmAccess.Spec.Rotation.MaintenanceWindow != nil {
+		return llmAccess.Spec.Rotation.MaintenanceWindow
+	}
+	if providerRotation != nil {
+		return providerRotation.MaintenanceWindow
+	}
+	return nil
+}
+
+// maintenanceWindowDays maps MaintenanceWindow days to time.Weekday.
+var maintenanceWindowDays = map[llmwardenv1alpha1.Weekday]time.Weekday{
+	"Sun": time.Sunday, "Mon": time.Monday, "Tue": time.Tuesday, "Wed": time.Wednesday,
+	"Thu": time.Thursday, "Fri": time.Friday, "Sat": time.Saturday,
+}
+
+// nextWindowOpening returns t if it falls inside the maintenance window, otherwise the time
+// the window next opens.
+func nextWindowOpening(window *llmwardenv1alpha1.MaintenanceWindow, t time.Time) (time.Time, error) {
+	start, err := time.Parse("15:04", window.Start)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid maintenance window start %q: %w", window.Start, err)
+	}
+	duration, err := parseDuration(window.Duration)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid maintenance window duration: %w", err)
+	}
+
+	t = t.UTC()
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	sinceMidnight := time.Duration(start.Hour())*time.Hour + time.Duration(start.Minute())*time.Minute
+	// Windows that opened on earlier days may still be open, and every allowed day occurs
+	// within the next week, so openings in that range are checked in order.
+	for day := -int(duration/(24*time.Hour)) - 1; day <= 7; day++ {
+		opens := midnight.AddDate(0, 0, day).Add(sinceMidnight)
+		if len(window.Days) > 0 && !slices.ContainsFunc(window.Days, func(d llmwardenv1alpha1.Weekday) bool {
+			weekday, ok := maintenanceWindowDays[d]
+			return ok && weekday == opens.Weekday()
+		}) {
+			continue
+		}
+		if opens.After(t) {
+			return opens, nil
+		}
+		if t.Before(opens.Add(duration)) {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("maintenance window has no valid days: %v", window.Days)
+}
+
+// parseDuration parses duration strings like "30d", "7d", "24h"; see duration.Parse.
 func parseDuration(s string) (time.Duration, error) {
-	if s == "" {
-		return 0, fmt.Errorf("empty duration string")
+	return duration.Parse(s)
+}
+
+// providerRefNameField is the field index key for LLMAccess.spec.providerRef.name. A
+// reference to a NamespacedLLMProvider is indexed as "<namespace>/<name>" so it never
+// collides with a cluster-scoped LLMProvider of the same name.
+const providerRefNameField = ".spec.providerRef.name"
+
+// providerIndexKey returns the providerRefNameField value for a provider: its name when
+// cluster-scoped, "<namespace>/<name>" when namespaced.
+func providerIndexKey(namespace, name string) string {
+	if namespace == "" {
+		return name
 	}
+	return namespace + "/" + name
+}
 
-	// Extract number and unit
-	var value int
-	var unit string
+// describeProviderRef names the provider an access references, for messages.
+func describeProviderRef(access *llmwardenv1alpha1.LLMAccess) string {
+	ref := access.Spec.ProviderRef
+	if ref.Kind == llmwardenv1alpha1.ProviderKindNamespacedLLMProvider {
+		return fmt.Sprintf("NamespacedLLMProvider %s/%s", access.Namespace, ref.Name)
+	}
+	return "LLMProvider " + ref.Name
+}
 
-	for i, r := range s {
-		if r < '0' || r > '9' {
-			if i == 0 {
-				return 0, fmt.Errorf("invalid duration format: %s", s)
-			}
-			var err error
-			value, err = strconv.Atoi(s[:i])
-			if err != nil {
-				return 0, fmt.Errorf("invalid duration value: %w", err)
-			}
-			// Prevent integer overflow and reject non-positive intervals ("0d" is ambiguous).
-			if value <= 0 || value > 365 {
-				return 0, fmt.Errorf("duration value out of range (1-365): %d", value)
-			}
-			unit = s[i:]
-			break
+// managedSecretDeleted passes only deletions of secrets carrying the managed-by label
+// under the configured label prefix, so that a deleted target secret is recreated right
+// away instead of on the next periodic reconcile. Owns covers secrets we are the
+// controller of; this also covers ones whose owner reference was removed.
+var managedSecretDeleted = predicate.Funcs{
+	CreateFunc:  func(event.CreateEvent) bool { return false },
+	UpdateFunc:  func(event.UpdateEvent) bool { return false },
+	GenericFunc: func(event.GenericEvent) bool { return false },
+	DeleteFunc: func(e event.DeleteEvent) bool {
+		return e.Object.GetLabels()[labelkeys.ManagedBy()] == labelkeys.ManagedByValue
+	},
+}
+
+// mapManagedSecretToAccess enqueues the LLMAccess named by a managed secret's access label.
+// Target secrets always live in the access's namespace.
+func mapManagedSecretToAccess(_ context.Context, obj client.Object) []reconcile.Request {
+	name := obj.GetLabels()[labelkeys.Access()]
+	if name == "" {
+		return nil
+	}
+	return []reconcile.Request{{
+		NamespacedName: types.NamespacedName{Name: name, Namespace: obj.GetNamespace()},
+	}}
+}
+
+// indexProviderRef is the providerRefNameField indexer.
+// lazyProvisioningField is the field index key that lists the LLMAccess resources with
+// spec.injection.lazyProvisioning set, under the value "true".
+const lazyProvisioningField = ".spec.injection.lazyProvisioning"
+
+// indexLazyProvisioning is the IndexerFunc for lazyProvisioningField.
+func indexLazyProvisioning(obj client.Object) []string {
+	access, ok := obj.(*llmwardenv1alpha1.LLMAccess)
+	if !ok || access.Spec.Injection.LazyProvisioning == nil {
+		return nil
+	}
+	return []string{"true"}
+}
+
+// mapPodToLazyAccesses enqueues the accesses with lazy provisioning that a pod, seen by
+// the metadata-only pod watch, may match. The pod's service account isn't part of its
+// metadata, so accesses with a serviceAccountSelector are always enqueued and the
+// reconciler decides.
+func (r *LLMAccessReconciler) mapPodToLazyAccesses(ctx context.Context, obj client.Object) []reconcile.Request {
+	llmAccessList := &llmwardenv1alpha1.LLMAccessList{}
+	if err := r.List(ctx, llmAccessList, client.InNamespace(obj.GetNamespace()),
+		client.MatchingFields{lazyProvisioningField: "true"}); err != nil {
+		return nil
+	}
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        obj.GetName(),
+		Namespace:   obj.GetNamespace(),
+		Labels:      obj.GetLabels(),
+		Annotations: obj.GetAnnotations(),
+	}}
+	var reqs []reconcile.Request
+	for i := range llmAccessList.Items {
+		access := &llmAccessList.Items[i]
+		if access.Spec.ServiceAccountSelector != nil || webhookv1alpha1.PodMatchesAccess(pod, access) {
+			reqs = append(reqs, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: access.Name, Namespace: access.Namespace},
+			})
 		}
 	}
+	return reqs
+}
 
-	if unit == "" {
-		return 0, fmt.Errorf("missing duration unit in: %s", s)
+func indexProviderRef(obj client.Object) []string {
+	access, ok := obj.(*llmwardenv1alpha1.LLMAccess)
+	if !ok {
+		return nil
+	}
+	if access.Spec.ProviderRef.Kind == llmwardenv1alpha1.ProviderKindNamespacedLLMProvider {
+		return []string{providerIndexKey(access.Namespace, access.Spec.ProviderRef.Name)}
 	}
+	return []string{access.Spec.ProviderRef.Name}
+}
 
-	var duration time.Duration
-	switch unit {
-	case "d":
-		duration = time.Duration(value) * 24 * time.Hour
-	case "h":
-		duration = time.Duration(value) * time.Hour
-	case "m":
-		duration = time.Duration(value) * time.Minute
-	default:
-		return 0, fmt.Errorf("unsupported duration unit: %s", unit)
+// mapProviderToAccesses enqueues the LLMAccess resources that reference a changed LLMProvider
+// or NamespacedLLMProvider, so spec changes such as a new auth type reach every dependent
+// access. The field index makes this lookup O(matches) rather than O(total LLMAccess).
+func (r *LLMAccessReconciler) mapProviderToAccesses(ctx context.Context, obj client.Object) []reconcile.Request {
+	llmAccessList := &llmwardenv1alpha1.LLMAccessList{}
+	if err := r.List(ctx, llmAccessList,
+		client.MatchingFields{providerRefNameField: providerIndexKey(obj.GetNamespace(), obj.GetName())},
+	); err != nil {
+		return nil
 	}
+	reqs := make([]reconcile.Request, 0, len(llmAccessList.Items))
+	for _, access := range llmAccessList.Items {
+		reqs = append(reqs, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      access.Name,
+				Namespace: access.Namespace,
+			},
+		})
+	}
+	return reqs
+}
 
-	// Additional safety check: max 365 days
-	if duration > 365*24*time.Hour {
-		return 0, fmt.Errorf("duration exceeds maximum allowed (365 days): %s", s)
+// providerChangeHandler returns the event handler of the provider watches, which enqueues
+// the dependent accesses of a changed provider, debounced by ProviderChangeDebounce.
+func (r *LLMAccessReconciler) providerChangeHandler() handler.EventHandler {
+	if r.ProviderChangeDebounce <= 0 {
+		return handler.EnqueueRequestsFromMapFunc(r.mapProviderToAccesses)
+	}
+	debouncer := &enqueueDebouncer{window: r.ProviderChangeDebounce, now: r.clock}
+	enqueue := func(ctx context.Context, obj client.Object, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+		debouncer.enqueue(q, r.mapProviderToAccesses(ctx, obj))
 	}
+	return handler.Funcs{
+		CreateFunc: func(ctx context.Context, e event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueue(ctx, e.Object, q)
+		},
+		UpdateFunc: func(ctx context.Context, e event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueue(ctx, e.ObjectNew, q)
+		},
+		DeleteFunc: func(ctx context.Context, e event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueue(ctx, e.Object, q)
+		},
+		GenericFunc: func(ctx context.Context, e event.GenericEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueue(ctx, e.Object, q)
+		},
+	}
+}
+
+// enqueueDebouncer coalesces enqueues of the same request: the first one is added to the
+// queue after window, and the ones that arrive before it fires are dropped. The reconcile
+// still sees the dropped changes, which are already in the cache when it runs.
+type enqueueDebouncer struct {
+	window time.Duration
+	now    func() time.Time
 
-	return duration, nil
+	// mu guards due, the time each pending request fires.
+	mu  sync.Mutex
+	due map[reconcile.Request]time.Time
 }
 
-// providerRefNameField is the field index key for LLMAccess.spec.providerRef.name.
-const providerRefNameField = ".spec.providerRef.name"
+// enqueue adds each of reqs to q after the debouncer's window, unless it is already pending.
+func (d *enqueueDebouncer) enqueue(q workqueue.TypedRateLimitingInterface[reconcile.Request], reqs []reconcile.Request) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	now := d.now()
+	for req, due := range d.due {
+		if !due.After(now) {
+			delete(d.due, req)
+		}
+	}
+	if d.due == nil {
+		d.due = make(map[reconcile.Request]time.Time)
+	}
+	for _, req := range reqs {
+		if _, pending := d.due[req]; pending {
+			continue
+		}
+		d.due[req] = now.Add(d.window)
+		q.AddAfter(req, d.window)
+	}
+}
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *LLMAccessReconciler) SetupWithManager(mgr ctrl.Manager) error {
@@ -425,42 +1827,37 @@ func (r *LLMAccessReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		context.Background(),
 		&llmwardenv1alpha1.LLMAccess{},
 		providerRefNameField,
-		func(obj client.Object) []string {
-			access, ok := obj.(*llmwardenv1alpha1.LLMAccess)
-			if !ok {
-				return nil
-			}
-			return []string{access.Spec.ProviderRef.Name}
-		},
+		indexProviderRef,
 	); err != nil {
 		return fmt.Errorf("setting up providerRef.name field index: %w", err)
 	}
 
-	// Watch LLMProvider changes and enqueue only LLMAccess resources that reference the changed
-	// provider. The field index makes this lookup O(matches) rather than O(total LLMAccess).
-	mapProviderToAccesses := func(ctx context.Context, obj client.Object) []reconcile.Request {
-		llmAccessList := &llmwardenv1alpha1.LLMAccessList{}
-		if err := mgr.GetClient().List(ctx, llmAccessList,
-			client.MatchingFields{providerRefNameField: obj.GetName()},
+	// Both provider kinds share one handler, so its debouncer sees every provider change.
+	providerChanged := r.providerChangeHandler()
+
+	bldr := ctrl.NewControllerManagedBy(mgr).
+		For(&llmwardenv1alpha1.LLMAccess{}).
+		Owns(&corev1.Secret{}).
+		Watches(&llmwardenv1alpha1.LLMProvider{}, providerChanged).
+		Watches(&llmwardenv1alpha1.NamespacedLLMProvider{}, providerChanged).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(mapManagedSecretToAccess),
+			builder.WithPredicates(managedSecretDeleted))
+
+	// Watch pods only to wake accesses with lazy provisioning, which create and delete their
+	// secret as matching pods come and go. Metadata is enough to match them, so full pod
+	// objects are never cached; installs that don't enable the feature don't watch pods.
+	if r.LazyProvisioning {
+		if err := mgr.GetFieldIndexer().IndexField(
+			context.Background(),
+			&llmwardenv1alpha1.LLMAccess{},
+			lazyProvisioningField,
+			indexLazyProvisioning,
 		); err != nil {
-			return nil
-		}
-		reqs := make([]reconcile.Request, 0, len(llmAccessList.Items))
-		for _, access := range llmAccessList.Items {
-			reqs = append(reqs, reconcile.Request{
-				NamespacedName: types.NamespacedName{
-					Name:      access.Name,
-					Namespace: access.Namespace,
-				},
-			})
+			return fmt.Errorf("setting up lazyProvisioning field index: %w", err)
 		}
-		return reqs
+		bldr = bldr.Watches(&corev1.Pod{}, handler.EnqueueRequestsFromMapFunc(r.mapPodToLazyAccesses),
+			builder.OnlyMetadata, builder.WithPredicates(podMatchChanged))
 	}
 
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&llmwardenv1alpha1.LLMAccess{}).
-		Owns(&corev1.Secret{}).
-		Watches(&llmwardenv1alpha1.LLMProvider{}, handler.EnqueueRequestsFromMapFunc(mapProviderToAccesses)).
-		Named("llmaccess").
-		Complete(r)
+	return bldr.Named("llmaccess").Complete(r)
 }
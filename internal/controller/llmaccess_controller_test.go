@@ -18,20 +18,28 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"math/rand"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/metrics"
 	"github.com/llmwarden/llmwarden/internal/provisioner"
+	webhookv1alpha1 "github.com/llmwarden/llmwarden/internal/webhook/v1alpha1"
 )
 
 var _ = Describe("LLMAccess Controller", func() {
@@ -229,6 +237,12 @@ var _ = Describe("LLMAccess Controller", func() {
 			Expect(llmAccess.Status.ProvisionedModels).To(Equal([]string{"gpt-4o"}))
 			Expect(llmAccess.Status.LastRotation).NotTo(BeNil())
 			Expect(llmAccess.Status.NextRotation).NotTo(BeNil())
+			Expect(llmAccess.Status.Phase).To(Equal(llmwardenv1alpha1.PhaseReady))
+			Expect(llmAccess.Status.SourceSecretRef).NotTo(BeNil())
+			Expect(llmAccess.Status.SourceSecretRef.Kind).To(Equal("Secret"))
+			Expect(llmAccess.Status.SourceSecretRef.Namespace).To(Equal(providerSecret.Namespace))
+			Expect(llmAccess.Status.SourceSecretRef.Name).To(Equal(providerSecret.Name))
+			Expect(llmAccess.Status.SourceSecretRef.FieldPath).To(Equal("data[api-key]"))
 		})
 
 		It("should reject LLMAccess when namespace is not allowed", func() {
@@ -494,6 +508,580 @@ var _ = Describe("LLMAccess Controller", func() {
 				return secret.Data["apiKey"]
 			}, timeout, interval).Should(Equal([]byte("sk-new-key-0987654321")))
 		})
+
+		It("should repair a manually edited target secret", func() {
+			llmAccess = &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "drift-test",
+					Namespace: namespace.Name,
+				},
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					ProviderRef: llmwardenv1alpha1.ProviderReference{
+						Name: provider.Name,
+					},
+					Models:     []string{"gpt-4o"},
+					SecretName: "openai-credentials",
+					Injection: llmwardenv1alpha1.InjectionConfig{
+						Env: []llmwardenv1alpha1.EnvVarMapping{
+							{Name: "OPENAI_API_KEY", SecretKey: "apiKey"},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, llmAccess)).To(Succeed())
+
+			recorder := record.NewFakeRecorder(100)
+			controllerReconciler.Recorder = recorder
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      llmAccess.Name,
+					Namespace: llmAccess.Namespace,
+				},
+			}
+
+			// First reconcile adds the finalizer, second provisions the secret
+			_, err := controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Tamper with the managed secret
+			secretKey := types.NamespacedName{Name: "openai-credentials", Namespace: namespace.Name}
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, secretKey, secret)).To(Succeed())
+			secret.Data["apiKey"] = []byte("sk-wrong-value")
+			Expect(k8sClient.Update(ctx, secret)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(func() []byte {
+				secret := &corev1.Secret{}
+				if err := k8sClient.Get(ctx, secretKey, secret); err != nil {
+					return nil
+				}
+				return secret.Data["apiKey"]
+			}, timeout, interval).Should(Equal([]byte("sk-test-key-1234567890")))
+
+			var events []string
+			for len(recorder.Events) > 0 {
+				events = append(events, <-recorder.Events)
+			}
+			Expect(events).To(ContainElement(ContainSubstring(ReasonDriftRepaired)))
+		})
+
+		It("should recreate a deleted target secret", func() {
+			llmAccess = &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "deleted-secret-test",
+					Namespace: namespace.Name,
+				},
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					ProviderRef: llmwardenv1alpha1.ProviderReference{
+						Name: provider.Name,
+					},
+					Models:     []string{"gpt-4o"},
+					SecretName: "openai-credentials",
+					Injection: llmwardenv1alpha1.InjectionConfig{
+						Env: []llmwardenv1alpha1.EnvVarMapping{
+							{Name: "OPENAI_API_KEY", SecretKey: "apiKey"},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, llmAccess)).To(Succeed())
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      llmAccess.Name,
+					Namespace: llmAccess.Namespace,
+				},
+			}
+			_, err := controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			secretKey := types.NamespacedName{Name: "openai-credentials", Namespace: namespace.Name}
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, secretKey, secret)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, secret)).To(Succeed())
+
+			// The deletion maps back to the owning access; other events and unmanaged secrets don't.
+			Expect(managedSecretDeleted.Delete(event.DeleteEvent{Object: secret})).To(BeTrue())
+			Expect(managedSecretDeleted.Update(event.UpdateEvent{ObjectOld: secret, ObjectNew: secret})).To(BeFalse())
+			Expect(managedSecretDeleted.Delete(event.DeleteEvent{Object: providerSecret})).To(BeFalse())
+			Expect(mapManagedSecretToAccess(ctx, secret)).To(ConsistOf(req))
+
+			_, err = controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(func() []byte {
+				secret := &corev1.Secret{}
+				if err := k8sClient.Get(ctx, secretKey, secret); err != nil {
+					return nil
+				}
+				return secret.Data["apiKey"]
+			}, timeout, interval).Should(Equal([]byte("sk-test-key-1234567890")))
+		})
+
+		It("should surface provisioner metadata in the event and annotations", func() {
+			llmAccess = &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "metadata-test",
+					Namespace: namespace.Name,
+				},
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					ProviderRef: llmwardenv1alpha1.ProviderReference{
+						Name: provider.Name,
+					},
+					Models:     []string{"gpt-4o"},
+					SecretName: "openai-credentials",
+					Injection: llmwardenv1alpha1.InjectionConfig{
+						Env: []llmwardenv1alpha1.EnvVarMapping{
+							{Name: "OPENAI_API_KEY", SecretKey: "apiKey"},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, llmAccess)).To(Succeed())
+
+			recorder := record.NewFakeRecorder(100)
+			controllerReconciler.Recorder = recorder
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      llmAccess.Name,
+					Namespace: llmAccess.Namespace,
+				},
+			}
+			_, err := controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			sourceSecret := providerNamespace.Name + "/openai-key"
+			targetSecret := namespace.Name + "/openai-credentials"
+
+			var events []string
+			for len(recorder.Events) > 0 {
+				events = append(events, <-recorder.Events)
+			}
+			Expect(events).To(ContainElement(SatisfyAll(
+				ContainSubstring(ReasonCredentialProvisioned),
+				ContainSubstring("sourceSecret="+sourceSecret),
+				ContainSubstring("targetSecret="+targetSecret),
+				Not(ContainSubstring("sk-test-key")),
+			)))
+
+			updated := &llmwardenv1alpha1.LLMAccess{}
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updated)).To(Succeed())
+			Expect(updated.Annotations).To(HaveKeyWithValue(ProvisionMetadataAnnotationPrefix()+"sourceSecret", sourceSecret))
+			Expect(updated.Annotations).To(HaveKeyWithValue(ProvisionMetadataAnnotationPrefix()+"targetSecret", targetSecret))
+			Expect(updated.Annotations).NotTo(HaveKey(ProvisionMetadataAnnotationPrefix() + "storeKind"))
+			// The annotation patch must not clobber the status written before it.
+			Expect(updated.Status.Ready).To(BeTrue())
+			// The provision result is opt-in.
+			Expect(updated.Annotations).NotTo(HaveKey(ProvisionResultAnnotation()))
+		})
+
+		It("should export the redacted provision result as a JSON annotation when enabled", func() {
+			llmAccess = &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "export-test",
+					Namespace: namespace.Name,
+				},
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					ProviderRef: llmwardenv1alpha1.ProviderReference{
+						Name: provider.Name,
+					},
+					Models:     []string{"gpt-4o"},
+					SecretName: "openai-credentials",
+					Injection: llmwardenv1alpha1.InjectionConfig{
+						Env: []llmwardenv1alpha1.EnvVarMapping{
+							{Name: "OPENAI_API_KEY", SecretKey: "apiKey"},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, llmAccess)).To(Succeed())
+
+			controllerReconciler.ExportProvisionResult = true
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      llmAccess.Name,
+					Namespace: llmAccess.Namespace,
+				},
+			}
+			_, err := controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			updated := &llmwardenv1alpha1.LLMAccess{}
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updated)).To(Succeed())
+			Expect(updated.Annotations).To(HaveKey(ProvisionResultAnnotation()))
+			exported := updated.Annotations[ProvisionResultAnnotation()]
+			Expect(exported).NotTo(ContainSubstring("sk-test-key"))
+
+			var result map[string]any
+			Expect(json.Unmarshal([]byte(exported), &result)).To(Succeed())
+			Expect(result).To(HaveKeyWithValue("secretName", "openai-credentials"))
+			Expect(result).To(HaveKeyWithValue("secretNamespace", namespace.Name))
+			Expect(result).To(HaveKeyWithValue("secretKeys", ContainElement("apiKey")))
+			Expect(result).To(HaveKeyWithValue("needsRotation", BeAssignableToTypeOf(false)))
+			Expect(result).To(HaveKey("provisionedAt"))
+			Expect(result).To(HaveKeyWithValue("metadata", SatisfyAll(
+				HaveKeyWithValue("sourceSecret", providerNamespace.Name+"/openai-key"),
+				HaveKeyWithValue("targetSecret", namespace.Name+"/openai-credentials"),
+			)))
+			Expect(result["metadata"]).NotTo(HaveKey("provider"))
+
+			// Turning the export off removes the annotation.
+			controllerReconciler.ExportProvisionResult = false
+			_, err = controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updated)).To(Succeed())
+			Expect(updated.Annotations).NotTo(HaveKey(ProvisionResultAnnotation()))
+		})
+
+		It("should requeue before the TTL elapses and delete the access once it has", func() {
+			llmAccess = &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ttl-test",
+					Namespace: namespace.Name,
+				},
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					ProviderRef: llmwardenv1alpha1.ProviderReference{
+						Name: provider.Name,
+					},
+					Models:     []string{"gpt-4o"},
+					SecretName: "openai-credentials",
+					TTL:        "1h",
+					Injection: llmwardenv1alpha1.InjectionConfig{
+						Env: []llmwardenv1alpha1.EnvVarMapping{
+							{Name: "OPENAI_API_KEY", SecretKey: "apiKey"},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, llmAccess)).To(Succeed())
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      llmAccess.Name,
+					Namespace: llmAccess.Namespace,
+				},
+			}
+
+			By("requeueing no later than the expiry while the TTL has not elapsed")
+			_, err := controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			result, err := controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			// The provider rotates every 7d, so the 1h TTL must win.
+			Expect(result.RequeueAfter).To(BeNumerically("<=", time.Hour))
+			Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+
+			Expect(k8sClient.Get(ctx, req.NamespacedName, llmAccess)).To(Succeed())
+			Expect(llmAccess.Status.ExpiresAt).NotTo(BeNil())
+			Expect(llmAccess.Status.ExpiresAt.Time).To(BeTemporally("~", llmAccess.CreationTimestamp.Add(time.Hour), time.Second))
+
+			By("deleting the access once the TTL has elapsed")
+			controllerReconciler.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+			result, err = controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeZero())
+
+			Eventually(func() bool {
+				access := &llmwardenv1alpha1.LLMAccess{}
+				if err := k8sClient.Get(ctx, req.NamespacedName, access); err != nil {
+					return apierrors.IsNotFound(err)
+				}
+				return !access.DeletionTimestamp.IsZero()
+			}, timeout, interval).Should(BeTrue())
+		})
+
+		It("should provision lazily on the first matching pod and delete the secret once idle", func() {
+			controllerReconciler.LazyProvisioning = true
+			llmAccess = &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "lazy-test",
+					Namespace: namespace.Name,
+				},
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					ProviderRef: llmwardenv1alpha1.ProviderReference{
+						Name: provider.Name,
+					},
+					Models:     []string{"gpt-4o"},
+					SecretName: "openai-credentials",
+					WorkloadSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"app": "chatbot"},
+					},
+					Injection: llmwardenv1alpha1.InjectionConfig{
+						Env: []llmwardenv1alpha1.EnvVarMapping{
+							{Name: "OPENAI_API_KEY", SecretKey: "apiKey"},
+						},
+						LazyProvisioning: &llmwardenv1alpha1.LazyProvisioningConfig{IdleGracePeriod: "1h"},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, llmAccess)).To(Succeed())
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      llmAccess.Name,
+					Namespace: llmAccess.Namespace,
+				},
+			}
+			secretKey := types.NamespacedName{Name: "openai-credentials", Namespace: namespace.Name}
+			secretExists := func() bool {
+				err := k8sClient.Get(ctx, secretKey, &corev1.Secret{})
+				if err != nil && !apierrors.IsNotFound(err) {
+					Fail(err.Error())
+				}
+				return err == nil
+			}
+
+			By("holding off the secret while no pod matches")
+			_, err := controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secretExists()).To(BeFalse())
+			Expect(k8sClient.Get(ctx, req.NamespacedName, llmAccess)).To(Succeed())
+			Expect(apimeta.FindStatusCondition(llmAccess.Status.Conditions, ConditionTypeReady).Reason).To(Equal(ReasonAwaitingWorkload))
+
+			By("provisioning once a matching pod exists")
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "chatbot",
+					Namespace: namespace.Name,
+					Labels:    map[string]string{"app": "chatbot"},
+				},
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Image: "chatbot"}}},
+			}
+			Expect(k8sClient.Create(ctx, pod)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secretExists()).To(BeTrue())
+
+			By("keeping the secret through the idle grace period")
+			Expect(k8sClient.Delete(ctx, pod, client.GracePeriodSeconds(0))).To(Succeed())
+			Eventually(func() bool {
+				return apierrors.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{}))
+			}, timeout, interval).Should(BeTrue())
+			result, err := controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically("<=", time.Hour))
+			Expect(secretExists()).To(BeTrue())
+			Expect(k8sClient.Get(ctx, req.NamespacedName, llmAccess)).To(Succeed())
+			Expect(llmAccess.Status.IdleSince).NotTo(BeNil())
+
+			By("deleting the secret once the grace period has passed")
+			controllerReconciler.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+			_, err = controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secretExists()).To(BeFalse())
+			Expect(k8sClient.Get(ctx, req.NamespacedName, llmAccess)).To(Succeed())
+			Expect(llmAccess.Status.ProvisionedAuthType).To(BeEmpty())
+			Expect(llmAccess.Status.IdleSince).To(BeNil())
+			Expect(apimeta.FindStatusCondition(llmAccess.Status.Conditions, ConditionTypeReady).Reason).To(Equal(ReasonAwaitingWorkload))
+		})
+
+		It("should back off and flag accesses whose provider auth type is unsupported", func() {
+			wiProvider := &llmwardenv1alpha1.LLMProvider{
+				ObjectMeta: metav1.ObjectMeta{Name: "bedrock-wi-" + randString(5)},
+				Spec: llmwardenv1alpha1.LLMProviderSpec{
+					Provider: llmwardenv1alpha1.ProviderAWSBedrock,
+					Auth: llmwardenv1alpha1.AuthConfig{
+						Type: llmwardenv1alpha1.AuthTypeWorkloadIdentity,
+						WorkloadIdentity: &llmwardenv1alpha1.WorkloadIdentityAuth{
+							AWS: &llmwardenv1alpha1.AWSWorkloadIdentity{
+								RoleArn: "arn:aws:iam::123456789012:role/bedrock",
+								Region:  "us-east-1",
+							},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, wiProvider)).To(Succeed())
+			DeferCleanup(func() { _ = k8sClient.Delete(ctx, wiProvider) })
+
+			llmAccess = &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "unsupported-auth-test",
+					Namespace: namespace.Name,
+				},
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					ProviderRef: llmwardenv1alpha1.ProviderReference{Name: wiProvider.Name},
+					SecretName:  "bedrock-credentials",
+					Injection: llmwardenv1alpha1.InjectionConfig{
+						Env: []llmwardenv1alpha1.EnvVarMapping{
+							{Name: "AWS_REGION", SecretKey: "region"},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, llmAccess)).To(Succeed())
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: llmAccess.Name, Namespace: llmAccess.Namespace},
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			result, err := controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(unsupportedAuthTypeRequeueInterval))
+
+			Expect(k8sClient.Get(ctx, req.NamespacedName, llmAccess)).To(Succeed())
+			ready := apimeta.FindStatusCondition(llmAccess.Status.Conditions, ConditionTypeReady)
+			Expect(ready).NotTo(BeNil())
+			Expect(ready.Reason).To(Equal(ReasonAuthTypeNotSupported))
+			Expect(testutil.ToFloat64(metrics.UnsupportedAuthTypeAccesses.WithLabelValues(
+				wiProvider.Name, llmAccess.Namespace, llmAccess.Name, string(llmwardenv1alpha1.AuthTypeWorkloadIdentity),
+			))).To(Equal(1.0))
+
+			By("not rewriting status on subsequent reconciles")
+			resourceVersion := llmAccess.ResourceVersion
+			result, err = controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(unsupportedAuthTypeRequeueInterval))
+			Expect(k8sClient.Get(ctx, req.NamespacedName, llmAccess)).To(Succeed())
+			Expect(llmAccess.ResourceVersion).To(Equal(resourceVersion))
+		})
+
+		It("should propagate provider endpoint changes to the target secret", func() {
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: provider.Name}, provider)).To(Succeed())
+			provider.Spec.Endpoint = &llmwardenv1alpha1.EndpointConfig{BaseURL: "https://old.example.com/v1"}
+			Expect(k8sClient.Update(ctx, provider)).To(Succeed())
+
+			llmAccess = &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "endpoint-test",
+					Namespace: namespace.Name,
+				},
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					ProviderRef: llmwardenv1alpha1.ProviderReference{
+						Name: provider.Name,
+					},
+					Models:     []string{"gpt-4o"},
+					SecretName: "openai-credentials",
+					Injection: llmwardenv1alpha1.InjectionConfig{
+						Env: []llmwardenv1alpha1.EnvVarMapping{
+							{Name: "OPENAI_API_KEY", SecretKey: "apiKey"},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, llmAccess)).To(Succeed())
+
+			recorder := record.NewFakeRecorder(100)
+			controllerReconciler.Recorder = recorder
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      llmAccess.Name,
+					Namespace: llmAccess.Namespace,
+				},
+			}
+
+			// First reconcile adds the finalizer, second provisions the secret
+			_, err := controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Migrate the provider to a new endpoint
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: provider.Name}, provider)).To(Succeed())
+			provider.Spec.Endpoint.BaseURL = "https://new.example.com/v1"
+			Expect(k8sClient.Update(ctx, provider)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(func() string {
+				secret := &corev1.Secret{}
+				if err := k8sClient.Get(ctx, types.NamespacedName{Name: "openai-credentials", Namespace: namespace.Name}, secret); err != nil {
+					return ""
+				}
+				return string(secret.Data["baseUrl"])
+			}, timeout, interval).Should(Equal("https://new.example.com/v1"))
+
+			var events []string
+			for len(recorder.Events) > 0 {
+				events = append(events, <-recorder.Events)
+			}
+			Expect(events).To(ContainElement(ContainSubstring(ReasonEndpointUpdated)))
+		})
+	})
+
+	Context("When cleaning up injected annotations on deletion", func() {
+		It("should drop the deleted access's provider from matching pods", func() {
+			ctx := context.Background()
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "cleanup-ns-" + randString(5)}}
+			Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+			defer func() { _ = k8sClient.Delete(ctx, ns) }()
+
+			reconciler := &LLMAccessReconciler{
+				Client:                     k8sClient,
+				Scheme:                     k8sClient.Scheme(),
+				Recorder:                   record.NewFakeRecorder(100),
+				CleanupInjectedAnnotations: true,
+			}
+
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "chatbot",
+					Namespace: ns.Name,
+					Labels:    map[string]string{"app": "chatbot"},
+					Annotations: map[string]string{
+						webhookv1alpha1.InjectedProvidersAnnotation(): "openai-prod,anthropic-prod",
+						webhookv1alpha1.InjectionStatusAnnotation():   "injected",
+					},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "main", Image: "nginx"}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, pod)).To(Succeed())
+
+			access := &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "chatbot-access",
+					Namespace: ns.Name,
+				},
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai-prod"},
+					SecretName:  "openai-credentials",
+					WorkloadSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"app": "chatbot"},
+					},
+					Injection: llmwardenv1alpha1.InjectionConfig{
+						Env: []llmwardenv1alpha1.EnvVarMapping{
+							{Name: "OPENAI_API_KEY", SecretKey: "apiKey"},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, access)).To(Succeed())
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: access.Name, Namespace: ns.Name}}
+
+			// First reconcile - adds finalizer
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Delete(ctx, access)).To(Succeed())
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(func() map[string]string {
+				updated := &corev1.Pod{}
+				if err := k8sClient.Get(ctx, types.NamespacedName{Name: pod.Name, Namespace: ns.Name}, updated); err != nil {
+					return nil
+				}
+				return updated.Annotations
+			}, timeout, interval).Should(And(
+				HaveKeyWithValue(webhookv1alpha1.InjectedProvidersAnnotation(), "anthropic-prod"),
+				HaveKeyWithValue(webhookv1alpha1.InjectionStatusAnnotation(), "injected"),
+			))
+		})
 	})
 
 	Context("Helper functions", func() {
@@ -516,6 +1104,95 @@ var _ = Describe("LLMAccess Controller", func() {
 			_, err = parseDuration("7x")
 			Expect(err).To(HaveOccurred())
 		})
+
+		It("should ignore an access rotation override the provider forbids", func() {
+			r := &LLMAccessReconciler{}
+			allow := false
+			provider := &llmwardenv1alpha1.LLMProvider{
+				Spec: llmwardenv1alpha1.LLMProviderSpec{
+					Auth: llmwardenv1alpha1.AuthConfig{
+						Type: llmwardenv1alpha1.AuthTypeAPIKey,
+						APIKey: &llmwardenv1alpha1.APIKeyAuth{
+							Rotation: &llmwardenv1alpha1.RotationConfig{Enabled: true, Interval: "30d"},
+						},
+					},
+				},
+			}
+			access := &llmwardenv1alpha1.LLMAccess{
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					Rotation: &llmwardenv1alpha1.AccessRotationConfig{Interval: "7d"},
+				},
+			}
+
+			Expect(r.getRotationInterval(access, provider)).To(Equal(7 * 24 * time.Hour))
+
+			provider.Spec.Auth.APIKey.Rotation.AllowOverride = &allow
+			Expect(r.getRotationInterval(access, provider)).To(Equal(30 * 24 * time.Hour))
+		})
+
+		It("should enforce per-namespace model rules", func() {
+			r := &LLMAccessReconciler{}
+			provider := &llmwardenv1alpha1.LLMProvider{
+				Spec: llmwardenv1alpha1.LLMProviderSpec{
+					AllowedModels: []string{"gpt-4o", "o1", "o1-mini"},
+					ModelNamespaceRules: []llmwardenv1alpha1.ModelNamespaceRule{
+						{
+							Models: []string{"o1*"},
+							NamespaceSelector: metav1.LabelSelector{
+								MatchLabels: map[string]string{"ai-tier": "research"},
+							},
+						},
+					},
+				},
+			}
+
+			research := labels.Set{"ai-tier": "research"}
+			production := labels.Set{"ai-tier": "production"}
+
+			Expect(r.validateModels([]string{"o1"}, provider, research)).To(Succeed())
+			Expect(r.validateModels([]string{"o1-mini"}, provider, research)).To(Succeed())
+
+			err := r.validateModels([]string{"gpt-4o", "o1"}, provider, production)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("o1"))
+			Expect(err.Error()).NotTo(ContainSubstring("gpt-4o"))
+
+			// Models not covered by any rule fall back to the flat allowedModels list.
+			Expect(r.validateModels([]string{"gpt-4o"}, provider, production)).To(Succeed())
+			Expect(r.validateModels([]string{"gpt-4-turbo"}, provider, research)).NotTo(Succeed())
+		})
+
+		It("should treat an empty model list as every allowed model", func() {
+			r := &LLMAccessReconciler{}
+			provider := &llmwardenv1alpha1.LLMProvider{
+				Spec: llmwardenv1alpha1.LLMProviderSpec{
+					AllowedModels: []string{"gpt-4o", "gpt-4o-mini", "o1"},
+					ModelNamespaceRules: []llmwardenv1alpha1.ModelNamespaceRule{
+						{
+							Models: []string{"o1"},
+							NamespaceSelector: metav1.LabelSelector{
+								MatchLabels: map[string]string{"ai-tier": "research"},
+							},
+						},
+					},
+				},
+			}
+			production := labels.Set{"ai-tier": "production"}
+
+			By("accepting empty, single and multiple model lists")
+			Expect(r.validateModels(nil, provider, production)).To(Succeed())
+			Expect(r.validateModels([]string{"gpt-4o"}, provider, production)).To(Succeed())
+			Expect(r.validateModels([]string{"gpt-4o", "gpt-4o-mini"}, provider, production)).To(Succeed())
+
+			By("granting explicit lists as requested")
+			Expect(effectiveModels([]string{"gpt-4o"}, provider, production)).To(Equal([]string{"gpt-4o"}))
+			Expect(effectiveModels([]string{"gpt-4o", "gpt-4o-mini"}, provider, production)).
+				To(Equal([]string{"gpt-4o", "gpt-4o-mini"}))
+
+			By("expanding an empty list to the models available to the namespace")
+			Expect(effectiveModels(nil, provider, production)).To(Equal([]string{"gpt-4o", "gpt-4o-mini"}))
+			Expect(effectiveModels(nil, &llmwardenv1alpha1.LLMProvider{}, production)).To(BeEmpty())
+		})
 	})
 })
 
@@ -0,0 +1,748 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/eso"
+	"github.com/llmwarden/llmwarden/internal/provisioner"
+)
+
+// TestLLMAccessReconciler_ExternalSecretSync runs against the fake client because
+// envtest doesn't install the ESO CRDs.
+func TestLLMAccessReconciler_ExternalSecretSync(t *testing.T) {
+	adapter := eso.NewV1Beta1Adapter()
+
+	syncedES := func(status, message string) *unstructured.Unstructured {
+		es := &unstructured.Unstructured{}
+		es.SetGroupVersionKind(adapter.GVK())
+		es.SetNamespace("team-a")
+		es.SetName("openai-credentials")
+		es.Object["status"] = map[string]any{
+			"conditions": []any{
+				map[string]any{"type": "Ready", "status": status, "message": message},
+			},
+		}
+		return es
+	}
+
+	syncedSecret := func(data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "openai-credentials"},
+			Data:       data,
+		}
+	}
+
+	tests := []struct {
+		name            string
+		existingES      *unstructured.Unstructured
+		existingSecret  *corev1.Secret
+		wantReady       metav1.ConditionStatus
+		wantReason      string
+		wantPhase       llmwardenv1alpha1.Phase
+		wantRequeue     bool
+		wantLastRotated bool
+	}{
+		{
+			name:        "newly created ExternalSecret is not synced yet",
+			existingES:  nil,
+			wantReady:   metav1.ConditionFalse,
+			wantReason:  ReasonExternalSecretNotSynced,
+			wantPhase:   llmwardenv1alpha1.PhasePending,
+			wantRequeue: true,
+		},
+		{
+			name:        "ExternalSecret failing to sync is not ready",
+			existingES:  syncedES("False", "SecretStore vault not found"),
+			wantReady:   metav1.ConditionFalse,
+			wantReason:  ReasonExternalSecretNotSynced,
+			wantPhase:   llmwardenv1alpha1.PhasePending,
+			wantRequeue: true,
+		},
+		{
+			name:            "synced ExternalSecret is ready",
+			existingES:      syncedES("True", "Secret was synced"),
+			existingSecret:  syncedSecret(map[string][]byte{"apiKey": []byte("sk-synced")}),
+			wantReady:       metav1.ConditionTrue,
+			wantReason:      ReasonCredentialProvisioned,
+			wantPhase:       llmwardenv1alpha1.PhaseReady,
+			wantLastRotated: true,
+		},
+		{
+			name:           "synced ExternalSecret without the credential key is not ready",
+			existingES:     syncedES("True", "Secret was synced"),
+			existingSecret: syncedSecret(map[string][]byte{"password": []byte("sk-synced")}),
+			wantReady:      metav1.ConditionFalse,
+			wantReason:     ReasonSyncedButKeyMissing,
+			wantPhase:      llmwardenv1alpha1.PhaseError,
+			wantRequeue:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			scheme := runtime.NewScheme()
+			_ = clientgoscheme.AddToScheme(scheme)
+			_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+			provider := &llmwardenv1alpha1.LLMProvider{
+				ObjectMeta: metav1.ObjectMeta{Name: "openai-eso"},
+				Spec: llmwardenv1alpha1.LLMProviderSpec{
+					Provider: llmwardenv1alpha1.ProviderOpenAI,
+					Auth: llmwardenv1alpha1.AuthConfig{
+						Type: llmwardenv1alpha1.AuthTypeExternalSecret,
+						ExternalSecret: &llmwardenv1alpha1.ExternalSecretAuth{
+							Store: llmwardenv1alpha1.StoreReference{
+								Name: "vault",
+								Kind: llmwardenv1alpha1.SecretStoreKindClusterSecretStore,
+							},
+							RemoteRef: llmwardenv1alpha1.RemoteReference{Key: "secret/openai"},
+						},
+					},
+				},
+			}
+			access := &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "openai-access",
+					Namespace:  "team-a",
+					Finalizers: []string{llmAccessFinalizer},
+				},
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai-eso"},
+					SecretName:  "openai-credentials",
+					Injection: llmwardenv1alpha1.InjectionConfig{
+						Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+					},
+				},
+			}
+
+			objs := []client.Object{provider, access}
+			if tt.existingES != nil {
+				objs = append(objs, tt.existingES)
+			}
+			if tt.existingSecret != nil {
+				objs = append(objs, tt.existingSecret)
+			}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(objs...).
+				WithStatusSubresource(&llmwardenv1alpha1.LLMAccess{}).
+				Build()
+
+			r := &LLMAccessReconciler{
+				Client:                    fakeClient,
+				Scheme:                    scheme,
+				Recorder:                  record.NewFakeRecorder(10),
+				ExternalSecretProvisioner: provisioner.NewExternalSecretProvisioner(fakeClient, scheme, adapter),
+			}
+
+			key := types.NamespacedName{Name: access.Name, Namespace: access.Namespace}
+			result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+			if err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+			if tt.wantRequeue && result.RequeueAfter != externalSecretSyncRequeueInterval {
+				t.Errorf("RequeueAfter = %v, want %v", result.RequeueAfter, externalSecretSyncRequeueInterval)
+			}
+			if !tt.wantRequeue && result.RequeueAfter == externalSecretSyncRequeueInterval {
+				t.Errorf("RequeueAfter = %v, want no sync requeue", result.RequeueAfter)
+			}
+
+			updated := &llmwardenv1alpha1.LLMAccess{}
+			if err := fakeClient.Get(ctx, key, updated); err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			ready := apimeta.FindStatusCondition(updated.Status.Conditions, ConditionTypeReady)
+			if ready == nil {
+				t.Fatal("Ready condition not set")
+			}
+			if ready.Status != tt.wantReady || ready.Reason != tt.wantReason {
+				t.Errorf("Ready = %s/%s, want %s/%s", ready.Status, ready.Reason, tt.wantReady, tt.wantReason)
+			}
+			if updated.Status.Phase != tt.wantPhase {
+				t.Errorf("Phase = %q, want %q", updated.Status.Phase, tt.wantPhase)
+			}
+			if got := updated.Status.LastRotation != nil; got != tt.wantLastRotated {
+				t.Errorf("LastRotation set = %v, want %v", got, tt.wantLastRotated)
+			}
+			source := updated.Status.SourceSecretRef
+			if source == nil {
+				t.Fatal("SourceSecretRef not set")
+			}
+			if source.Kind != "ClusterSecretStore" || source.Name != "vault" || source.Namespace != "" ||
+				source.FieldPath != "remoteRef[secret/openai]" {
+				t.Errorf("SourceSecretRef = %+v, want ClusterSecretStore vault remoteRef[secret/openai]", source)
+			}
+			wantStore := llmwardenv1alpha1.StoreReference{Name: "vault", Kind: llmwardenv1alpha1.SecretStoreKindClusterSecretStore}
+			if store := updated.Status.ResolvedStore; store == nil || *store != wantStore {
+				t.Errorf("ResolvedStore = %+v, want %+v", store, wantStore)
+			}
+		})
+	}
+}
+
+func TestLLMAccessReconciler_EffectiveRefreshInterval(t *testing.T) {
+	adapter := eso.NewV1Beta1Adapter()
+
+	tests := []struct {
+		name             string
+		providerInterval string
+		accessInterval   string
+		want             string
+	}{
+		{name: "access override wins", providerInterval: "30m", accessInterval: "12h", want: "12h"},
+		{name: "provider interval applies without an override", providerInterval: "30m", want: "30m"},
+		{name: "ESO default applies when neither is set", want: "1h"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			scheme := runtime.NewScheme()
+			_ = clientgoscheme.AddToScheme(scheme)
+			_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+			provider := &llmwardenv1alpha1.LLMProvider{
+				ObjectMeta: metav1.ObjectMeta{Name: "openai-eso"},
+				Spec: llmwardenv1alpha1.LLMProviderSpec{
+					Provider: llmwardenv1alpha1.ProviderOpenAI,
+					Auth: llmwardenv1alpha1.AuthConfig{
+						Type: llmwardenv1alpha1.AuthTypeExternalSecret,
+						ExternalSecret: &llmwardenv1alpha1.ExternalSecretAuth{
+							Store:           llmwardenv1alpha1.StoreReference{Name: "vault", Kind: llmwardenv1alpha1.SecretStoreKindClusterSecretStore},
+							RemoteRef:       llmwardenv1alpha1.RemoteReference{Key: "secret/openai"},
+							RefreshInterval: tt.providerInterval,
+						},
+					},
+				},
+			}
+			access := &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "openai-access",
+					Namespace:  "team-a",
+					Finalizers: []string{llmAccessFinalizer},
+				},
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai-eso"},
+					SecretName:  "openai-credentials",
+					Injection: llmwardenv1alpha1.InjectionConfig{
+						Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+					},
+				},
+			}
+			if tt.accessInterval != "" {
+				access.Spec.Rotation = &llmwardenv1alpha1.AccessRotationConfig{Interval: tt.accessInterval}
+			}
+
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(provider, access).
+				WithStatusSubresource(&llmwardenv1alpha1.LLMAccess{}).
+				Build()
+			r := &LLMAccessReconciler{
+				Client:                    fakeClient,
+				Scheme:                    scheme,
+				Recorder:                  record.NewFakeRecorder(10),
+				ExternalSecretProvisioner: provisioner.NewExternalSecretProvisioner(fakeClient, scheme, adapter),
+			}
+
+			key := types.NamespacedName{Name: access.Name, Namespace: access.Namespace}
+			if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+
+			updated := &llmwardenv1alpha1.LLMAccess{}
+			if err := fakeClient.Get(ctx, key, updated); err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			if updated.Status.EffectiveRefreshInterval != tt.want {
+				t.Errorf("EffectiveRefreshInterval = %q, want %q", updated.Status.EffectiveRefreshInterval, tt.want)
+			}
+		})
+	}
+}
+
+func TestExternalSecretSyncRequeue(t *testing.T) {
+	tests := []struct {
+		refreshInterval string
+		want            time.Duration
+	}{
+		{refreshInterval: "1h", want: externalSecretSyncRequeueInterval},
+		{refreshInterval: "7d", want: externalSecretSyncRequeueInterval},
+		{refreshInterval: "10s", want: 10 * time.Second},
+		{refreshInterval: "500ms", want: time.Second},
+		{refreshInterval: "0s", want: externalSecretSyncRequeueInterval},
+		{refreshInterval: "", want: externalSecretSyncRequeueInterval},
+		{refreshInterval: "soon", want: externalSecretSyncRequeueInterval},
+	}
+	for _, tt := range tests {
+		if got := externalSecretSyncRequeue(tt.refreshInterval); got != tt.want {
+			t.Errorf("externalSecretSyncRequeue(%q) = %v, want %v", tt.refreshInterval, got, tt.want)
+		}
+	}
+}
+
+func TestLLMAccessReconciler_ExternalSecretSyncRequeueStops(t *testing.T) {
+	ctx := context.Background()
+	adapter := eso.NewV1Beta1Adapter()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai-eso"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderOpenAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeExternalSecret,
+				ExternalSecret: &llmwardenv1alpha1.ExternalSecretAuth{
+					Store:           llmwardenv1alpha1.StoreReference{Name: "vault", Kind: llmwardenv1alpha1.SecretStoreKindClusterSecretStore},
+					RemoteRef:       llmwardenv1alpha1.RemoteReference{Key: "secret/openai"},
+					RefreshInterval: "10s",
+				},
+			},
+		},
+	}
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "openai-access",
+			Namespace:  "team-a",
+			Finalizers: []string{llmAccessFinalizer},
+		},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai-eso"},
+			SecretName:  "openai-credentials",
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+			},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(provider, access).
+		WithStatusSubresource(&llmwardenv1alpha1.LLMAccess{}).
+		Build()
+	r := &LLMAccessReconciler{
+		Client:                    fakeClient,
+		Scheme:                    scheme,
+		Recorder:                  record.NewFakeRecorder(10),
+		ExternalSecretProvisioner: provisioner.NewExternalSecretProvisioner(fakeClient, scheme, adapter),
+	}
+	key := types.NamespacedName{Name: access.Name, Namespace: access.Namespace}
+
+	// The freshly created ExternalSecret hasn't synced: re-check at the refresh interval.
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if result.RequeueAfter != 10*time.Second {
+		t.Errorf("RequeueAfter while pending = %v, want 10s", result.RequeueAfter)
+	}
+
+	// ESO reports the sync and writes the secret; the access becomes Ready and the sync
+	// polling stops.
+	syncedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "openai-credentials"},
+		Data:       map[string][]byte{"apiKey": []byte("sk-synced")},
+	}
+	if err := fakeClient.Create(ctx, syncedSecret); err != nil {
+		t.Fatalf("Create(synced secret) error = %v", err)
+	}
+	es := &unstructured.Unstructured{}
+	es.SetGroupVersionKind(adapter.GVK())
+	esKey := types.NamespacedName{Name: access.Spec.SecretName, Namespace: access.Namespace}
+	if err := fakeClient.Get(ctx, esKey, es); err != nil {
+		t.Fatalf("Get(ExternalSecret) error = %v", err)
+	}
+	es.Object["status"] = map[string]any{
+		"conditions": []any{
+			map[string]any{"type": "Ready", "status": "True", "message": "Secret was synced"},
+		},
+	}
+	if err := fakeClient.Update(ctx, es); err != nil {
+		t.Fatalf("Update(ExternalSecret) error = %v", err)
+	}
+
+	result, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if result.RequeueAfter != 0 {
+		t.Errorf("RequeueAfter once synced = %v, want no requeue", result.RequeueAfter)
+	}
+	updated := &llmwardenv1alpha1.LLMAccess{}
+	if err := fakeClient.Get(ctx, key, updated); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if !apimeta.IsStatusConditionTrue(updated.Status.Conditions, ConditionTypeReady) {
+		t.Errorf("Ready condition = %+v, want True once synced",
+			apimeta.FindStatusCondition(updated.Status.Conditions, ConditionTypeReady))
+	}
+}
+
+func TestLLMAccessReconciler_AuthTypeChangeCleansUpPrevious(t *testing.T) {
+	ctx := context.Background()
+	adapter := eso.NewV1Beta1Adapter()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+	// The provider used to be apiKey and now delegates to ESO.
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderOpenAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeExternalSecret,
+				ExternalSecret: &llmwardenv1alpha1.ExternalSecretAuth{
+					Store: llmwardenv1alpha1.StoreReference{
+						Name: "vault",
+						Kind: llmwardenv1alpha1.SecretStoreKindClusterSecretStore,
+					},
+					RemoteRef: llmwardenv1alpha1.RemoteReference{Key: "secret/openai"},
+				},
+			},
+		},
+	}
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "openai-access",
+			Namespace:  "team-a",
+			Finalizers: []string{llmAccessFinalizer},
+		},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai"},
+			SecretName:  "openai-credentials",
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+			},
+		},
+		Status: llmwardenv1alpha1.LLMAccessStatus{
+			ProvisionedAuthType: llmwardenv1alpha1.AuthTypeAPIKey,
+		},
+	}
+	copiedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai-credentials", Namespace: "team-a"},
+		Data:       map[string][]byte{"apiKey": []byte("sk-copied")},
+	}
+	// A running consumer doesn't hold up the switch: the ExternalSecret writes a secret
+	// under the same name.
+	consumer := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "team-a"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:    "app",
+				EnvFrom: []corev1.EnvFromSource{{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "openai-credentials"}}}},
+			}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(provider, access, copiedSecret, consumer).
+		WithStatusSubresource(&llmwardenv1alpha1.LLMAccess{}).
+		Build()
+
+	recorder := record.NewFakeRecorder(10)
+	r := &LLMAccessReconciler{
+		Client:                    fakeClient,
+		Scheme:                    scheme,
+		Recorder:                  recorder,
+		ApiKeyProvisioner:         provisioner.NewApiKeyProvisioner(fakeClient, scheme),
+		ExternalSecretProvisioner: provisioner.NewExternalSecretProvisioner(fakeClient, scheme, adapter),
+	}
+
+	key := types.NamespacedName{Name: access.Name, Namespace: access.Namespace}
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	secretKey := types.NamespacedName{Name: "openai-credentials", Namespace: "team-a"}
+	if err := fakeClient.Get(ctx, secretKey, &corev1.Secret{}); !apierrors.IsNotFound(err) {
+		t.Errorf("Get(copied secret) error = %v, want NotFound after apiKey cleanup", err)
+	}
+
+	es := &unstructured.Unstructured{}
+	es.SetGroupVersionKind(adapter.GVK())
+	if err := fakeClient.Get(ctx, secretKey, es); err != nil {
+		t.Errorf("Get(ExternalSecret) error = %v, want it created by the new provisioner", err)
+	}
+
+	updated := &llmwardenv1alpha1.LLMAccess{}
+	if err := fakeClient.Get(ctx, key, updated); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if updated.Status.ProvisionedAuthType != llmwardenv1alpha1.AuthTypeExternalSecret {
+		t.Errorf("ProvisionedAuthType = %q, want %q", updated.Status.ProvisionedAuthType, llmwardenv1alpha1.AuthTypeExternalSecret)
+	}
+
+	var sawChange bool
+	for len(recorder.Events) > 0 {
+		if strings.Contains(<-recorder.Events, ReasonAuthTypeChanged) {
+			sawChange = true
+		}
+	}
+	if !sawChange {
+		t.Errorf("expected a %s event", ReasonAuthTypeChanged)
+	}
+}
+
+func TestLLMAccessReconciler_ProviderAuthTypeFlip(t *testing.T) {
+	ctx := context.Background()
+	adapter := eso.NewV1Beta1Adapter()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderOpenAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef: llmwardenv1alpha1.SecretReference{Name: "openai-master", Namespace: "llmwarden-system", Key: "api-key"},
+				},
+			},
+		},
+	}
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai-master", Namespace: "llmwarden-system"},
+		Data:       map[string][]byte{"api-key": []byte("sk-copied")},
+	}
+	access := func(name, providerName string) *llmwardenv1alpha1.LLMAccess {
+		return &llmwardenv1alpha1.LLMAccess{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Namespace:  "team-a",
+				Finalizers: []string{llmAccessFinalizer},
+			},
+			Spec: llmwardenv1alpha1.LLMAccessSpec{
+				ProviderRef: llmwardenv1alpha1.ProviderReference{Name: providerName},
+				SecretName:  name + "-credentials",
+				Injection: llmwardenv1alpha1.InjectionConfig{
+					Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+				},
+			},
+		}
+	}
+	dependent := access("openai-access", "openai")
+	unrelated := access("anthropic-access", "anthropic")
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(provider, source, dependent, unrelated).
+		WithStatusSubresource(&llmwardenv1alpha1.LLMAccess{}).
+		WithIndex(&llmwardenv1alpha1.LLMAccess{}, providerRefNameField, indexProviderRef).
+		Build()
+	recorder := record.NewFakeRecorder(20)
+	r := &LLMAccessReconciler{
+		Client:                    fakeClient,
+		Scheme:                    scheme,
+		Recorder:                  recorder,
+		ApiKeyProvisioner:         provisioner.NewApiKeyProvisioner(fakeClient, scheme),
+		ExternalSecretProvisioner: provisioner.NewExternalSecretProvisioner(fakeClient, scheme, adapter),
+	}
+
+	key := types.NamespacedName{Name: dependent.Name, Namespace: dependent.Namespace}
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	secretKey := types.NamespacedName{Name: dependent.Spec.SecretName, Namespace: dependent.Namespace}
+	if err := fakeClient.Get(ctx, secretKey, &corev1.Secret{}); err != nil {
+		t.Fatalf("Get(copied secret) error = %v, want it provisioned with apiKey auth", err)
+	}
+
+	// The operator switches the provider to ESO.
+	current := &llmwardenv1alpha1.LLMProvider{}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Name: provider.Name}, current); err != nil {
+		t.Fatalf("Get(provider) error = %v", err)
+	}
+	current.Spec.Auth = llmwardenv1alpha1.AuthConfig{
+		Type: llmwardenv1alpha1.AuthTypeExternalSecret,
+		ExternalSecret: &llmwardenv1alpha1.ExternalSecretAuth{
+			Store:     llmwardenv1alpha1.StoreReference{Name: "vault", Kind: llmwardenv1alpha1.SecretStoreKindClusterSecretStore},
+			RemoteRef: llmwardenv1alpha1.RemoteReference{Key: "secret/openai"},
+		},
+	}
+	if err := fakeClient.Update(ctx, current); err != nil {
+		t.Fatalf("Update(provider) error = %v", err)
+	}
+
+	// The provider watch enqueues only the dependent access.
+	reqs := r.mapProviderToAccesses(ctx, current)
+	if len(reqs) != 1 || reqs[0].NamespacedName != key {
+		t.Fatalf("mapProviderToAccesses() = %v, want only %s", reqs, key)
+	}
+	for _, req := range reqs {
+		if _, err := r.Reconcile(ctx, req); err != nil {
+			t.Fatalf("Reconcile() after the auth type change error = %v", err)
+		}
+	}
+
+	if err := fakeClient.Get(ctx, secretKey, &corev1.Secret{}); !apierrors.IsNotFound(err) {
+		t.Errorf("Get(copied secret) error = %v, want NotFound after apiKey cleanup", err)
+	}
+	es := &unstructured.Unstructured{}
+	es.SetGroupVersionKind(adapter.GVK())
+	if err := fakeClient.Get(ctx, secretKey, es); err != nil {
+		t.Errorf("Get(ExternalSecret) error = %v, want it created by the new provisioner", err)
+	}
+
+	updated := &llmwardenv1alpha1.LLMAccess{}
+	if err := fakeClient.Get(ctx, key, updated); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if updated.Status.ProvisionedAuthType != llmwardenv1alpha1.AuthTypeExternalSecret {
+		t.Errorf("ProvisionedAuthType = %q, want %q", updated.Status.ProvisionedAuthType, llmwardenv1alpha1.AuthTypeExternalSecret)
+	}
+	change := updated.Status.LastAuthTypeChange
+	if change == nil || change.From != llmwardenv1alpha1.AuthTypeAPIKey || change.To != llmwardenv1alpha1.AuthTypeExternalSecret || change.Time.IsZero() {
+		t.Fatalf("LastAuthTypeChange = %+v, want apiKey to externalSecret with a time", change)
+	}
+
+	// Further reconciles don't record the change again.
+	recorded := change.Time
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if err := fakeClient.Get(ctx, key, updated); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if updated.Status.LastAuthTypeChange == nil || !updated.Status.LastAuthTypeChange.Time.Equal(&recorded) {
+		t.Errorf("LastAuthTypeChange = %+v after a steady-state reconcile, want it unchanged", updated.Status.LastAuthTypeChange)
+	}
+}
+
+// TestLLMAccessReconciler_ExternalSecretDryRunRejected simulates ESO's validating webhook
+// denying the ExternalSecret: the fake client rejects dry-run creates as the apiserver
+// would, with a 403 that must not be mistaken for missing RBAC.
+func TestLLMAccessReconciler_ExternalSecretDryRunRejected(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	adapter := eso.NewV1Beta1Adapter()
+
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai-eso"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderOpenAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeExternalSecret,
+				ExternalSecret: &llmwardenv1alpha1.ExternalSecretAuth{
+					Store:     llmwardenv1alpha1.StoreReference{Name: "vault", Kind: llmwardenv1alpha1.SecretStoreKindClusterSecretStore},
+					RemoteRef: llmwardenv1alpha1.RemoteReference{Key: "secret/openai"},
+				},
+			},
+		},
+	}
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "openai-access",
+			Namespace:  "team-a",
+			Finalizers: []string{llmAccessFinalizer},
+		},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai-eso"},
+			SecretName:  "openai-credentials",
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+			},
+		},
+	}
+
+	denial := `admission webhook "validate.externalsecret.external-secrets.io" denied the request: ` +
+		`secretStoreRef: ClusterSecretStore vault not allowed in namespace team-a`
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(provider, access).
+		WithStatusSubresource(&llmwardenv1alpha1.LLMAccess{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				createOpts := &client.CreateOptions{}
+				createOpts.ApplyOptions(opts)
+				if len(createOpts.DryRun) > 0 && obj.GetObjectKind().GroupVersionKind() == adapter.GVK() {
+					return apierrors.NewForbidden(schema.GroupResource{Group: "external-secrets.io", Resource: "externalsecrets"},
+						obj.GetName(), errors.New(denial))
+				}
+				return c.Create(ctx, obj, opts...)
+			},
+		}).
+		Build()
+
+	esProvisioner := provisioner.NewExternalSecretProvisioner(fakeClient, scheme, adapter)
+	esProvisioner.DryRunValidation = true
+	r := &LLMAccessReconciler{
+		Client:                    fakeClient,
+		Scheme:                    scheme,
+		Recorder:                  record.NewFakeRecorder(10),
+		ExternalSecretProvisioner: esProvisioner,
+	}
+
+	key := types.NamespacedName{Name: access.Name, Namespace: access.Namespace}
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v, want the rejection reported in status only", err)
+	}
+	if result.RequeueAfter != externalSecretRejectedRequeueInterval {
+		t.Errorf("RequeueAfter = %v, want %v", result.RequeueAfter, externalSecretRejectedRequeueInterval)
+	}
+
+	es := &unstructured.Unstructured{}
+	es.SetGroupVersionKind(adapter.GVK())
+	if err := fakeClient.Get(ctx, types.NamespacedName{Namespace: "team-a", Name: "openai-credentials"}, es); !apierrors.IsNotFound(err) {
+		t.Errorf("Get(ExternalSecret) error = %v, want NotFound after a rejected dry-run", err)
+	}
+
+	updated := &llmwardenv1alpha1.LLMAccess{}
+	if err := fakeClient.Get(ctx, key, updated); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	for _, condType := range []string{ConditionTypeReady, ConditionTypeCredentialProvisioned} {
+		cond := apimeta.FindStatusCondition(updated.Status.Conditions, condType)
+		if cond == nil || cond.Status != metav1.ConditionFalse || cond.Reason != ReasonExternalSecretRejected {
+			t.Errorf("%s = %+v, want False/%s", condType, cond, ReasonExternalSecretRejected)
+		} else if !strings.Contains(cond.Message, "ClusterSecretStore vault not allowed") {
+			t.Errorf("%s message = %q, want the webhook's validation error", condType, cond.Message)
+		}
+	}
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	webhookv1alpha1 "github.com/llmwarden/llmwarden/internal/webhook/v1alpha1"
+)
+
+func TestLLMAccessReconciler_Finalizers(t *testing.T) {
+	tests := []struct {
+		name           string
+		finalizer      string
+		disable        bool
+		existing       []string
+		wantFinalizers []string
+	}{
+		{
+			name:           "adds the default finalizer",
+			wantFinalizers: []string{llmAccessFinalizer},
+		},
+		{
+			name:           "migrates the default finalizer to a custom name",
+			finalizer:      "example.com/llm-cleanup",
+			existing:       []string{llmAccessFinalizer, "example.com/other"},
+			wantFinalizers: []string{"example.com/other", "example.com/llm-cleanup"},
+		},
+		{
+			name:           "removes the default finalizer when disabled",
+			disable:        true,
+			existing:       []string{llmAccessFinalizer, "example.com/other"},
+			wantFinalizers: []string{"example.com/other"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			scheme := runtime.NewScheme()
+			_ = clientgoscheme.AddToScheme(scheme)
+			_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+			access := &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{Name: "openai-access", Namespace: "team-a", Finalizers: tt.existing},
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai"},
+					SecretName:  "openai-credentials",
+				},
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(access).Build()
+			r := &LLMAccessReconciler{
+				Client:           fakeClient,
+				Scheme:           scheme,
+				Recorder:         record.NewFakeRecorder(10),
+				Finalizer:        tt.finalizer,
+				DisableFinalizer: tt.disable,
+			}
+
+			key := types.NamespacedName{Name: access.Name, Namespace: access.Namespace}
+			result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+			if err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+			if !result.Requeue {
+				t.Errorf("Reconcile() result = %+v, want a requeue after updating finalizers", result)
+			}
+
+			updated := &llmwardenv1alpha1.LLMAccess{}
+			if err := fakeClient.Get(ctx, key, updated); err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			if !slices.Equal(updated.Finalizers, tt.wantFinalizers) {
+				t.Errorf("finalizers = %v, want %v", updated.Finalizers, tt.wantFinalizers)
+			}
+		})
+	}
+}
+
+// TestLLMAccessReconciler_FinalizerDisabledDeletion checks that an access created while the
+// finalizer was enabled is still released once it is disabled.
+func TestLLMAccessReconciler_FinalizerDisabledDeletion(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+	now := metav1.Now()
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "openai-access",
+			Namespace:         "team-a",
+			Finalizers:        []string{llmAccessFinalizer},
+			DeletionTimestamp: &now,
+		},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai"},
+			SecretName:  "openai-credentials",
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(access).Build()
+	r := &LLMAccessReconciler{
+		Client:           fakeClient,
+		Scheme:           scheme,
+		Recorder:         record.NewFakeRecorder(10),
+		DisableFinalizer: true,
+	}
+
+	key := types.NamespacedName{Name: access.Name, Namespace: access.Namespace}
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if err := fakeClient.Get(ctx, key, &llmwardenv1alpha1.LLMAccess{}); !apierrors.IsNotFound(err) {
+		t.Errorf("Get() error = %v, want NotFound once the finalizer is removed", err)
+	}
+}
+
+func TestLLMAccessReconciler_CleanupInjectedAnnotationsAccessAnnotation(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+	access := func(name, provider string) *llmwardenv1alpha1.LLMAccess {
+		return &llmwardenv1alpha1.LLMAccess{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "team-a"},
+			Spec: llmwardenv1alpha1.LLMAccessSpec{
+				ProviderRef: llmwardenv1alpha1.ProviderReference{Name: provider},
+				SecretName:  name + "-credentials",
+			},
+		}
+	}
+	// Both openai accesses are bound to pods only through the pod's access annotation.
+	deleted := access("openai-access", "openai-prod")
+	backup := access("openai-backup", "openai-prod")
+	anthropic := access("anthropic-access", "anthropic-prod")
+	anthropic.Spec.WorkloadSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "chatbot"}}
+
+	details := func(accesses ...*llmwardenv1alpha1.LLMAccess) string {
+		var entries []webhookv1alpha1.InjectionDetail
+		for _, a := range accesses {
+			entries = append(entries, webhookv1alpha1.InjectionDetail{Access: a.Name, Provider: a.Spec.ProviderRef.Name, Containers: 1})
+		}
+		raw, err := json.Marshal(entries)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(raw)
+	}
+	pod := func(name, accesses, providers string, injected ...*llmwardenv1alpha1.LLMAccess) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "team-a",
+				Labels:    map[string]string{"app": "chatbot"},
+				Annotations: map[string]string{
+					webhookv1alpha1.AccessAnnotation():            accesses,
+					webhookv1alpha1.InjectedProvidersAnnotation(): providers,
+					webhookv1alpha1.InjectionStatusAnnotation():   "injected",
+					webhookv1alpha1.InjectionDetailAnnotation():   details(injected...),
+				},
+			},
+		}
+	}
+	bound := pod("bound", "openai-access", "anthropic-prod,openai-prod", anthropic, deleted)
+	shared := pod("shared", "openai-access,openai-backup", "openai-prod", deleted, backup)
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(backup, anthropic, bound, shared).
+		Build()
+	r := &LLMAccessReconciler{Client: fakeClient, Scheme: scheme, Recorder: record.NewFakeRecorder(10)}
+
+	if err := r.cleanupInjectedAnnotations(ctx, deleted); err != nil {
+		t.Fatalf("cleanupInjectedAnnotations() error = %v", err)
+	}
+
+	get := func(name string) map[string]string {
+		updated := &corev1.Pod{}
+		if err := fakeClient.Get(ctx, types.NamespacedName{Name: name, Namespace: "team-a"}, updated); err != nil {
+			t.Fatalf("Get(%s) error = %v", name, err)
+		}
+		return updated.Annotations
+	}
+	if got := get("bound"); got[webhookv1alpha1.InjectedProvidersAnnotation()] != "anthropic-prod" ||
+		got[webhookv1alpha1.InjectionDetailAnnotation()] != details(anthropic) {
+		t.Errorf("bound pod annotations = %v, want only anthropic-prod and its detail left", got)
+	}
+	if got := get("shared"); got[webhookv1alpha1.InjectedProvidersAnnotation()] != "openai-prod" ||
+		got[webhookv1alpha1.InjectionDetailAnnotation()] != details(deleted, backup) {
+		t.Errorf("shared pod annotations = %v, want them unchanged while openai-backup still injects openai-prod", got)
+	}
+}
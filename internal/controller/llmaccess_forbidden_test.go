@@ -0,0 +1,202 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/provisioner"
+)
+
+// TestLLMAccessReconciler_SourceSecretForbidden runs against the fake client because
+// envtest runs as an administrator and never returns Forbidden.
+func TestLLMAccessReconciler_SourceSecretForbidden(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderOpenAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef: llmwardenv1alpha1.SecretReference{Name: "openai-master", Namespace: "vault-sync", Key: "api-key"},
+				},
+			},
+		},
+	}
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "openai-access",
+			Namespace:  "team-a",
+			Finalizers: []string{llmAccessFinalizer},
+		},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai"},
+			SecretName:  "openai-credentials",
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+			},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(provider, access).
+		WithStatusSubresource(&llmwardenv1alpha1.LLMAccess{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				if _, ok := obj.(*corev1.Secret); ok && key.Namespace == "vault-sync" {
+					return apierrors.NewForbidden(corev1.Resource("secrets"), key.Name, nil)
+				}
+				return c.Get(ctx, key, obj, opts...)
+			},
+		}).
+		Build()
+
+	r := &LLMAccessReconciler{
+		Client:            fakeClient,
+		Scheme:            scheme,
+		Recorder:          record.NewFakeRecorder(10),
+		ApiKeyProvisioner: provisioner.NewApiKeyProvisioner(fakeClient, scheme),
+	}
+
+	key := types.NamespacedName{Name: access.Name, Namespace: access.Namespace}
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v, want nil so the forbidden error isn't retried with backoff", err)
+	}
+	if result.RequeueAfter != sourceSecretForbiddenRequeueInterval {
+		t.Errorf("RequeueAfter = %v, want %v", result.RequeueAfter, sourceSecretForbiddenRequeueInterval)
+	}
+
+	updated := &llmwardenv1alpha1.LLMAccess{}
+	if err := fakeClient.Get(ctx, key, updated); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	ready := apimeta.FindStatusCondition(updated.Status.Conditions, ConditionTypeReady)
+	if ready == nil || ready.Status != metav1.ConditionFalse || ready.Reason != ReasonSourceSecretForbidden {
+		t.Fatalf("Ready = %+v, want False/%s", ready, ReasonSourceSecretForbidden)
+	}
+}
+
+// TestLLMAccessReconciler_TargetSecretWriteForbidden checks that a forbidden write of the
+// target secret isn't reported as a forbidden read of the source secret.
+func TestLLMAccessReconciler_TargetSecretWriteForbidden(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderOpenAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef: llmwardenv1alpha1.SecretReference{Name: "openai-master", Namespace: "vault-sync", Key: "api-key"},
+				},
+			},
+		},
+	}
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai-master", Namespace: "vault-sync"},
+		Data:       map[string][]byte{"api-key": []byte("sk-test")},
+	}
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "openai-access",
+			Namespace:  "team-a",
+			Finalizers: []string{llmAccessFinalizer},
+		},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai"},
+			SecretName:  "openai-credentials",
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+			},
+		},
+	}
+
+	forbidTargetWrite := func(obj client.Object) error {
+		if _, ok := obj.(*corev1.Secret); ok && obj.GetNamespace() == "team-a" {
+			return apierrors.NewForbidden(corev1.Resource("secrets"), obj.GetName(), nil)
+		}
+		return nil
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(provider, source, access).
+		WithStatusSubresource(&llmwardenv1alpha1.LLMAccess{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				if err := forbidTargetWrite(obj); err != nil {
+					return err
+				}
+				return c.Create(ctx, obj, opts...)
+			},
+			Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+				if err := forbidTargetWrite(obj); err != nil {
+					return err
+				}
+				return c.Update(ctx, obj, opts...)
+			},
+		}).
+		Build()
+
+	r := &LLMAccessReconciler{
+		Client:            fakeClient,
+		Scheme:            scheme,
+		Recorder:          record.NewFakeRecorder(10),
+		ApiKeyProvisioner: provisioner.NewApiKeyProvisioner(fakeClient, scheme),
+	}
+
+	key := types.NamespacedName{Name: access.Name, Namespace: access.Namespace}
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+	if err == nil && result.RequeueAfter == sourceSecretForbiddenRequeueInterval {
+		t.Errorf("Reconcile() backed off as for a forbidden source secret read")
+	}
+
+	updated := &llmwardenv1alpha1.LLMAccess{}
+	if err := fakeClient.Get(ctx, key, updated); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	for _, condition := range updated.Status.Conditions {
+		if condition.Reason == ReasonSourceSecretForbidden {
+			t.Errorf("condition %s has reason %s for a forbidden target secret write", condition.Type, ReasonSourceSecretForbidden)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/provisioner"
+)
+
+// TestLLMAccessReconciler_PrunesRemovedEndpoint removes the provider endpoint and checks
+// that the next reconcile drops baseUrl from the target secret but keeps keys llmwarden
+// never wrote.
+func TestLLMAccessReconciler_PrunesRemovedEndpoint(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderOpenAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef: llmwardenv1alpha1.SecretReference{Name: "openai-master", Namespace: "vault-sync", Key: "api-key"},
+				},
+			},
+			Endpoint: &llmwardenv1alpha1.EndpointConfig{BaseURL: "https://proxy.example.com/v1"},
+		},
+	}
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai-master", Namespace: "vault-sync"},
+		Data:       map[string][]byte{"api-key": []byte("sk-test")},
+	}
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "openai-access",
+			Namespace:  "team-a",
+			Finalizers: []string{llmAccessFinalizer},
+		},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai"},
+			SecretName:  "openai-credentials",
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+			},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(provider, source, access).
+		WithStatusSubresource(&llmwardenv1alpha1.LLMAccess{}).
+		Build()
+	r := &LLMAccessReconciler{
+		Client:            fakeClient,
+		Scheme:            scheme,
+		Recorder:          record.NewFakeRecorder(20),
+		ApiKeyProvisioner: provisioner.NewApiKeyProvisioner(fakeClient, scheme),
+	}
+
+	key := types.NamespacedName{Name: access.Name, Namespace: access.Namespace}
+	secretKey := types.NamespacedName{Name: "openai-credentials", Namespace: "team-a"}
+	reconcile := func() *corev1.Secret {
+		t.Helper()
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+		secret := &corev1.Secret{}
+		if err := fakeClient.Get(ctx, secretKey, secret); err != nil {
+			t.Fatalf("Get(secret) error = %v", err)
+		}
+		return secret
+	}
+
+	secret := reconcile()
+	if got := secret.Annotations[provisioner.ManagedKeysAnnotation()]; !strings.Contains(got, "baseUrl") {
+		t.Fatalf("%s = %q, want it to list baseUrl", provisioner.ManagedKeysAnnotation(), got)
+	}
+
+	// Fold StringData into Data the way the API server does, and add a key of our own.
+	secret.Data = map[string][]byte{"team-note": []byte("keep me")}
+	for k, v := range secret.StringData {
+		secret.Data[k] = []byte(v)
+	}
+	secret.StringData = nil
+	if err := fakeClient.Update(ctx, secret); err != nil {
+		t.Fatalf("Update(secret) error = %v", err)
+	}
+
+	if err := fakeClient.Get(ctx, types.NamespacedName{Name: provider.Name}, provider); err != nil {
+		t.Fatalf("Get(provider) error = %v", err)
+	}
+	provider.Spec.Endpoint = nil
+	if err := fakeClient.Update(ctx, provider); err != nil {
+		t.Fatalf("Update(provider) error = %v", err)
+	}
+
+	secret = reconcile()
+	if _, ok := secret.Data["baseUrl"]; ok {
+		t.Errorf("Data[baseUrl] = %q after the endpoint was removed, want it pruned", secret.Data["baseUrl"])
+	}
+	if _, ok := secret.StringData["baseUrl"]; ok {
+		t.Errorf("StringData[baseUrl] = %q after the endpoint was removed, want it pruned", secret.StringData["baseUrl"])
+	}
+	if got := string(secret.Data["team-note"]); got != "keep me" {
+		t.Errorf("Data[team-note] = %q, want the unmanaged key preserved", got)
+	}
+	if got := secret.Annotations[provisioner.ManagedKeysAnnotation()]; strings.Contains(got, "baseUrl") {
+		t.Errorf("%s = %q, want baseUrl no longer listed", provisioner.ManagedKeysAnnotation(), got)
+	}
+}
@@ -0,0 +1,439 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/metrics"
+	"github.com/llmwarden/llmwarden/internal/notify"
+	"github.com/llmwarden/llmwarden/internal/provisioner"
+)
+
+func TestLLMAccessReconciler_KeyRotationWindow(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderOpenAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef:      llmwardenv1alpha1.SecretReference{Name: "openai-master", Namespace: "llmwarden-system", Key: "current"},
+					NextSecretRef:  &llmwardenv1alpha1.SecretReference{Name: "openai-master", Namespace: "llmwarden-system", Key: "next"},
+					RotationWindow: "2h",
+				},
+			},
+		},
+	}
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai-master", Namespace: "llmwarden-system"},
+		Data: map[string][]byte{
+			"current": []byte("sk-current"),
+			"next":    []byte("sk-next"),
+		},
+	}
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "openai-access",
+			Namespace:  "team-a",
+			Finalizers: []string{llmAccessFinalizer},
+		},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai"},
+			SecretName:  "openai-credentials",
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+			},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(provider, source, access).
+		WithStatusSubresource(&llmwardenv1alpha1.LLMAccess{}).
+		Build()
+
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	r := &LLMAccessReconciler{
+		Client:            fakeClient,
+		Scheme:            scheme,
+		Recorder:          record.NewFakeRecorder(20),
+		ApiKeyProvisioner: provisioner.NewApiKeyProvisioner(fakeClient, scheme),
+		now:               func() time.Time { return now },
+	}
+
+	key := types.NamespacedName{Name: access.Name, Namespace: access.Namespace}
+	secretKey := types.NamespacedName{Name: "openai-credentials", Namespace: "team-a"}
+	reconcile := func() (ctrl.Result, *llmwardenv1alpha1.LLMAccess, *corev1.Secret) {
+		t.Helper()
+		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		if err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+		updated := &llmwardenv1alpha1.LLMAccess{}
+		if err := fakeClient.Get(ctx, key, updated); err != nil {
+			t.Fatalf("Get(access) error = %v", err)
+		}
+		secret := &corev1.Secret{}
+		if err := fakeClient.Get(ctx, secretKey, secret); err != nil {
+			t.Fatalf("Get(secret) error = %v", err)
+		}
+		return result, updated, secret
+	}
+
+	// The window opens: both keys are served and we come back when it closes.
+	result, updated, secret := reconcile()
+	if string(secret.Data["apiKey"]) != "sk-current" || string(secret.Data["apiKeyNext"]) != "sk-next" {
+		t.Errorf("secret data during window = apiKey %q, apiKeyNext %q; want sk-current, sk-next",
+			secret.Data["apiKey"], secret.Data["apiKeyNext"])
+	}
+	if updated.Status.KeyRotation == nil || updated.Status.KeyRotation.Promoted {
+		t.Fatalf("KeyRotation = %+v, want an open window", updated.Status.KeyRotation)
+	}
+	if !updated.Status.KeyRotation.WindowStart.Time.Equal(now) {
+		t.Errorf("WindowStart = %v, want %v", updated.Status.KeyRotation.WindowStart.Time, now)
+	}
+	if result.RequeueAfter != 2*time.Hour {
+		t.Errorf("RequeueAfter = %v, want the 2h window", result.RequeueAfter)
+	}
+
+	// Still inside the window: nothing changes.
+	now = now.Add(time.Hour)
+	result, updated, secret = reconcile()
+	if _, ok := secret.Data["apiKeyNext"]; !ok || updated.Status.KeyRotation.Promoted {
+		t.Error("next key promoted before the window elapsed")
+	}
+	if result.RequeueAfter != time.Hour {
+		t.Errorf("RequeueAfter = %v, want the remaining 1h", result.RequeueAfter)
+	}
+
+	// The window has elapsed: next is promoted and is the only key.
+	now = now.Add(time.Hour)
+	_, updated, secret = reconcile()
+	if string(secret.Data["apiKey"]) != "sk-next" {
+		t.Errorf("apiKey after promotion = %q, want sk-next", secret.Data["apiKey"])
+	}
+	if _, ok := secret.Data["apiKeyNext"]; ok {
+		t.Error("apiKeyNext still present after promotion")
+	}
+	if updated.Status.KeyRotation == nil || !updated.Status.KeyRotation.Promoted {
+		t.Errorf("KeyRotation = %+v, want promoted", updated.Status.KeyRotation)
+	}
+
+	// Once the operator drops nextSecretRef, the rotation state is cleared.
+	if err := fakeClient.Get(ctx, types.NamespacedName{Name: provider.Name}, provider); err != nil {
+		t.Fatalf("Get(provider) error = %v", err)
+	}
+	provider.Spec.Auth.APIKey.SecretRef.Key = "next"
+	provider.Spec.Auth.APIKey.NextSecretRef = nil
+	if err := fakeClient.Update(ctx, provider); err != nil {
+		t.Fatalf("Update(provider) error = %v", err)
+	}
+	_, updated, secret = reconcile()
+	if updated.Status.KeyRotation != nil {
+		t.Errorf("KeyRotation = %+v, want cleared", updated.Status.KeyRotation)
+	}
+	if string(secret.Data["apiKey"]) != "sk-next" {
+		t.Errorf("apiKey after rotation completes = %q, want sk-next", secret.Data["apiKey"])
+	}
+}
+
+func TestLLMAccessReconciler_RotationNotification(t *testing.T) {
+	tests := []struct {
+		name         string
+		namespace    string
+		status       int
+		wantFailures float64
+	}{
+		{name: "rotation posts a notification", namespace: "notify-ok", status: http.StatusOK},
+		{name: "failed notification doesn't fail the reconcile", namespace: "notify-fail", status: http.StatusServiceUnavailable, wantFailures: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			scheme := runtime.NewScheme()
+			_ = clientgoscheme.AddToScheme(scheme)
+			_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+			namespace := tt.namespace
+			provider := &llmwardenv1alpha1.LLMProvider{
+				ObjectMeta: metav1.ObjectMeta{Name: "openai"},
+				Spec: llmwardenv1alpha1.LLMProviderSpec{
+					Provider: llmwardenv1alpha1.ProviderOpenAI,
+					Auth: llmwardenv1alpha1.AuthConfig{
+						Type: llmwardenv1alpha1.AuthTypeAPIKey,
+						APIKey: &llmwardenv1alpha1.APIKeyAuth{
+							SecretRef: llmwardenv1alpha1.SecretReference{Name: "openai-master", Namespace: "llmwarden-system", Key: "api-key"},
+							Rotation:  &llmwardenv1alpha1.RotationConfig{Enabled: true, Interval: "30d"},
+						},
+					},
+				},
+			}
+			source := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "openai-master", Namespace: "llmwarden-system"},
+				Data:       map[string][]byte{"api-key": []byte("sk-secret-value")},
+			}
+			// The scheduled rotation is due.
+			due := metav1.NewTime(time.Now().Add(-time.Minute))
+			access := &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "openai-access",
+					Namespace:  namespace,
+					Finalizers: []string{llmAccessFinalizer},
+				},
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai"},
+					SecretName:  "openai-credentials",
+					Injection: llmwardenv1alpha1.InjectionConfig{
+						Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+					},
+				},
+				Status: llmwardenv1alpha1.LLMAccessStatus{NextRotation: &due},
+			}
+
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(provider, source, access).
+				WithStatusSubresource(&llmwardenv1alpha1.LLMAccess{}).
+				Build()
+
+			var received []map[string]any
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				var payload map[string]any
+				if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+					t.Errorf("decoding notification: %v", err)
+				}
+				received = append(received, payload)
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			r := &LLMAccessReconciler{
+				Client:            fakeClient,
+				Scheme:            scheme,
+				Recorder:          record.NewFakeRecorder(10),
+				ApiKeyProvisioner: provisioner.NewApiKeyProvisioner(fakeClient, scheme),
+				RotationNotifier:  &notify.RotationNotifier{URL: server.URL, HTTPClient: server.Client()},
+			}
+
+			key := types.NamespacedName{Name: access.Name, Namespace: access.Namespace}
+			if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+
+			if len(received) != 1 {
+				t.Fatalf("notifications = %d, want 1", len(received))
+			}
+			payload := received[0]
+			if payload["access"] != "openai-access" || payload["namespace"] != namespace || payload["provider"] != "openai" {
+				t.Errorf("payload = %v, want access openai-access, namespace %s, provider openai", payload, namespace)
+			}
+			if _, err := time.Parse(time.RFC3339, payload["rotatedAt"].(string)); err != nil {
+				t.Errorf("rotatedAt = %v, want an RFC 3339 timestamp", payload["rotatedAt"])
+			}
+			for _, v := range payload {
+				if v == "sk-secret-value" {
+					t.Error("notification payload leaked the credential")
+				}
+			}
+			if got := testutil.ToFloat64(metrics.RotationNotificationFailures.WithLabelValues("openai", namespace)); got != tt.wantFailures {
+				t.Errorf("notification failures = %v, want %v", got, tt.wantFailures)
+			}
+
+			// The next reconcile isn't a rotation: NextRotation moved 30 days out.
+			if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+				t.Fatalf("second Reconcile() error = %v", err)
+			}
+			if len(received) != 1 {
+				t.Errorf("notifications after a non-rotating reconcile = %d, want 1", len(received))
+			}
+		})
+	}
+}
+
+func TestLLMAccessReconciler_RotationHistory(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderOpenAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef: llmwardenv1alpha1.SecretReference{Name: "openai-master", Namespace: "llmwarden-system", Key: "api-key"},
+					Rotation: &llmwardenv1alpha1.RotationConfig{
+						Enabled: true, Interval: "30d", Strategy: llmwardenv1alpha1.RotationStrategyRecreateSecret,
+					},
+				},
+			},
+		},
+	}
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai-master", Namespace: "llmwarden-system"},
+		Data:       map[string][]byte{"api-key": []byte("sk-new-value")},
+	}
+	// The access secret still carries the key from before the source was rotated.
+	target := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "openai-credentials",
+			Namespace: "history",
+			Labels:    map[string]string{"llmwarden.io/managed-by": "llmwarden"},
+		},
+		Data: map[string][]byte{"apiKey": []byte("sk-old-value")},
+	}
+
+	// A full history, so the rotation must drop the oldest record.
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var history []llmwardenv1alpha1.RotationRecord
+	for i := range rotationHistoryLimit {
+		history = append(history, llmwardenv1alpha1.RotationRecord{
+			Time:     metav1.NewTime(start.AddDate(0, 0, 30*i)),
+			ToHash:   fmt.Sprintf("sha256:%016d", i),
+			Strategy: string(llmwardenv1alpha1.RotationStrategyRecreateSecret),
+		})
+	}
+	due := metav1.NewTime(time.Now().Add(-time.Minute))
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "openai-access",
+			Namespace:  "history",
+			Finalizers: []string{llmAccessFinalizer},
+		},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai"},
+			SecretName:  "openai-credentials",
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+			},
+		},
+		Status: llmwardenv1alpha1.LLMAccessStatus{NextRotation: &due, RotationHistory: history},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(provider, source, target, access).
+		WithStatusSubresource(&llmwardenv1alpha1.LLMAccess{}).
+		Build()
+	r := &LLMAccessReconciler{
+		Client:            fakeClient,
+		Scheme:            scheme,
+		Recorder:          record.NewFakeRecorder(20),
+		ApiKeyProvisioner: provisioner.NewApiKeyProvisioner(fakeClient, scheme),
+	}
+
+	key := types.NamespacedName{Name: access.Name, Namespace: access.Namespace}
+	reconcile := func() []llmwardenv1alpha1.RotationRecord {
+		t.Helper()
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+		updated := &llmwardenv1alpha1.LLMAccess{}
+		if err := fakeClient.Get(ctx, key, updated); err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		raw, err := json.Marshal(updated.Status)
+		if err != nil {
+			t.Fatalf("Marshal(status) error = %v", err)
+		}
+		for _, value := range []string{"sk-old-value", "sk-new-value"} {
+			if strings.Contains(string(raw), value) {
+				t.Errorf("status contains the raw credential %q", value)
+			}
+		}
+		return updated.Status.RotationHistory
+	}
+
+	got := reconcile()
+	if len(got) != rotationHistoryLimit {
+		t.Fatalf("rotation history has %d records, want it capped at %d", len(got), rotationHistoryLimit)
+	}
+	if got[0].ToHash != history[1].ToHash {
+		t.Errorf("oldest record ToHash = %q, want %q: the first record should have been dropped", got[0].ToHash, history[1].ToHash)
+	}
+	want := llmwardenv1alpha1.RotationRecord{
+		FromHash: provisioner.CredentialFingerprint([]byte("sk-old-value")),
+		ToHash:   provisioner.CredentialFingerprint([]byte("sk-new-value")),
+		Strategy: string(llmwardenv1alpha1.RotationStrategyRecreateSecret),
+	}
+	last := got[len(got)-1]
+	if last.FromHash != want.FromHash || last.ToHash != want.ToHash || last.Strategy != want.Strategy {
+		t.Errorf("appended record = %+v, want from %s to %s with strategy %s", last, want.FromHash, want.ToHash, want.Strategy)
+	}
+	if last.Time.IsZero() {
+		t.Error("appended record has no time")
+	}
+
+	// The next reconcile isn't a rotation, so nothing is appended.
+	if again := reconcile(); len(again) != rotationHistoryLimit || again[len(again)-1].ToHash != want.ToHash {
+		t.Errorf("rotation history after a non-rotating reconcile = %+v, want it unchanged", again)
+	}
+}
+
+func TestRecordRotation(t *testing.T) {
+	provider := &llmwardenv1alpha1.LLMProvider{
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Auth: llmwardenv1alpha1.AuthConfig{Type: llmwardenv1alpha1.AuthTypeAPIKey, APIKey: &llmwardenv1alpha1.APIKeyAuth{}},
+		},
+	}
+	access := &llmwardenv1alpha1.LLMAccess{}
+	result := &provisioner.ProvisionResult{PreviousCredentialHash: "sha256:aaaa", CredentialHash: "sha256:bbbb"}
+
+	recordRotation(access, provider, result, metav1.Now(), false)
+	recordRotation(access, provider, result, metav1.Now(), true)
+
+	history := access.Status.RotationHistory
+	if len(history) != 2 {
+		t.Fatalf("rotation history has %d records, want 2", len(history))
+	}
+	if history[0].Strategy != string(llmwardenv1alpha1.RotationStrategyProviderAPI) {
+		t.Errorf("scheduled rotation strategy = %q, want the %s default", history[0].Strategy, llmwardenv1alpha1.RotationStrategyProviderAPI)
+	}
+	if history[1].Strategy != llmwardenv1alpha1.RotationStrategyKeyPromotion {
+		t.Errorf("promotion strategy = %q, want %s", history[1].Strategy, llmwardenv1alpha1.RotationStrategyKeyPromotion)
+	}
+	if history[1].FromHash != "sha256:aaaa" || history[1].ToHash != "sha256:bbbb" {
+		t.Errorf("record hashes = %s -> %s, want sha256:aaaa -> sha256:bbbb", history[1].FromHash, history[1].ToHash)
+	}
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/provisioner"
+)
+
+func TestLLMAccessReconciler_LazyProvisioningDisabled(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderOpenAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef: llmwardenv1alpha1.SecretReference{Name: "openai-master", Namespace: "vault-sync", Key: "api-key"},
+				},
+			},
+		},
+	}
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai-master", Namespace: "vault-sync"},
+		Data:       map[string][]byte{"api-key": []byte("sk-test")},
+	}
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "openai-access",
+			Namespace:  "team-a",
+			Finalizers: []string{llmAccessFinalizer},
+		},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai"},
+			SecretName:  "openai-credentials",
+			WorkloadSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "chatbot"},
+			},
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				Env:              []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+				LazyProvisioning: &llmwardenv1alpha1.LazyProvisioningConfig{IdleGracePeriod: "1h"},
+			},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(provider, source, access).
+		WithStatusSubresource(&llmwardenv1alpha1.LLMAccess{}).
+		Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &LLMAccessReconciler{
+		Client:            fakeClient,
+		Scheme:            scheme,
+		Recorder:          recorder,
+		ApiKeyProvisioner: provisioner.NewApiKeyProvisioner(fakeClient, scheme),
+	}
+
+	key := types.NamespacedName{Name: access.Name, Namespace: access.Namespace}
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	// No pod matches, but without --enable-lazy-provisioning the secret is provisioned anyway.
+	secret := &corev1.Secret{}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Name: "openai-credentials", Namespace: "team-a"}, secret); err != nil {
+		t.Fatalf("Get(target secret) error = %v, want it provisioned eagerly", err)
+	}
+
+	var found bool
+	for len(recorder.Events) > 0 {
+		if strings.Contains(<-recorder.Events, ReasonLazyProvisioningDisabled) {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("no %s event recorded", ReasonLazyProvisioningDisabled)
+	}
+}
+
+func TestLLMAccessReconciler_mapPodToLazyAccesses(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+	newAccess := func(name string, lazy bool, mutate func(*llmwardenv1alpha1.LLMAccessSpec)) *llmwardenv1alpha1.LLMAccess {
+		access := &llmwardenv1alpha1.LLMAccess{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "team-a"},
+			Spec: llmwardenv1alpha1.LLMAccessSpec{
+				ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai"},
+				SecretName:  name,
+				WorkloadSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "chatbot"},
+				},
+			},
+		}
+		if lazy {
+			access.Spec.Injection.LazyProvisioning = &llmwardenv1alpha1.LazyProvisioningConfig{}
+		}
+		if mutate != nil {
+			mutate(&access.Spec)
+		}
+		return access
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			newAccess("lazy-match", true, nil),
+			newAccess("eager-match", false, nil),
+			newAccess("lazy-other-app", true, func(spec *llmwardenv1alpha1.LLMAccessSpec) {
+				spec.WorkloadSelector.MatchLabels["app"] = "batch"
+			}),
+			newAccess("lazy-service-account", true, func(spec *llmwardenv1alpha1.LLMAccessSpec) {
+				spec.WorkloadSelector = nil
+				spec.ServiceAccountSelector = &llmwardenv1alpha1.ServiceAccountSelector{Names: []string{"chatbot"}}
+			}),
+		).
+		WithIndex(&llmwardenv1alpha1.LLMAccess{}, lazyProvisioningField, indexLazyProvisioning).
+		Build()
+	r := &LLMAccessReconciler{Client: fakeClient, Scheme: scheme, LazyProvisioning: true}
+
+	// The metadata-only watch hands the map function partial objects.
+	pod := &metav1.PartialObjectMetadata{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{Name: "chatbot-0", Namespace: "team-a", Labels: map[string]string{"app": "chatbot"}},
+	}
+	var got []string
+	for _, req := range r.mapPodToLazyAccesses(context.Background(), pod) {
+		got = append(got, req.Name)
+	}
+	slices.Sort(got)
+
+	// The service account isn't in the metadata, so that access is always woken.
+	want := []string{"lazy-match", "lazy-service-account"}
+	if !slices.Equal(got, want) {
+		t.Errorf("mapPodToLazyAccesses() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/provisioner"
+)
+
+func TestNextWindowOpening(t *testing.T) {
+	// 2026-03-04 is a Wednesday.
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, 3, day, hour, minute, 0, 0, time.UTC)
+	}
+	nightly := &llmwardenv1alpha1.MaintenanceWindow{Start: "02:00", Duration: "2h"}
+	overnight := &llmwardenv1alpha1.MaintenanceWindow{Start: "23:00", Duration: "2h"}
+	weekends := &llmwardenv1alpha1.MaintenanceWindow{Start: "02:00", Duration: "2h", Days: []llmwardenv1alpha1.Weekday{"Sat", "Sun"}}
+
+	tests := []struct {
+		name    string
+		window  *llmwardenv1alpha1.MaintenanceWindow
+		t       time.Time
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "inside the window", window: nightly, t: at(4, 3, 0), want: at(4, 3, 0)},
+		{name: "at the opening", window: nightly, t: at(4, 2, 0), want: at(4, 2, 0)},
+		{name: "before the window", window: nightly, t: at(4, 1, 0), want: at(4, 2, 0)},
+		{name: "at the closing", window: nightly, t: at(4, 4, 0), want: at(5, 2, 0)},
+		{name: "after the window", window: nightly, t: at(4, 10, 0), want: at(5, 2, 0)},
+		{name: "window opened the previous day", window: overnight, t: at(4, 0, 30), want: at(4, 0, 30)},
+		{name: "overnight window not yet open", window: overnight, t: at(4, 1, 0), want: at(4, 23, 0)},
+		{name: "restricted to other days", window: weekends, t: at(4, 3, 0), want: at(7, 2, 0)},
+		{name: "on an allowed day", window: weekends, t: at(8, 3, 0), want: at(8, 3, 0)},
+		{name: "non-UTC input", window: nightly, t: at(4, 3, 0).In(time.FixedZone("UTC+5", 5*3600)), want: at(4, 3, 0)},
+		{name: "invalid start", window: &llmwardenv1alpha1.MaintenanceWindow{Start: "25:00", Duration: "2h"}, t: at(4, 3, 0), wantErr: true},
+		{name: "invalid duration", window: &llmwardenv1alpha1.MaintenanceWindow{Start: "02:00", Duration: "2x"}, t: at(4, 3, 0), wantErr: true},
+		{name: "no valid days", window: &llmwardenv1alpha1.MaintenanceWindow{Start: "02:00", Duration: "2h", Days: []llmwardenv1alpha1.Weekday{"Someday"}}, t: at(4, 3, 0), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := nextWindowOpening(tt.window, tt.t)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("nextWindowOpening() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("nextWindowOpening() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLLMAccessReconciler_MaintenanceWindow(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+	window := &llmwardenv1alpha1.MaintenanceWindow{Start: "02:00", Duration: "2h"}
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderOpenAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef: llmwardenv1alpha1.SecretReference{Name: "openai-master", Namespace: "llmwarden-system", Key: "api-key"},
+					Rotation: &llmwardenv1alpha1.RotationConfig{
+						Enabled: true, Interval: "30d", Strategy: llmwardenv1alpha1.RotationStrategyRecreateSecret,
+						MaintenanceWindow: window,
+					},
+				},
+			},
+		},
+	}
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai-master", Namespace: "llmwarden-system"},
+		Data:       map[string][]byte{"api-key": []byte("sk-secret-value")},
+	}
+
+	// The rotation came due in the middle of the day, well outside the nightly window.
+	now := time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+	due := metav1.NewTime(now.Add(-time.Minute))
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "openai-access",
+			Namespace:  "maintenance",
+			Finalizers: []string{llmAccessFinalizer},
+		},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai"},
+			SecretName:  "openai-credentials",
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+			},
+		},
+		Status: llmwardenv1alpha1.LLMAccessStatus{NextRotation: &due},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(provider, source, access).
+		WithStatusSubresource(&llmwardenv1alpha1.LLMAccess{}).
+		Build()
+	recorder := record.NewFakeRecorder(20)
+	r := &LLMAccessReconciler{
+		Client:            fakeClient,
+		Scheme:            scheme,
+		Recorder:          recorder,
+		ApiKeyProvisioner: provisioner.NewApiKeyProvisioner(fakeClient, scheme),
+		now:               func() time.Time { return now },
+	}
+
+	key := types.NamespacedName{Name: access.Name, Namespace: access.Namespace}
+	reconcile := func() (ctrl.Result, *llmwardenv1alpha1.LLMAccess) {
+		t.Helper()
+		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		if err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+		updated := &llmwardenv1alpha1.LLMAccess{}
+		if err := fakeClient.Get(ctx, key, updated); err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		return result, updated
+	}
+
+	// Outside the window the rotation is deferred to the next opening.
+	opens := time.Date(2026, 3, 5, 2, 0, 0, 0, time.UTC)
+	result, updated := reconcile()
+	if len(updated.Status.RotationHistory) != 0 {
+		t.Errorf("RotationHistory = %+v, want no rotation outside the window", updated.Status.RotationHistory)
+	}
+	if updated.Status.NextRotation == nil || !updated.Status.NextRotation.Time.Equal(opens) {
+		t.Errorf("NextRotation = %v, want the window opening %v", updated.Status.NextRotation, opens)
+	}
+	if result.RequeueAfter != opens.Sub(now) {
+		t.Errorf("RequeueAfter = %v, want %v", result.RequeueAfter, opens.Sub(now))
+	}
+	if !drainEvents(recorder, ReasonRotationDeferred) {
+		t.Errorf("no %s event recorded", ReasonRotationDeferred)
+	}
+
+	// Reconciles before the window opens keep the deferred rotation.
+	now = now.Add(time.Hour)
+	_, updated = reconcile()
+	if updated.Status.NextRotation == nil || !updated.Status.NextRotation.Time.Equal(opens) {
+		t.Errorf("NextRotation after an intermediate reconcile = %v, want %v", updated.Status.NextRotation, opens)
+	}
+
+	// Inside the window the rotation runs, and the next one is planned inside a window.
+	now = opens.Add(30 * time.Minute)
+	_, updated = reconcile()
+	if len(updated.Status.RotationHistory) != 1 {
+		t.Fatalf("RotationHistory = %+v, want one rotation inside the window", updated.Status.RotationHistory)
+	}
+	wantNext := now.Add(30 * 24 * time.Hour)
+	if updated.Status.NextRotation == nil || !updated.Status.NextRotation.Time.Equal(wantNext) {
+		t.Errorf("NextRotation = %v, want %v", updated.Status.NextRotation, wantNext)
+	}
+	if drainEvents(recorder, ReasonRotationDeferred) {
+		t.Errorf("%s event recorded for a rotation inside the window", ReasonRotationDeferred)
+	}
+}
+
+// drainEvents empties the recorder and reports whether any event had the reason.
+func drainEvents(recorder *record.FakeRecorder, reason string) bool {
+	found := false
+	for {
+		select {
+		case event := <-recorder.Events:
+			found = found || strings.Contains(event, " "+reason+" ")
+		default:
+			return found
+		}
+	}
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/provisioner"
+)
+
+func TestEffectiveModels(t *testing.T) {
+	rules := []llmwardenv1alpha1.ModelNamespaceRule{{
+		Models:            []string{"o1*"},
+		NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"tier": "research"}},
+	}}
+	tests := []struct {
+		name      string
+		requested []string
+		allowed   []string
+		rules     []llmwardenv1alpha1.ModelNamespaceRule
+		want      []string
+	}{
+		{name: "unrestricted provider grants the request", requested: []string{"gpt-4o", "o1"}, want: []string{"gpt-4o", "o1"}},
+		{name: "request limited to the allowlist", requested: []string{"gpt-4o", "o1"}, allowed: []string{"gpt-4o", "gpt-4o-mini"}, want: []string{"gpt-4o"}},
+		{name: "empty request grants the allowlist", allowed: []string{"gpt-4o", "gpt-4o-mini"}, want: []string{"gpt-4o", "gpt-4o-mini"}},
+		{name: "empty request to an unrestricted provider", want: nil},
+		{name: "request limited by namespace rules", requested: []string{"gpt-4o", "o1-mini"}, rules: rules, want: []string{"gpt-4o"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := &llmwardenv1alpha1.LLMProvider{
+				Spec: llmwardenv1alpha1.LLMProviderSpec{AllowedModels: tt.allowed, ModelNamespaceRules: tt.rules},
+			}
+			got := effectiveModels(tt.requested, provider, labels.Set{"tier": "production"})
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("effectiveModels() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestLLMAccessReconciler_ModelsProvisioned adds a model the provider doesn't allow to a
+// provisioned access: the access is rejected, keeps its provisioned models, and reports
+// the requested model that isn't among them.
+func TestLLMAccessReconciler_ModelsProvisioned(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai", Generation: 1},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderOpenAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef: llmwardenv1alpha1.SecretReference{Name: "openai-master", Namespace: "llmwarden-system", Key: "api-key"},
+				},
+			},
+			AllowedModels: []string{"gpt-4o", "gpt-4o-mini"},
+		},
+	}
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai-master", Namespace: "llmwarden-system"},
+		Data:       map[string][]byte{"api-key": []byte("sk-secret-value")},
+	}
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "openai-access",
+			Namespace:  "team-a",
+			Generation: 1,
+			Finalizers: []string{llmAccessFinalizer},
+		},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai"},
+			SecretName:  "openai-credentials",
+			Models:      []string{"gpt-4o"},
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+			},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(provider, source, access).
+		WithStatusSubresource(&llmwardenv1alpha1.LLMAccess{}).
+		Build()
+	recorder := record.NewFakeRecorder(20)
+	r := &LLMAccessReconciler{
+		Client:            fakeClient,
+		Scheme:            scheme,
+		Recorder:          recorder,
+		ApiKeyProvisioner: provisioner.NewApiKeyProvisioner(fakeClient, scheme),
+	}
+
+	key := types.NamespacedName{Name: access.Name, Namespace: access.Namespace}
+	reconcile := func() *llmwardenv1alpha1.LLMAccess {
+		t.Helper()
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+		updated := &llmwardenv1alpha1.LLMAccess{}
+		if err := fakeClient.Get(ctx, key, updated); err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		return updated
+	}
+
+	updated := reconcile()
+	if !slices.Equal(updated.Status.ProvisionedModels, []string{"gpt-4o"}) {
+		t.Errorf("ProvisionedModels = %v, want [gpt-4o]", updated.Status.ProvisionedModels)
+	}
+	if cond := apimeta.FindStatusCondition(updated.Status.Conditions, ConditionTypeModelsProvisioned); cond == nil || cond.Status != metav1.ConditionTrue {
+		t.Errorf("%s = %+v, want True", ConditionTypeModelsProvisioned, cond)
+	}
+	if updated.Status.Phase != llmwardenv1alpha1.PhaseReady {
+		t.Errorf("Phase = %s, want %s", updated.Status.Phase, llmwardenv1alpha1.PhaseReady)
+	}
+	drainEvents(recorder, "")
+
+	updated.Spec.Models = []string{"gpt-4o", "o1"}
+	updated.Generation = 2
+	if err := fakeClient.Update(ctx, updated); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	updated = reconcile()
+	if ready := apimeta.FindStatusCondition(updated.Status.Conditions, ConditionTypeReady); ready == nil || ready.Reason != ReasonModelNotAllowed {
+		t.Errorf("Ready = %+v, want reason %s", ready, ReasonModelNotAllowed)
+	}
+	if !slices.Equal(updated.Status.ProvisionedModels, []string{"gpt-4o"}) {
+		t.Errorf("ProvisionedModels = %v, want only the permitted [gpt-4o]", updated.Status.ProvisionedModels)
+	}
+	cond := apimeta.FindStatusCondition(updated.Status.Conditions, ConditionTypeModelsProvisioned)
+	if cond == nil || cond.Status != metav1.ConditionFalse || cond.Reason != ReasonModelsNotProvisioned {
+		t.Fatalf("%s = %+v, want False/%s", ConditionTypeModelsProvisioned, cond, ReasonModelsNotProvisioned)
+	}
+	if !strings.Contains(cond.Message, "o1") || strings.Contains(cond.Message, "gpt-4o") {
+		t.Errorf("%s message = %q, want it to name only o1", ConditionTypeModelsProvisioned, cond.Message)
+	}
+	if !drainEvents(recorder, ReasonModelsNotProvisioned) {
+		t.Errorf("no %s event recorded", ReasonModelsNotProvisioned)
+	}
+
+	// The warning is recorded when models stop being provisioned, not on every reconcile.
+	reconcile()
+	if drainEvents(recorder, ReasonModelsNotProvisioned) {
+		t.Errorf("%s event recorded again for unchanged models", ReasonModelsNotProvisioned)
+	}
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/provisioner"
+)
+
+func TestLLMAccessReconciler_NamespacedProvider(t *testing.T) {
+	namespacedProvider := func(name, secretNamespace string) *llmwardenv1alpha1.NamespacedLLMProvider {
+		return &llmwardenv1alpha1.NamespacedLLMProvider{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "team-a"},
+			Spec: llmwardenv1alpha1.LLMProviderSpec{
+				Provider: llmwardenv1alpha1.ProviderOpenAI,
+				Auth: llmwardenv1alpha1.AuthConfig{
+					Type: llmwardenv1alpha1.AuthTypeAPIKey,
+					APIKey: &llmwardenv1alpha1.APIKeyAuth{
+						SecretRef: llmwardenv1alpha1.SecretReference{Name: "openai-master", Namespace: secretNamespace, Key: "api-key"},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		namespace     string
+		providerName  string
+		wantReady     metav1.ConditionStatus
+		wantReason    string
+		wantProvision bool
+	}{
+		{
+			name:          "provider in the access namespace",
+			namespace:     "team-a",
+			providerName:  "openai",
+			wantReady:     metav1.ConditionTrue,
+			wantReason:    ReasonCredentialProvisioned,
+			wantProvision: true,
+		},
+		{
+			name:         "provider of another namespace is not visible",
+			namespace:    "team-b",
+			providerName: "openai",
+			wantReady:    metav1.ConditionFalse,
+			wantReason:   ReasonProviderNotFound,
+		},
+		{
+			name:         "provider reading another namespace's secret",
+			namespace:    "team-a",
+			providerName: "borrowed",
+			wantReady:    metav1.ConditionFalse,
+			wantReason:   ReasonProviderOutOfNamespace,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			scheme := runtime.NewScheme()
+			_ = clientgoscheme.AddToScheme(scheme)
+			_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+			access := &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "openai-access",
+					Namespace:  tt.namespace,
+					Finalizers: []string{llmAccessFinalizer},
+				},
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					ProviderRef: llmwardenv1alpha1.ProviderReference{
+						Name: tt.providerName,
+						Kind: llmwardenv1alpha1.ProviderKindNamespacedLLMProvider,
+					},
+					SecretName: "openai-credentials",
+					Injection: llmwardenv1alpha1.InjectionConfig{
+						Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+					},
+				},
+			}
+
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(
+					namespacedProvider("openai", "team-a"),
+					namespacedProvider("borrowed", "team-b"),
+					access,
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Name: "openai-master", Namespace: "team-a"},
+						Data:       map[string][]byte{"api-key": []byte("sk-team-a")},
+					},
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Name: "openai-master", Namespace: "team-b"},
+						Data:       map[string][]byte{"api-key": []byte("sk-team-b")},
+					},
+				).
+				WithStatusSubresource(&llmwardenv1alpha1.LLMAccess{}).
+				Build()
+
+			r := &LLMAccessReconciler{
+				Client:            fakeClient,
+				Scheme:            scheme,
+				Recorder:          record.NewFakeRecorder(10),
+				ApiKeyProvisioner: provisioner.NewApiKeyProvisioner(fakeClient, scheme),
+			}
+
+			key := types.NamespacedName{Name: access.Name, Namespace: access.Namespace}
+			if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+
+			updated := &llmwardenv1alpha1.LLMAccess{}
+			if err := fakeClient.Get(ctx, key, updated); err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			ready := apimeta.FindStatusCondition(updated.Status.Conditions, ConditionTypeReady)
+			if ready == nil || ready.Status != tt.wantReady || ready.Reason != tt.wantReason {
+				t.Fatalf("Ready = %+v, want %s/%s", ready, tt.wantReady, tt.wantReason)
+			}
+
+			target := &corev1.Secret{}
+			err := fakeClient.Get(ctx, types.NamespacedName{Name: "openai-credentials", Namespace: tt.namespace}, target)
+			if !tt.wantProvision {
+				if !apierrors.IsNotFound(err) {
+					t.Fatalf("target secret Get() error = %v, want NotFound", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("target secret Get() error = %v", err)
+			}
+			if got := string(target.Data["apiKey"]); got != "sk-team-a" {
+				t.Errorf("apiKey = %q, want the team-a key", got)
+			}
+		})
+	}
+}
+
+func TestNamespacedLLMProviderReconciler_OutOfNamespace(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+	provider := &llmwardenv1alpha1.NamespacedLLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "borrowed", Namespace: "team-a"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderOpenAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef: llmwardenv1alpha1.SecretReference{Name: "openai-master", Namespace: "team-b", Key: "api-key"},
+				},
+			},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(provider).
+		WithStatusSubresource(&llmwardenv1alpha1.NamespacedLLMProvider{}).
+		Build()
+
+	r := &NamespacedLLMProviderReconciler{
+		Client:   fakeClient,
+		Scheme:   scheme,
+		Recorder: record.NewFakeRecorder(10),
+	}
+	key := types.NamespacedName{Name: provider.Name, Namespace: provider.Namespace}
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	updated := &llmwardenv1alpha1.NamespacedLLMProvider{}
+	if err := fakeClient.Get(ctx, key, updated); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	ready := apimeta.FindStatusCondition(updated.Status.Conditions, ConditionTypeReady)
+	if ready == nil || ready.Status != metav1.ConditionFalse || ready.Reason != ReasonProviderOutOfNamespace {
+		t.Fatalf("Ready = %+v, want False/%s", ready, ReasonProviderOutOfNamespace)
+	}
+}
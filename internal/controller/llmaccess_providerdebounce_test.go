@@ -0,0 +1,113 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+)
+
+// countingQueue counts the requests added to it, immediately or after a delay.
+type countingQueue struct {
+	workqueue.TypedRateLimitingInterface[reconcile.Request]
+	added map[reconcile.Request]int
+}
+
+func (q *countingQueue) Add(req reconcile.Request) { q.added[req]++ }
+
+func (q *countingQueue) AddAfter(req reconcile.Request, _ time.Duration) { q.added[req]++ }
+
+func TestLLMAccessReconciler_ProviderChangeDebounce(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai-prod", ResourceVersion: "1"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderOpenAI,
+			Auth:     llmwardenv1alpha1.AuthConfig{Type: llmwardenv1alpha1.AuthTypeAPIKey},
+		},
+	}
+	objects := []client.Object{provider}
+	for i := range 3 {
+		objects = append(objects, &llmwardenv1alpha1.LLMAccess{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("access-%d", i), Namespace: "team-a"},
+			Spec: llmwardenv1alpha1.LLMAccessSpec{
+				ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai-prod"},
+				SecretName:  fmt.Sprintf("creds-%d", i),
+			},
+		})
+	}
+
+	// 20 updates 100ms apart, as a GitOps sync re-applying the provider would send.
+	const updates = 20
+	tests := []struct {
+		name     string
+		debounce time.Duration
+		want     int
+	}{
+		// Updates at 0s..0.9s fold into the enqueue due at 1s, those at 1s..1.9s into a second.
+		{name: "debounced updates coalesce per window", debounce: time.Second, want: 2},
+		{name: "without debounce every update enqueues", want: updates},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(objects...).
+				WithIndex(&llmwardenv1alpha1.LLMAccess{}, providerRefNameField, indexProviderRef).
+				Build()
+			now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+			r := &LLMAccessReconciler{
+				Client:                 fakeClient,
+				ProviderChangeDebounce: tt.debounce,
+				now:                    func() time.Time { return now },
+			}
+
+			h := r.providerChangeHandler()
+			q := &countingQueue{added: make(map[reconcile.Request]int)}
+			for range updates {
+				h.Update(context.Background(), event.UpdateEvent{ObjectOld: provider, ObjectNew: provider}, q)
+				now = now.Add(100 * time.Millisecond)
+			}
+
+			if len(q.added) != 3 {
+				t.Fatalf("enqueued %v, want the 3 dependent accesses", q.added)
+			}
+			for req, count := range q.added {
+				if count != tt.want {
+					t.Errorf("%s enqueued %d times for %d updates, want %d", req, count, updates, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/provisioner"
+)
+
+// authTypeVaultAgent is an auth type only the registered fakeProvisioner handles.
+const authTypeVaultAgent llmwardenv1alpha1.AuthType = "vaultAgent"
+
+// fakeProvisioner records the accesses it provisioned and reports them healthy.
+type fakeProvisioner struct {
+	provisioned []string
+}
+
+func (f *fakeProvisioner) Provision(_ context.Context, _ *llmwardenv1alpha1.LLMProvider, access *llmwardenv1alpha1.LLMAccess) (*provisioner.ProvisionResult, error) {
+	f.provisioned = append(f.provisioned, access.Name)
+	return &provisioner.ProvisionResult{SecretName: access.Spec.SecretName, SecretNamespace: access.Namespace}, nil
+}
+
+func (f *fakeProvisioner) Cleanup(context.Context, *llmwardenv1alpha1.LLMProvider, *llmwardenv1alpha1.LLMAccess) error {
+	return nil
+}
+
+func (f *fakeProvisioner) HealthCheck(context.Context, *llmwardenv1alpha1.LLMProvider, *llmwardenv1alpha1.LLMAccess) (*provisioner.HealthCheckResult, error) {
+	return &provisioner.HealthCheckResult{Healthy: true}, nil
+}
+
+func TestLLMAccessReconciler_RegisteredProvisioner(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+	// The registry is process-wide and rejects duplicates, so register once per test binary.
+	fakeProv := &fakeProvisioner{}
+	if _, ok := provisioner.Lookup(authTypeVaultAgent); !ok {
+		provisioner.Register(authTypeVaultAgent, func(client.Client, *runtime.Scheme) provisioner.Provisioner {
+			return fakeProv
+		})
+	}
+	factory, _ := provisioner.Lookup(authTypeVaultAgent)
+	fakeProv = factory(nil, nil).(*fakeProvisioner)
+	fakeProv.provisioned = nil
+
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "vault-llm"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderCustom,
+			Auth:     llmwardenv1alpha1.AuthConfig{Type: authTypeVaultAgent},
+		},
+	}
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "vault-access",
+			Namespace:  "team-a",
+			Finalizers: []string{llmAccessFinalizer},
+		},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: provider.Name},
+			SecretName:  "vault-credentials",
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "LLM_API_KEY", SecretKey: "apiKey"}},
+			},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(provider, access).
+		WithStatusSubresource(&llmwardenv1alpha1.LLMAccess{}).
+		Build()
+	// No built-in provisioners are configured; only the registry can resolve the auth type.
+	r := &LLMAccessReconciler{
+		Client:   fakeClient,
+		Scheme:   scheme,
+		Recorder: record.NewFakeRecorder(10),
+	}
+
+	if prov, err := r.selectProvisioner(authTypeVaultAgent); err != nil || prov != fakeProv {
+		t.Fatalf("selectProvisioner(%q) = %v, %v; want the registered provisioner", authTypeVaultAgent, prov, err)
+	}
+
+	key := types.NamespacedName{Name: access.Name, Namespace: access.Namespace}
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if len(fakeProv.provisioned) != 1 || fakeProv.provisioned[0] != access.Name {
+		t.Errorf("registered provisioner provisioned %v, want [%s]", fakeProv.provisioned, access.Name)
+	}
+
+	updated := &llmwardenv1alpha1.LLMAccess{}
+	if err := fakeClient.Get(ctx, key, updated); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	ready := apimeta.FindStatusCondition(updated.Status.Conditions, ConditionTypeReady)
+	if ready == nil || ready.Status != metav1.ConditionTrue || ready.Reason != ReasonCredentialProvisioned {
+		t.Fatalf("Ready = %+v, want True/%s", ready, ReasonCredentialProvisioned)
+	}
+	if updated.Status.ProvisionedAuthType != authTypeVaultAgent {
+		t.Errorf("ProvisionedAuthType = %q, want %q", updated.Status.ProvisionedAuthType, authTypeVaultAgent)
+	}
+}
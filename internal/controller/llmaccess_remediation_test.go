@@ -0,0 +1,136 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/provisioner"
+)
+
+func TestLLMAccessReconciler_RemediationHints(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   *corev1.Secret
+		wantHint string
+	}{
+		{
+			name:     "missing secret",
+			wantHint: "Create secret provider-ns/openai-key with key 'api-key', or update spec.auth.apiKey.secretRef",
+		},
+		{
+			name: "missing key",
+			source: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "openai-key", Namespace: "provider-ns"},
+				Data:       map[string][]byte{"apikey": []byte("sk-secret-value")},
+			},
+			wantHint: "Add key 'api-key' to secret provider-ns/openai-key, or update spec.auth.apiKey.secretRef.key",
+		},
+		{
+			name: "empty value",
+			source: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "openai-key", Namespace: "provider-ns"},
+				Data:       map[string][]byte{"api-key": {}},
+			},
+			wantHint: "Set a value for key 'api-key' in secret provider-ns/openai-key, or update spec.auth.apiKey.secretRef.key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			scheme := runtime.NewScheme()
+			_ = clientgoscheme.AddToScheme(scheme)
+			_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+			provider := &llmwardenv1alpha1.LLMProvider{
+				ObjectMeta: metav1.ObjectMeta{Name: "openai"},
+				Spec: llmwardenv1alpha1.LLMProviderSpec{
+					Provider: llmwardenv1alpha1.ProviderOpenAI,
+					Auth: llmwardenv1alpha1.AuthConfig{
+						Type: llmwardenv1alpha1.AuthTypeAPIKey,
+						APIKey: &llmwardenv1alpha1.APIKeyAuth{
+							SecretRef: llmwardenv1alpha1.SecretReference{Name: "openai-key", Namespace: "provider-ns", Key: "api-key"},
+						},
+					},
+				},
+			}
+			access := &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "openai-access",
+					Namespace:  "team-a",
+					Finalizers: []string{llmAccessFinalizer},
+				},
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai"},
+					SecretName:  "openai-credentials",
+					Injection: llmwardenv1alpha1.InjectionConfig{
+						Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+					},
+				},
+			}
+			objects := []client.Object{provider, access}
+			if tt.source != nil {
+				objects = append(objects, tt.source)
+			}
+
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(objects...).
+				WithStatusSubresource(&llmwardenv1alpha1.LLMAccess{}).
+				Build()
+			r := &LLMAccessReconciler{
+				Client:            fakeClient,
+				Scheme:            scheme,
+				Recorder:          record.NewFakeRecorder(10),
+				ApiKeyProvisioner: provisioner.NewApiKeyProvisioner(fakeClient, scheme),
+			}
+
+			key := types.NamespacedName{Name: access.Name, Namespace: access.Namespace}
+			if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err == nil {
+				t.Fatal("Reconcile() error = nil, want the provisioning failure")
+			}
+
+			updated := &llmwardenv1alpha1.LLMAccess{}
+			if err := fakeClient.Get(ctx, key, updated); err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			for _, condType := range []string{ConditionTypeReady, ConditionTypeCredentialProvisioned} {
+				cond := apimeta.FindStatusCondition(updated.Status.Conditions, condType)
+				if cond == nil || cond.Status != metav1.ConditionFalse {
+					t.Errorf("%s = %+v, want False", condType, cond)
+				} else if !strings.Contains(cond.Message, tt.wantHint) {
+					t.Errorf("%s message = %q, want it to contain %q", condType, cond.Message, tt.wantHint)
+				}
+			}
+		})
+	}
+}
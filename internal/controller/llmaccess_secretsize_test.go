@@ -0,0 +1,121 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/provisioner"
+)
+
+// TestLLMAccessReconciler_SecretTooLarge runs against the fake client, which unlike the
+// apiserver doesn't enforce the secret size limit, so only llmwarden's own check applies.
+func TestLLMAccessReconciler_SecretTooLarge(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "vertex"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderGCPVertexAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef: llmwardenv1alpha1.SecretReference{Name: "vertex-master", Namespace: "vault-sync", Key: "sa.json"},
+				},
+			},
+		},
+	}
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "vertex-master", Namespace: "vault-sync"},
+		Data:       map[string][]byte{"sa.json": []byte(strings.Repeat("x", corev1.MaxSecretSize+1))},
+	}
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "vertex-access",
+			Namespace:  "team-a",
+			Finalizers: []string{llmAccessFinalizer},
+		},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "vertex"},
+			SecretName:  "vertex-credentials",
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "GOOGLE_CREDENTIALS", SecretKey: "apiKey"}},
+			},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(provider, source, access).
+		WithStatusSubresource(&llmwardenv1alpha1.LLMAccess{}).
+		Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &LLMAccessReconciler{
+		Client:            fakeClient,
+		Scheme:            scheme,
+		Recorder:          recorder,
+		ApiKeyProvisioner: provisioner.NewApiKeyProvisioner(fakeClient, scheme),
+	}
+
+	key := types.NamespacedName{Name: access.Name, Namespace: access.Namespace}
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v, want the failure reported in status only", err)
+	}
+	if result.RequeueAfter != secretTooLargeRequeueInterval {
+		t.Errorf("RequeueAfter = %v, want %v", result.RequeueAfter, secretTooLargeRequeueInterval)
+	}
+
+	updated := &llmwardenv1alpha1.LLMAccess{}
+	if err := fakeClient.Get(ctx, key, updated); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	for _, condType := range []string{ConditionTypeReady, ConditionTypeCredentialProvisioned} {
+		cond := apimeta.FindStatusCondition(updated.Status.Conditions, condType)
+		if cond == nil || cond.Status != metav1.ConditionFalse || cond.Reason != ReasonSecretTooLarge {
+			t.Errorf("%s = %+v, want False/%s", condType, cond, ReasonSecretTooLarge)
+		} else if !strings.Contains(cond.Message, "byte limit") {
+			t.Errorf("%s message = %q, want it to explain the size limit", condType, cond.Message)
+		}
+	}
+
+	var sawEvent bool
+	for len(recorder.Events) > 0 {
+		if strings.Contains(<-recorder.Events, ReasonSecretTooLarge) {
+			sawEvent = true
+		}
+	}
+	if !sawEvent {
+		t.Errorf("no %s event recorded", ReasonSecretTooLarge)
+	}
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/provisioner"
+)
+
+// TestLLMAccessReconciler_StatusWrites counts status writes per reconcile: every branch
+// writes the status at most once, and a permanent failure that is reconciled again
+// without changes doesn't write it at all.
+func TestLLMAccessReconciler_StatusWrites(t *testing.T) {
+	provider := func(mutate func(*llmwardenv1alpha1.LLMProvider)) *llmwardenv1alpha1.LLMProvider {
+		p := &llmwardenv1alpha1.LLMProvider{
+			ObjectMeta: metav1.ObjectMeta{Name: "openai", Generation: 1},
+			Spec: llmwardenv1alpha1.LLMProviderSpec{
+				Provider: llmwardenv1alpha1.ProviderOpenAI,
+				Auth: llmwardenv1alpha1.AuthConfig{
+					Type: llmwardenv1alpha1.AuthTypeAPIKey,
+					APIKey: &llmwardenv1alpha1.APIKeyAuth{
+						SecretRef: llmwardenv1alpha1.SecretReference{Name: "openai-master", Namespace: "vault-sync", Key: "api-key"},
+					},
+				},
+			},
+		}
+		if mutate != nil {
+			mutate(p)
+		}
+		return p
+	}
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai-master", Namespace: "vault-sync"},
+		Data:       map[string][]byte{"api-key": []byte("sk-test")},
+	}
+
+	tests := []struct {
+		name       string
+		objects    []client.Object
+		wantReason string
+		wantErr    bool
+		// steady is set for branches whose status is unchanged when reconciled again.
+		steady bool
+	}{
+		{
+			name:       "provisioned",
+			objects:    []client.Object{provider(nil), source},
+			wantReason: ReasonCredentialProvisioned,
+		},
+		{
+			name:       "provider not found",
+			wantReason: ReasonProviderNotFound,
+			steady:     true,
+		},
+		{
+			name: "namespace not allowed",
+			objects: []client.Object{provider(func(p *llmwardenv1alpha1.LLMProvider) {
+				p.Spec.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"ai-tier": "production"}}
+			}), source},
+			wantReason: ReasonNamespaceNotAllowed,
+			steady:     true,
+		},
+		{
+			name: "model not allowed",
+			objects: []client.Object{provider(func(p *llmwardenv1alpha1.LLMProvider) {
+				p.Spec.AllowedModels = []string{"gpt-4o"}
+			}), source},
+			wantReason: ReasonModelNotAllowed,
+			steady:     true,
+		},
+		{
+			name:       "provisioning fails",
+			objects:    []client.Object{provider(nil)},
+			wantReason: ReasonReconciliationError,
+			wantErr:    true,
+			steady:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			scheme := runtime.NewScheme()
+			_ = clientgoscheme.AddToScheme(scheme)
+			_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+			access := &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "openai-access",
+					Namespace:  "team-a",
+					Generation: 1,
+					Finalizers: []string{llmAccessFinalizer},
+				},
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai"},
+					SecretName:  "openai-credentials",
+					Models:      []string{"o1"},
+					Injection: llmwardenv1alpha1.InjectionConfig{
+						Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+					},
+				},
+			}
+			namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}}
+
+			statusWrites := 0
+			count := func(obj client.Object) {
+				if _, ok := obj.(*llmwardenv1alpha1.LLMAccess); ok {
+					statusWrites++
+				}
+			}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(append(tt.objects, access, namespace)...).
+				WithStatusSubresource(&llmwardenv1alpha1.LLMAccess{}).
+				WithInterceptorFuncs(interceptor.Funcs{
+					SubResourceUpdate: func(ctx context.Context, c client.Client, subResource string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+						count(obj)
+						return c.SubResource(subResource).Update(ctx, obj, opts...)
+					},
+					SubResourcePatch: func(ctx context.Context, c client.Client, subResource string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+						count(obj)
+						return c.SubResource(subResource).Patch(ctx, obj, patch, opts...)
+					},
+				}).
+				Build()
+			r := &LLMAccessReconciler{
+				Client:            fakeClient,
+				Scheme:            scheme,
+				Recorder:          record.NewFakeRecorder(20),
+				ApiKeyProvisioner: provisioner.NewApiKeyProvisioner(fakeClient, scheme),
+			}
+
+			key := types.NamespacedName{Name: access.Name, Namespace: access.Namespace}
+			for round := 1; round <= 2; round++ {
+				statusWrites = 0
+				_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("round %d: Reconcile() error = %v, want error %v", round, err, tt.wantErr)
+				}
+
+				want := 1
+				if round == 2 && tt.steady {
+					want = 0
+				}
+				if statusWrites > 1 || (tt.steady && statusWrites != want) {
+					t.Errorf("round %d: %d status writes, want %d", round, statusWrites, want)
+				}
+
+				updated := &llmwardenv1alpha1.LLMAccess{}
+				if err := fakeClient.Get(ctx, key, updated); err != nil {
+					t.Fatalf("Get() error = %v", err)
+				}
+				ready := apimeta.FindStatusCondition(updated.Status.Conditions, ConditionTypeReady)
+				if ready == nil || ready.Reason != tt.wantReason {
+					t.Fatalf("round %d: Ready = %+v, want reason %s", round, ready, tt.wantReason)
+				}
+				if updated.Status.Phase != computePhase(updated.Status.Conditions) {
+					t.Errorf("round %d: phase = %s, want it derived from the stored conditions", round, updated.Status.Phase)
+				}
+			}
+		})
+	}
+}
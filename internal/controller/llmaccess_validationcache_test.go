@@ -0,0 +1,239 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/provisioner"
+)
+
+// TestLLMAccessReconciler_ValidationCache requests a model the provider doesn't allow, so
+// the Ready reason shows whether the model check ran.
+func TestLLMAccessReconciler_ValidationCache(t *testing.T) {
+	tests := []struct {
+		name               string
+		validated          *llmwardenv1alpha1.ValidatedGenerations
+		providerGeneration int64
+		wantReady          metav1.ConditionStatus
+		wantReason         string
+	}{
+		{
+			name:               "skipped when access and provider are unchanged",
+			validated:          &llmwardenv1alpha1.ValidatedGenerations{Access: 1, Provider: 1, ProviderUID: "provider-uid"},
+			providerGeneration: 1,
+			wantReady:          metav1.ConditionTrue,
+			wantReason:         ReasonCredentialProvisioned,
+		},
+		{
+			name:               "re-run when the provider changed",
+			validated:          &llmwardenv1alpha1.ValidatedGenerations{Access: 1, Provider: 1, ProviderUID: "provider-uid"},
+			providerGeneration: 2,
+			wantReady:          metav1.ConditionFalse,
+			wantReason:         ReasonModelNotAllowed,
+		},
+		{
+			name:               "re-run when the provider was recreated",
+			validated:          &llmwardenv1alpha1.ValidatedGenerations{Access: 1, Provider: 1, ProviderUID: "old-provider-uid"},
+			providerGeneration: 1,
+			wantReady:          metav1.ConditionFalse,
+			wantReason:         ReasonModelNotAllowed,
+		},
+		{
+			name:               "run when never validated",
+			providerGeneration: 1,
+			wantReady:          metav1.ConditionFalse,
+			wantReason:         ReasonModelNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			scheme := runtime.NewScheme()
+			_ = clientgoscheme.AddToScheme(scheme)
+			_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+			provider := &llmwardenv1alpha1.LLMProvider{
+				ObjectMeta: metav1.ObjectMeta{Name: "openai", UID: "provider-uid", Generation: tt.providerGeneration},
+				Spec: llmwardenv1alpha1.LLMProviderSpec{
+					Provider:      llmwardenv1alpha1.ProviderOpenAI,
+					AllowedModels: []string{"gpt-4o-mini"},
+					Auth: llmwardenv1alpha1.AuthConfig{
+						Type: llmwardenv1alpha1.AuthTypeAPIKey,
+						APIKey: &llmwardenv1alpha1.APIKeyAuth{
+							SecretRef: llmwardenv1alpha1.SecretReference{Name: "openai-master", Namespace: "vault-sync", Key: "api-key"},
+						},
+					},
+				},
+			}
+			access := &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "openai-access",
+					Namespace:  "team-a",
+					Generation: 1,
+					Finalizers: []string{llmAccessFinalizer},
+				},
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai"},
+					Models:      []string{"gpt-4o"},
+					SecretName:  "openai-credentials",
+					Injection: llmwardenv1alpha1.InjectionConfig{
+						Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+					},
+				},
+				Status: llmwardenv1alpha1.LLMAccessStatus{ValidatedGenerations: tt.validated},
+			}
+			sourceSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "openai-master", Namespace: "vault-sync"},
+				Data:       map[string][]byte{"api-key": []byte("sk-test")},
+			}
+
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(provider, access, sourceSecret).
+				WithStatusSubresource(&llmwardenv1alpha1.LLMAccess{}).
+				Build()
+
+			r := &LLMAccessReconciler{
+				Client:            fakeClient,
+				Scheme:            scheme,
+				Recorder:          record.NewFakeRecorder(10),
+				ApiKeyProvisioner: provisioner.NewApiKeyProvisioner(fakeClient, scheme),
+			}
+
+			key := types.NamespacedName{Name: access.Name, Namespace: access.Namespace}
+			if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+
+			updated := &llmwardenv1alpha1.LLMAccess{}
+			if err := fakeClient.Get(ctx, key, updated); err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			ready := apimeta.FindStatusCondition(updated.Status.Conditions, ConditionTypeReady)
+			if ready == nil || ready.Status != tt.wantReady || ready.Reason != tt.wantReason {
+				t.Fatalf("Ready = %+v, want %s/%s", ready, tt.wantReady, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestLLMAccessReconciler_ValidationCacheRecorded(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai", UID: "provider-uid", Generation: 3},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider:      llmwardenv1alpha1.ProviderOpenAI,
+			AllowedModels: []string{"gpt-4o"},
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef: llmwardenv1alpha1.SecretReference{Name: "openai-master", Namespace: "vault-sync", Key: "api-key"},
+				},
+			},
+		},
+	}
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "openai-access",
+			Namespace:  "team-a",
+			Generation: 2,
+			Finalizers: []string{llmAccessFinalizer},
+		},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai"},
+			Models:      []string{"gpt-4o"},
+			SecretName:  "openai-credentials",
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+			},
+		},
+	}
+	sourceSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai-master", Namespace: "vault-sync"},
+		Data:       map[string][]byte{"api-key": []byte("sk-test")},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(provider, access, sourceSecret).
+		WithStatusSubresource(&llmwardenv1alpha1.LLMAccess{}).
+		Build()
+
+	r := &LLMAccessReconciler{
+		Client:            fakeClient,
+		Scheme:            scheme,
+		Recorder:          record.NewFakeRecorder(10),
+		ApiKeyProvisioner: provisioner.NewApiKeyProvisioner(fakeClient, scheme),
+	}
+
+	key := types.NamespacedName{Name: access.Name, Namespace: access.Namespace}
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	updated := &llmwardenv1alpha1.LLMAccess{}
+	if err := fakeClient.Get(ctx, key, updated); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	want := llmwardenv1alpha1.ValidatedGenerations{Access: 2, Provider: 3, ProviderUID: "provider-uid"}
+	if got := updated.Status.ValidatedGenerations; got == nil || *got != want {
+		t.Errorf("ValidatedGenerations = %+v, want %+v", got, want)
+	}
+}
+
+func TestValidationCached(t *testing.T) {
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{Generation: 1},
+		Status: llmwardenv1alpha1.LLMAccessStatus{
+			ValidatedGenerations: &llmwardenv1alpha1.ValidatedGenerations{Access: 1, Provider: 1, ProviderUID: "uid"},
+		},
+	}
+	provider := &llmwardenv1alpha1.LLMProvider{ObjectMeta: metav1.ObjectMeta{Generation: 1, UID: "uid"}}
+	if !validationCached(access, provider) {
+		t.Errorf("validationCached() = false for unchanged generations, want true")
+	}
+
+	// Namespace labels carry no generation, so selector-dependent checks always re-run.
+	provider.Spec.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}
+	if validationCached(access, provider) {
+		t.Errorf("validationCached() = true with a namespaceSelector, want false")
+	}
+	provider.Spec.NamespaceSelector = nil
+
+	access.Generation = 2
+	if validationCached(access, provider) {
+		t.Errorf("validationCached() = true after the access changed, want false")
+	}
+}
@@ -19,20 +19,26 @@ package controller
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
 	"github.com/llmwarden/llmwarden/internal/metrics"
+	"github.com/llmwarden/llmwarden/internal/provisioner"
+	webhookv1alpha1 "github.com/llmwarden/llmwarden/internal/webhook/v1alpha1"
 )
 
 // LLMProviderReconciler reconciles a LLMProvider object
@@ -46,12 +52,49 @@ type LLMProviderReconciler struct {
 // +kubebuilder:rbac:groups=llmwarden.io,resources=llmproviders/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=llmwarden.io,resources=llmproviders/finalizers,verbs=update
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
 
 const (
 	providerRequeueInterval = 5 * time.Minute
 	reasonInvalidConfig     = "InvalidConfig"
+
+	// ReasonRequiredKeyMissing is set when the provider source secret lacks a field the
+	// provider type needs, such as the Azure OpenAI deployment.
+	ReasonRequiredKeyMissing = "RequiredKeyMissing"
+
+	// ConditionTypeAllowedModelsResolved reports whether spec.allowedModelsRef and
+	// spec.upstreamProviderRefs were loaded into status.allowedModels. It is only present
+	// when one of them is set.
+	ConditionTypeAllowedModelsResolved = "AllowedModelsResolved"
+	reasonAllowedModelsRefError        = "AllowedModelsRefError"
+	// ReasonUpstreamProviderNotFound is set on AllowedModelsResolved when an LLMProvider
+	// named in spec.upstreamProviderRefs does not exist.
+	ReasonUpstreamProviderNotFound = "UpstreamProviderNotFound"
+
+	// ConditionTypeNamespacedSecretStore is an advisory condition, True while the provider
+	// references a namespaced SecretStore that every access namespace must provide. It is
+	// only present in that case.
+	ConditionTypeNamespacedSecretStore = "NamespacedSecretStore"
+
+	// ConditionTypeDeprecated is an advisory condition, True while the provider sets
+	// spec.deprecationMessage or spec.sunsetDate. It is only present in that case.
+	ConditionTypeDeprecated = "Deprecated"
+	// ReasonSunsetPassed is set on Deprecated once spec.sunsetDate is in the past.
+	ReasonSunsetPassed = "SunsetPassed"
 )
 
+// allowedModelsRefField is the field index key for LLMProvider.spec.allowedModelsRef,
+// indexed as "<namespace>/<name>" of the referenced ConfigMap.
+const allowedModelsRefField = ".spec.allowedModelsRef"
+
+// sourceSecretField is the field index key for LLMProvider.spec.auth.apiKey.secretRef,
+// indexed as "<namespace>/<name>" of the source secret.
+const sourceSecretField = ".spec.auth.apiKey.secretRef"
+
+// upstreamProviderRefsField is the field index key for LLMProvider.spec.upstreamProviderRefs,
+// indexed by each upstream provider name.
+const upstreamProviderRefsField = ".spec.upstreamProviderRefs"
+
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
 func (r *LLMProviderReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -71,13 +114,7 @@ func (r *LLMProviderReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, err
 	}
 
-	// Validate provider config and set Ready condition
-	condStatus, reason, message := r.validateProviderConfig(ctx, provider)
-	setCondition(&provider.Status.Conditions, provider.Generation, "Ready", condStatus, reason, message)
-
-	// Update LastCredentialCheck timestamp
-	now := metav1.Now()
-	provider.Status.LastCredentialCheck = &now
+	condStatus, message := r.refreshStatus(ctx, provider)
 
 	// Count LLMAccess resources referencing this provider
 	llmAccessList := &llmwardenv1alpha1.LLMAccessList{}
@@ -86,7 +123,8 @@ func (r *LLMProviderReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	} else {
 		accessCount := int32(0)
 		for _, access := range llmAccessList.Items {
-			if access.Spec.ProviderRef.Name == provider.Name {
+			if access.Spec.ProviderRef.Name == provider.Name &&
+				access.Spec.ProviderRef.Kind != llmwardenv1alpha1.ProviderKindNamespacedLLMProvider {
 				accessCount++
 			}
 		}
@@ -119,12 +157,90 @@ func (r *LLMProviderReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	return ctrl.Result{RequeueAfter: providerRequeueInterval}, nil
 }
 
+// refreshStatus recomputes the provider's conditions, resolved allowlist and phase in
+// place, and returns the Ready condition's status and message. It is shared by the
+// LLMProvider and NamespacedLLMProvider reconcilers.
+func (r *LLMProviderReconciler) refreshStatus(ctx context.Context, provider *llmwardenv1alpha1.LLMProvider) (metav1.ConditionStatus, string) {
+	// Never read another namespace's secrets or ConfigMaps on behalf of a namespaced
+	// provider, not even to report whether they exist.
+	if err := provisioner.ValidateProviderNamespace(provider); err != nil {
+		setCondition(&provider.Status.Conditions, &provider.Status.Ready, provider.Generation, ConditionTypeReady,
+			metav1.ConditionFalse, ReasonProviderOutOfNamespace, err.Error())
+		provider.Status.AllowedModels = nil
+		apimeta.RemoveStatusCondition(&provider.Status.Conditions, ConditionTypeAllowedModelsResolved)
+		provider.Status.Phase = computePhase(provider.Status.Conditions)
+		return metav1.ConditionFalse, err.Error()
+	}
+
+	// Validate provider config and set Ready condition
+	condStatus, reason, message := r.validateProviderConfig(ctx, provider)
+	setCondition(&provider.Status.Conditions, &provider.Status.Ready, provider.Generation, ConditionTypeReady, condStatus, reason, message)
+
+	// Resolve the ConfigMap-backed and upstream model allowlists. LLMAccess validation
+	// reads the result from status, so a failure here is reported but does not fail the
+	// reconcile.
+	if provider.Spec.AllowedModelsRef != nil || len(provider.Spec.UpstreamProviderRefs) > 0 {
+		models, reason, err := r.resolveAllowedModels(ctx, provider)
+		if err != nil {
+			provider.Status.AllowedModels = nil
+			setCondition(&provider.Status.Conditions, &provider.Status.Ready, provider.Generation, ConditionTypeAllowedModelsResolved,
+				metav1.ConditionFalse, reason, err.Error())
+		} else {
+			provider.Status.AllowedModels = models
+			setCondition(&provider.Status.Conditions, &provider.Status.Ready, provider.Generation, ConditionTypeAllowedModelsResolved,
+				metav1.ConditionTrue, "AllowedModelsResolved",
+				fmt.Sprintf("Resolved %d allowed models using %s", len(models), allowedModelsSources(provider)))
+		}
+	} else {
+		provider.Status.AllowedModels = nil
+		apimeta.RemoveStatusCondition(&provider.Status.Conditions, ConditionTypeAllowedModelsResolved)
+	}
+
+	// Accesses of a namespaced provider all live in its namespace, so the advisory
+	// doesn't apply to it.
+	if warnings := webhookv1alpha1.NamespacedStoreWarnings(provider); len(warnings) > 0 && provider.Namespace == "" {
+		setCondition(&provider.Status.Conditions, &provider.Status.Ready, provider.Generation, ConditionTypeNamespacedSecretStore,
+			metav1.ConditionTrue, ConditionTypeNamespacedSecretStore, strings.Join(warnings, "; "))
+	} else {
+		apimeta.RemoveStatusCondition(&provider.Status.Conditions, ConditionTypeNamespacedSecretStore)
+	}
+
+	// Update LastCredentialCheck timestamp
+	now := metav1.Now()
+	if warning := provisioner.DeprecationWarning(provider, now.Time); warning != "" {
+		reason := ConditionTypeDeprecated
+		if sunset := provider.Spec.SunsetDate; sunset != nil && !now.Before(sunset) {
+			reason = ReasonSunsetPassed
+		}
+		setCondition(&provider.Status.Conditions, &provider.Status.Ready, provider.Generation, ConditionTypeDeprecated,
+			metav1.ConditionTrue, reason, warning)
+	} else {
+		apimeta.RemoveStatusCondition(&provider.Status.Conditions, ConditionTypeDeprecated)
+	}
+	provider.Status.LastCredentialCheck = &now
+	provider.Status.Phase = computePhase(provider.Status.Conditions)
+	return condStatus, message
+}
+
 // validateProviderConfig validates the provider's auth configuration and returns
 // the condition status, reason, and message.
 func (r *LLMProviderReconciler) validateProviderConfig(ctx context.Context, provider *llmwardenv1alpha1.LLMProvider) (metav1.ConditionStatus, string, string) {
+	if _, err := provisioner.EffectiveBaseURL(provider); err != nil {
+		return metav1.ConditionFalse, reasonInvalidConfig, err.Error()
+	}
+
+	if len(provider.Spec.UpstreamProviderRefs) > 0 && provider.Spec.Provider != llmwardenv1alpha1.ProviderCustom {
+		return metav1.ConditionFalse, reasonInvalidConfig,
+			fmt.Sprintf("spec.upstreamProviderRefs is only supported for provider %s", llmwardenv1alpha1.ProviderCustom)
+	}
+
+	// Only a successful apiKey validation records the secret version it saw.
+	validated := provider.Status.ValidatedSecret
+	provider.Status.ValidatedSecret = nil
+
 	switch provider.Spec.Auth.Type {
 	case llmwardenv1alpha1.AuthTypeAPIKey:
-		return r.validateAPIKeyConfig(ctx, provider)
+		return r.validateAPIKeyConfig(ctx, provider, validated)
 	case llmwardenv1alpha1.AuthTypeExternalSecret:
 		return r.validateExternalSecretConfig(provider)
 	case llmwardenv1alpha1.AuthTypeWorkloadIdentity:
@@ -132,13 +248,20 @@ func (r *LLMProviderReconciler) validateProviderConfig(ctx context.Context, prov
 		return metav1.ConditionTrue, "WorkloadIdentityNotValidated",
 			"WorkloadIdentity auth type accepted (validation implemented in Phase 3)"
 	default:
+		if _, ok := provisioner.Lookup(provider.Spec.Auth.Type); ok {
+			return metav1.ConditionTrue, "RegisteredAuthType",
+				fmt.Sprintf("Auth type %s is handled by a registered provisioner", provider.Spec.Auth.Type)
+		}
 		return metav1.ConditionFalse, "UnknownAuthType",
 			fmt.Sprintf("Unknown auth type: %s", provider.Spec.Auth.Type)
 	}
 }
 
 // validateAPIKeyConfig checks that the referenced secret exists and contains the expected key.
-func (r *LLMProviderReconciler) validateAPIKeyConfig(ctx context.Context, provider *llmwardenv1alpha1.LLMProvider) (metav1.ConditionStatus, string, string) {
+// When validated shows the same secret version already passed for the provider's current
+// generation, the previous Ready result is reused without re-validating the contents.
+func (r *LLMProviderReconciler) validateAPIKeyConfig(ctx context.Context, provider *llmwardenv1alpha1.LLMProvider,
+	validated *llmwardenv1alpha1.ValidatedSecret) (metav1.ConditionStatus, string, string) {
 	if provider.Spec.Auth.APIKey == nil {
 		return metav1.ConditionFalse, reasonInvalidConfig,
 			"spec.auth.apiKey is required when spec.auth.type is apiKey"
@@ -151,19 +274,116 @@ func (r *LLMProviderReconciler) validateAPIKeyConfig(ctx context.Context, provid
 			return metav1.ConditionFalse, "SecretNotFound",
 				fmt.Sprintf("Provider secret %s/%s not found", ref.Namespace, ref.Name)
 		}
+		if apierrors.IsForbidden(err) {
+			return metav1.ConditionFalse, ReasonSourceSecretForbidden,
+				fmt.Sprintf("Not allowed to read provider secret %s/%s; grant the controller's ServiceAccount get on secrets in namespace %s",
+					ref.Namespace, ref.Name, ref.Namespace)
+		}
 		return metav1.ConditionFalse, "SecretGetError",
 			fmt.Sprintf("Failed to get provider secret %s/%s: %v", ref.Namespace, ref.Name, err)
 	}
 
-	if _, exists := secret.Data[ref.Key]; !exists {
+	if validated != nil && validated.ResourceVersion == secret.ResourceVersion && validated.Generation == provider.Generation {
+		if ready := apimeta.FindStatusCondition(provider.Status.Conditions, ConditionTypeReady); ready != nil && ready.Status == metav1.ConditionTrue {
+			logf.FromContext(ctx).V(1).Info("Provider secret unchanged since last validation, skipping",
+				"secret", ref.Namespace+"/"+ref.Name, "resourceVersion", secret.ResourceVersion)
+			provider.Status.ValidatedSecret = validated
+			return ready.Status, ready.Reason, ready.Message
+		}
+	}
+
+	raw, exists := secret.Data[ref.Key]
+	if !exists {
 		return metav1.ConditionFalse, "SecretKeyMissing",
 			fmt.Sprintf("Key %q not found in secret %s/%s", ref.Key, ref.Namespace, ref.Name)
 	}
 
+	apiKey, err := provisioner.ExtractAPIKey(raw, ref.Property)
+	if err != nil {
+		return metav1.ConditionFalse, "SecretPropertyInvalid",
+			fmt.Sprintf("Key %q in secret %s/%s: %v", ref.Key, ref.Namespace, ref.Name, err)
+	}
+
+	if missing := missingRequiredKeys(provider, secret, apiKey); len(missing) > 0 {
+		return metav1.ConditionFalse, ReasonRequiredKeyMissing,
+			fmt.Sprintf("Provider secret %s/%s is missing what %s requires: %s",
+				ref.Namespace, ref.Name, provider.Spec.Provider, strings.Join(missing, ", "))
+	}
+
+	provider.Status.ValidatedSecret = &llmwardenv1alpha1.ValidatedSecret{
+		ResourceVersion: secret.ResourceVersion,
+		Generation:      provider.Generation,
+	}
+	if ref.Property != "" {
+		return metav1.ConditionTrue, "SecretFound",
+			fmt.Sprintf("Provider secret %s/%s exists and key %q contains property %q", ref.Namespace, ref.Name, ref.Key, ref.Property)
+	}
+
 	return metav1.ConditionTrue, "SecretFound",
 		fmt.Sprintf("Provider secret %s/%s exists and contains key %q", ref.Namespace, ref.Name, ref.Key)
 }
 
+// providerRequiredKey is a field a provider type needs besides the API key. The provider
+// spec supplies it when configured reports true; otherwise the apiKey source secret must
+// hold it, either as a key or, for a JSON value read through secretRef.property, as a
+// top-level property.
+type providerRequiredKey struct {
+	name       string
+	configured func(*llmwardenv1alpha1.LLMProvider) bool
+}
+
+// providerRequiredKeys lists the fields each provider type needs from its source secret.
+var providerRequiredKeys = map[llmwardenv1alpha1.ProviderType][]providerRequiredKey{
+	llmwardenv1alpha1.ProviderAzureOpenAI: {
+		{name: "endpoint", configured: endpointConfigured},
+		{name: "deployment"},
+	},
+	llmwardenv1alpha1.ProviderAWSBedrock: {
+		{name: "region", configured: endpointConfigured},
+	},
+}
+
+// serviceAccountFields are the fields a gcp-vertexai credential, a service-account JSON
+// key, must carry.
+var serviceAccountFields = []string{"type", "client_email", "private_key"}
+
+// endpointConfigured reports whether the provider spec yields a base URL, which for
+// azure-openai and aws-bedrock means the endpoint or region is configured.
+func endpointConfigured(provider *llmwardenv1alpha1.LLMProvider) bool {
+	baseURL, err := provisioner.EffectiveBaseURL(provider)
+	return err == nil && baseURL != ""
+}
+
+// missingRequiredKeys returns the fields the provider type needs that neither its spec nor
+// its source secret supplies. apiKey is the credential read from the secret.
+func missingRequiredKeys(provider *llmwardenv1alpha1.LLMProvider, secret *corev1.Secret, apiKey []byte) []string {
+	ref := provider.Spec.Auth.APIKey.SecretRef
+	var missing []string
+	for _, required := range providerRequiredKeys[provider.Spec.Provider] {
+		if required.configured != nil && required.configured(provider) {
+			continue
+		}
+		if _, ok := secret.Data[required.name]; ok {
+			continue
+		}
+		if ref.Property != "" {
+			if _, err := provisioner.ExtractAPIKey(secret.Data[ref.Key], required.name); err == nil {
+				continue
+			}
+		}
+		missing = append(missing, fmt.Sprintf("key %q", required.name))
+	}
+
+	if provider.Spec.Provider == llmwardenv1alpha1.ProviderGCPVertexAI {
+		for _, field := range serviceAccountFields {
+			if _, err := provisioner.ExtractAPIKey(apiKey, field); err != nil {
+				missing = append(missing, fmt.Sprintf("service-account JSON field %q in key %q", field, ref.Key))
+			}
+		}
+	}
+	return missing
+}
+
 // validateExternalSecretConfig validates that the externalSecret auth config is well-formed.
 // It does not attempt to contact ESO — ESO may not be installed yet when the provider is created.
 func (r *LLMProviderReconciler) validateExternalSecretConfig(provider *llmwardenv1alpha1.LLMProvider) (metav1.ConditionStatus, string, string) {
@@ -192,10 +412,204 @@ func (r *LLMProviderReconciler) validateExternalSecretConfig(provider *llmwarden
 		fmt.Sprintf("ExternalSecret configured: %s/%s → %s", cfg.Store.Kind, cfg.Store.Name, cfg.RemoteRef.Key)
 }
 
+// resolveAllowedModels merges spec.allowedModels with the models listed in the ConfigMap
+// key referenced by spec.allowedModelsRef and the allowed models of every provider in
+// spec.upstreamProviderRefs. On error it also returns the condition reason to report.
+func (r *LLMProviderReconciler) resolveAllowedModels(ctx context.Context, provider *llmwardenv1alpha1.LLMProvider) ([]string, string, error) {
+	lists := [][]string{provider.Spec.AllowedModels}
+
+	if ref := provider.Spec.AllowedModelsRef; ref != nil {
+		cm := &corev1.ConfigMap{}
+		if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, cm); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, reasonAllowedModelsRefError, fmt.Errorf("allowed models ConfigMap %s/%s not found", ref.Namespace, ref.Name)
+			}
+			return nil, reasonAllowedModelsRefError, fmt.Errorf("failed to get allowed models ConfigMap %s/%s: %w", ref.Namespace, ref.Name, err)
+		}
+		raw, ok := cm.Data[ref.Key]
+		if !ok {
+			return nil, reasonAllowedModelsRefError, fmt.Errorf("key %q not found in allowed models ConfigMap %s/%s", ref.Key, ref.Namespace, ref.Name)
+		}
+		lists = append(lists, parseModelList(raw))
+	}
+
+	upstreams, err := r.upstreamAllowedModels(ctx, provider)
+	if err != nil {
+		return nil, ReasonUpstreamProviderNotFound, err
+	}
+	lists = append(lists, upstreams...)
+
+	return mergeModels(lists...), "", nil
+}
+
+// upstreamAllowedModels returns the allowed models of each provider in
+// spec.upstreamProviderRefs: its resolved status.allowedModels when it resolves its own
+// list, else its spec.allowedModels. All missing upstreams are reported in one error.
+func (r *LLMProviderReconciler) upstreamAllowedModels(ctx context.Context, provider *llmwardenv1alpha1.LLMProvider) ([][]string, error) {
+	var lists [][]string
+	var missing []string
+	for _, name := range provider.Spec.UpstreamProviderRefs {
+		if name == provider.Name && provider.Namespace == "" {
+			return nil, fmt.Errorf("spec.upstreamProviderRefs must not reference the provider itself")
+		}
+		upstream := &llmwardenv1alpha1.LLMProvider{}
+		if err := r.Get(ctx, types.NamespacedName{Name: name}, upstream); err != nil {
+			if apierrors.IsNotFound(err) {
+				missing = append(missing, name)
+				continue
+			}
+			return nil, fmt.Errorf("failed to get upstream LLMProvider %s: %w", name, err)
+		}
+		if upstream.Spec.AllowedModelsRef != nil || len(upstream.Spec.UpstreamProviderRefs) > 0 {
+			lists = append(lists, upstream.Status.AllowedModels)
+		} else {
+			lists = append(lists, upstream.Spec.AllowedModels)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("upstream LLMProviders not found: %s", strings.Join(missing, ", "))
+	}
+	return lists, nil
+}
+
+// allowedModelsSources describes where a provider's resolved allowlist came from.
+func allowedModelsSources(provider *llmwardenv1alpha1.LLMProvider) string {
+	var sources []string
+	if ref := provider.Spec.AllowedModelsRef; ref != nil {
+		sources = append(sources, fmt.Sprintf("ConfigMap %s/%s", ref.Namespace, ref.Name))
+	}
+	if refs := provider.Spec.UpstreamProviderRefs; len(refs) > 0 {
+		sources = append(sources, fmt.Sprintf("upstream providers %s", strings.Join(refs, ", ")))
+	}
+	return strings.Join(sources, " and ")
+}
+
+// parseModelList splits a newline- or comma-separated model list, dropping blank entries.
+func parseModelList(raw string) []string {
+	var models []string
+	for _, field := range strings.FieldsFunc(raw, func(c rune) bool { return c == '\n' || c == ',' }) {
+		if model := strings.TrimSpace(field); model != "" {
+			models = append(models, model)
+		}
+	}
+	return models
+}
+
+// mergeModels concatenates model lists, keeping the first occurrence of each model.
+func mergeModels(lists ...[]string) []string {
+	seen := make(map[string]bool)
+	var merged []string
+	for _, list := range lists {
+		for _, model := range list {
+			if !seen[model] {
+				seen[model] = true
+				merged = append(merged, model)
+			}
+		}
+	}
+	return merged
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *LLMProviderReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(
+		context.Background(),
+		&llmwardenv1alpha1.LLMProvider{},
+		allowedModelsRefField,
+		func(obj client.Object) []string {
+			provider, ok := obj.(*llmwardenv1alpha1.LLMProvider)
+			if !ok || provider.Spec.AllowedModelsRef == nil {
+				return nil
+			}
+			ref := provider.Spec.AllowedModelsRef
+			return []string{ref.Namespace + "/" + ref.Name}
+		},
+	); err != nil {
+		return fmt.Errorf("setting up allowedModelsRef field index: %w", err)
+	}
+
+	// Re-resolve the allowlist of every provider referencing a ConfigMap when it changes.
+	mapConfigMapToProviders := func(ctx context.Context, obj client.Object) []reconcile.Request {
+		providerList := &llmwardenv1alpha1.LLMProviderList{}
+		if err := mgr.GetClient().List(ctx, providerList,
+			client.MatchingFields{allowedModelsRefField: obj.GetNamespace() + "/" + obj.GetName()},
+		); err != nil {
+			return nil
+		}
+		reqs := make([]reconcile.Request, 0, len(providerList.Items))
+		for _, provider := range providerList.Items {
+			reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Name: provider.Name}})
+		}
+		return reqs
+	}
+
+	if err := mgr.GetFieldIndexer().IndexField(
+		context.Background(),
+		&llmwardenv1alpha1.LLMProvider{},
+		upstreamProviderRefsField,
+		func(obj client.Object) []string {
+			provider, ok := obj.(*llmwardenv1alpha1.LLMProvider)
+			if !ok {
+				return nil
+			}
+			return provider.Spec.UpstreamProviderRefs
+		},
+	); err != nil {
+		return fmt.Errorf("setting up upstreamProviderRefs field index: %w", err)
+	}
+
+	// Re-resolve the allowlist of every gateway provider fronting a provider when it changes.
+	mapUpstreamToGateways := func(ctx context.Context, obj client.Object) []reconcile.Request {
+		providerList := &llmwardenv1alpha1.LLMProviderList{}
+		if err := mgr.GetClient().List(ctx, providerList,
+			client.MatchingFields{upstreamProviderRefsField: obj.GetName()},
+		); err != nil {
+			return nil
+		}
+		reqs := make([]reconcile.Request, 0, len(providerList.Items))
+		for _, provider := range providerList.Items {
+			reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Name: provider.Name}})
+		}
+		return reqs
+	}
+
+	if err := mgr.GetFieldIndexer().IndexField(
+		context.Background(),
+		&llmwardenv1alpha1.LLMProvider{},
+		sourceSecretField,
+		func(obj client.Object) []string {
+			provider, ok := obj.(*llmwardenv1alpha1.LLMProvider)
+			if !ok || provider.Spec.Auth.APIKey == nil {
+				return nil
+			}
+			ref := provider.Spec.Auth.APIKey.SecretRef
+			return []string{ref.Namespace + "/" + ref.Name}
+		},
+	); err != nil {
+		return fmt.Errorf("setting up source secret field index: %w", err)
+	}
+
+	// Re-validate every provider reading a source secret when it changes; unchanged secrets
+	// are not re-validated by the periodic requeue.
+	mapSecretToProviders := func(ctx context.Context, obj client.Object) []reconcile.Request {
+		providerList := &llmwardenv1alpha1.LLMProviderList{}
+		if err := mgr.GetClient().List(ctx, providerList,
+			client.MatchingFields{sourceSecretField: obj.GetNamespace() + "/" + obj.GetName()},
+		); err != nil {
+			return nil
+		}
+		reqs := make([]reconcile.Request, 0, len(providerList.Items))
+		for _, provider := range providerList.Items {
+			reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Name: provider.Name}})
+		}
+		return reqs
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&llmwardenv1alpha1.LLMProvider{}).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(mapConfigMapToProviders)).
+		Watches(&llmwardenv1alpha1.LLMProvider{}, handler.EnqueueRequestsFromMapFunc(mapUpstreamToGateways)).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(mapSecretToProviders)).
 		Named("llmprovider").
 		Complete(r)
 }
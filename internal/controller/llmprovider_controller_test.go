@@ -21,7 +21,9 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -94,4 +96,157 @@ var _ = Describe("LLMProvider Controller", func() {
 			// Example: If you expect a certain status condition after reconciliation, verify it here.
 		})
 	})
+
+	Context("When configuring AWS workload identity", func() {
+		ctx := context.Background()
+
+		bedrockProvider := func(name string, aws llmwardenv1alpha1.AWSWorkloadIdentity) *llmwardenv1alpha1.LLMProvider {
+			return &llmwardenv1alpha1.LLMProvider{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: llmwardenv1alpha1.LLMProviderSpec{
+					Provider: llmwardenv1alpha1.ProviderAWSBedrock,
+					Auth: llmwardenv1alpha1.AuthConfig{
+						Type:             llmwardenv1alpha1.AuthTypeWorkloadIdentity,
+						WorkloadIdentity: &llmwardenv1alpha1.WorkloadIdentityAuth{AWS: &aws},
+					},
+				},
+			}
+		}
+
+		It("should accept single-role and chained-role configurations", func() {
+			single := bedrockProvider("bedrock-single-role", llmwardenv1alpha1.AWSWorkloadIdentity{
+				RoleArn: "arn:aws:iam::111111111111:role/bedrock",
+				Region:  "us-east-1",
+			})
+			Expect(k8sClient.Create(ctx, single)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, ctx, single)
+
+			chained := bedrockProvider("bedrock-chained-role", llmwardenv1alpha1.AWSWorkloadIdentity{
+				RoleArn:       "arn:aws:iam::111111111111:role/pod",
+				Region:        "us-east-1",
+				SourceRoleArn: "arn:aws:iam::111111111111:role/pod",
+				TargetRoleArn: "arn:aws:iam::222222222222:role/bedrock",
+			})
+			Expect(k8sClient.Create(ctx, chained)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, ctx, chained)
+		})
+
+		It("should reject malformed chain role ARNs", func() {
+			malformedSource := bedrockProvider("bedrock-bad-source", llmwardenv1alpha1.AWSWorkloadIdentity{
+				RoleArn:       "arn:aws:iam::111111111111:role/pod",
+				Region:        "us-east-1",
+				SourceRoleArn: "arn:aws:iam::1111:role/pod",
+				TargetRoleArn: "arn:aws:iam::222222222222:role/bedrock",
+			})
+			err := k8sClient.Create(ctx, malformedSource)
+			Expect(errors.IsInvalid(err)).To(BeTrue(), "expected Invalid, got %v", err)
+			Expect(err.Error()).To(ContainSubstring("sourceRoleArn"))
+
+			malformedTarget := bedrockProvider("bedrock-bad-target", llmwardenv1alpha1.AWSWorkloadIdentity{
+				RoleArn:       "arn:aws:iam::111111111111:role/pod",
+				Region:        "us-east-1",
+				SourceRoleArn: "arn:aws:iam::111111111111:role/pod",
+				TargetRoleArn: "arn:aws:iam::222222222222:user/bedrock",
+			})
+			err = k8sClient.Create(ctx, malformedTarget)
+			Expect(errors.IsInvalid(err)).To(BeTrue(), "expected Invalid, got %v", err)
+			Expect(err.Error()).To(ContainSubstring("targetRoleArn"))
+		})
+	})
+
+	Context("When resolving allowed models", func() {
+		ctx := context.Background()
+
+		openAIProvider := func(name string, inline []string, ref *llmwardenv1alpha1.ConfigMapReference) *llmwardenv1alpha1.LLMProvider {
+			return &llmwardenv1alpha1.LLMProvider{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: llmwardenv1alpha1.LLMProviderSpec{
+					Provider: llmwardenv1alpha1.ProviderOpenAI,
+					Auth: llmwardenv1alpha1.AuthConfig{
+						Type: llmwardenv1alpha1.AuthTypeAPIKey,
+						APIKey: &llmwardenv1alpha1.APIKeyAuth{
+							SecretRef: llmwardenv1alpha1.SecretReference{Name: "test-api-key", Namespace: "default", Key: "api-key"},
+						},
+					},
+					AllowedModels:    inline,
+					AllowedModelsRef: ref,
+				},
+			}
+		}
+
+		// reconcileProvider creates the provider, reconciles it once and returns the result.
+		reconcileProvider := func(provider *llmwardenv1alpha1.LLMProvider) *llmwardenv1alpha1.LLMProvider {
+			Expect(k8sClient.Create(ctx, provider)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, ctx, provider)
+
+			r := &LLMProviderReconciler{Client: k8sClient, Scheme: k8sClient.Scheme(), Recorder: record.NewFakeRecorder(10)}
+			_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: provider.Name}})
+			Expect(err).NotTo(HaveOccurred())
+
+			updated := &llmwardenv1alpha1.LLMProvider{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: provider.Name}, updated)).To(Succeed())
+			return updated
+		}
+
+		createModelsConfigMap := func(name, models string) *llmwardenv1alpha1.ConfigMapReference {
+			cm := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+				Data:       map[string]string{"models": models},
+			}
+			Expect(k8sClient.Create(ctx, cm)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, ctx, cm)
+			return &llmwardenv1alpha1.ConfigMapReference{Name: name, Namespace: "default", Key: "models"}
+		}
+
+		It("should use the inline list when no ConfigMap is referenced", func() {
+			provider := reconcileProvider(openAIProvider("models-inline-only", []string{"gpt-4o", "gpt-4o-mini"}, nil))
+
+			Expect(provider.Status.AllowedModels).To(BeEmpty())
+			Expect(apimeta.FindStatusCondition(provider.Status.Conditions, ConditionTypeAllowedModelsResolved)).To(BeNil())
+
+			r := &LLMAccessReconciler{}
+			Expect(r.validateModels([]string{"gpt-4o"}, provider, nil)).To(Succeed())
+			Expect(r.validateModels([]string{"o1"}, provider, nil)).NotTo(Succeed())
+			Expect(effectiveModels(nil, provider, nil)).To(Equal([]string{"gpt-4o", "gpt-4o-mini"}))
+		})
+
+		It("should load a newline- and comma-separated list from the ConfigMap", func() {
+			ref := createModelsConfigMap("models-ref-only", "gpt-4o\n o1 , o1-mini\n\n")
+			provider := reconcileProvider(openAIProvider("models-ref-only", nil, ref))
+
+			Expect(provider.Status.AllowedModels).To(Equal([]string{"gpt-4o", "o1", "o1-mini"}))
+			Expect(apimeta.IsStatusConditionTrue(provider.Status.Conditions, ConditionTypeAllowedModelsResolved)).To(BeTrue())
+
+			r := &LLMAccessReconciler{}
+			Expect(r.validateModels([]string{"o1-mini"}, provider, nil)).To(Succeed())
+			Expect(r.validateModels([]string{"gpt-4o-mini"}, provider, nil)).NotTo(Succeed())
+			Expect(effectiveModels(nil, provider, nil)).To(Equal([]string{"gpt-4o", "o1", "o1-mini"}))
+		})
+
+		It("should merge the inline list with the ConfigMap list", func() {
+			ref := createModelsConfigMap("models-merged", "gpt-4o,o1")
+			provider := reconcileProvider(openAIProvider("models-merged", []string{"gpt-4o-mini", "gpt-4o"}, ref))
+
+			Expect(provider.Status.AllowedModels).To(Equal([]string{"gpt-4o-mini", "gpt-4o", "o1"}))
+
+			r := &LLMAccessReconciler{}
+			Expect(r.validateModels([]string{"gpt-4o-mini", "o1"}, provider, nil)).To(Succeed())
+			Expect(r.validateModels([]string{"o1-mini"}, provider, nil)).NotTo(Succeed())
+		})
+
+		It("should reject every model while the ConfigMap cannot be resolved", func() {
+			provider := reconcileProvider(openAIProvider("models-missing-ref", nil,
+				&llmwardenv1alpha1.ConfigMapReference{Name: "does-not-exist", Namespace: "default", Key: "models"}))
+
+			resolved := apimeta.FindStatusCondition(provider.Status.Conditions, ConditionTypeAllowedModelsResolved)
+			Expect(resolved).NotTo(BeNil())
+			Expect(resolved.Status).To(Equal(metav1.ConditionFalse))
+			Expect(resolved.Message).To(ContainSubstring("not found"))
+
+			r := &LLMAccessReconciler{}
+			err := r.validateModels(nil, provider, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("have not been resolved"))
+		})
+	})
 })
@@ -0,0 +1,124 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+)
+
+// TestLLMProviderReconciler_ValidatedSecretCache breaks the source secret behind the
+// cache's back, so the Ready reason shows whether the secret was re-validated.
+func TestLLMProviderReconciler_ValidatedSecretCache(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai", Generation: 1},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderOpenAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef: llmwardenv1alpha1.SecretReference{Name: "openai-master", Namespace: "vault-sync", Key: "api-key"},
+				},
+			},
+		},
+	}
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai-master", Namespace: "vault-sync"},
+		Data:       map[string][]byte{"api-key": []byte("sk-test")},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(provider, source).
+		WithStatusSubresource(&llmwardenv1alpha1.LLMProvider{}).
+		Build()
+	r := &LLMProviderReconciler{
+		Client:   fakeClient,
+		Scheme:   scheme,
+		Recorder: record.NewFakeRecorder(10),
+	}
+
+	key := types.NamespacedName{Name: provider.Name}
+	reconcile := func() *llmwardenv1alpha1.LLMProvider {
+		t.Helper()
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+		updated := &llmwardenv1alpha1.LLMProvider{}
+		if err := fakeClient.Get(ctx, key, updated); err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		return updated
+	}
+	assertReady := func(p *llmwardenv1alpha1.LLMProvider, status metav1.ConditionStatus, reason string) {
+		t.Helper()
+		ready := apimeta.FindStatusCondition(p.Status.Conditions, ConditionTypeReady)
+		if ready == nil || ready.Status != status || ready.Reason != reason {
+			t.Fatalf("Ready = %+v, want %s/%s", ready, status, reason)
+		}
+	}
+
+	updated := reconcile()
+	assertReady(updated, metav1.ConditionTrue, "SecretFound")
+	stored := &corev1.Secret{}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Name: source.Name, Namespace: source.Namespace}, stored); err != nil {
+		t.Fatalf("Get() source secret error = %v", err)
+	}
+	want := llmwardenv1alpha1.ValidatedSecret{ResourceVersion: stored.ResourceVersion, Generation: 1}
+	if got := updated.Status.ValidatedSecret; got == nil || *got != want {
+		t.Fatalf("ValidatedSecret = %+v, want %+v", got, want)
+	}
+
+	// Pretend the recorded version had no API key. Nothing changed since, so the contents
+	// aren't read again and the provider stays Ready.
+	stored.Data = map[string][]byte{}
+	if err := fakeClient.Update(ctx, stored); err != nil {
+		t.Fatalf("Update() source secret error = %v", err)
+	}
+	updated.Status.ValidatedSecret.ResourceVersion = stored.ResourceVersion
+	if err := fakeClient.Status().Update(ctx, updated); err != nil {
+		t.Fatalf("Status().Update() error = %v", err)
+	}
+	assertReady(reconcile(), metav1.ConditionTrue, "SecretFound")
+
+	// Any write to the secret changes its resourceVersion and forces a re-validation.
+	stored.Labels = map[string]string{"rotated": "true"}
+	if err := fakeClient.Update(ctx, stored); err != nil {
+		t.Fatalf("Update() source secret error = %v", err)
+	}
+	updated = reconcile()
+	assertReady(updated, metav1.ConditionFalse, "SecretKeyMissing")
+	if updated.Status.ValidatedSecret != nil {
+		t.Errorf("ValidatedSecret = %+v after a failed validation, want nil", updated.Status.ValidatedSecret)
+	}
+}
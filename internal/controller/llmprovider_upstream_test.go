@@ -0,0 +1,188 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+)
+
+func TestLLMProviderReconciler_UpstreamProviderRefs(t *testing.T) {
+	apiKeyProvider := func(name string, providerType llmwardenv1alpha1.ProviderType, models []string, upstreams ...string) *llmwardenv1alpha1.LLMProvider {
+		return &llmwardenv1alpha1.LLMProvider{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Generation: 1},
+			Spec: llmwardenv1alpha1.LLMProviderSpec{
+				Provider:             providerType,
+				AllowedModels:        models,
+				UpstreamProviderRefs: upstreams,
+				Auth: llmwardenv1alpha1.AuthConfig{
+					Type: llmwardenv1alpha1.AuthTypeAPIKey,
+					APIKey: &llmwardenv1alpha1.APIKeyAuth{
+						SecretRef: llmwardenv1alpha1.SecretReference{Name: "master", Namespace: "vault-sync", Key: "api-key"},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		gateway       *llmwardenv1alpha1.LLMProvider
+		wantResolved  metav1.ConditionStatus
+		wantReason    string
+		wantModels    []string
+		wantMessage   string
+		wantNotReady  bool
+		wantReadyNote string
+	}{
+		{
+			name:         "merges the upstream allowlists",
+			gateway:      apiKeyProvider("gateway", llmwardenv1alpha1.ProviderCustom, []string{"llama-3"}, "openai", "anthropic"),
+			wantResolved: metav1.ConditionTrue,
+			wantReason:   "AllowedModelsResolved",
+			wantModels:   []string{"llama-3", "gpt-4o", "gpt-4o-mini", "claude-sonnet-4"},
+		},
+		{
+			name:         "reports missing upstream providers",
+			gateway:      apiKeyProvider("gateway", llmwardenv1alpha1.ProviderCustom, nil, "openai", "mistral", "gemini"),
+			wantResolved: metav1.ConditionFalse,
+			wantReason:   ReasonUpstreamProviderNotFound,
+			wantMessage:  "mistral, gemini",
+		},
+		{
+			name:          "rejects upstreams on a non-custom provider",
+			gateway:       apiKeyProvider("gateway", llmwardenv1alpha1.ProviderOpenAI, nil, "openai"),
+			wantNotReady:  true,
+			wantReadyNote: "only supported for provider custom",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			scheme := runtime.NewScheme()
+			_ = clientgoscheme.AddToScheme(scheme)
+			_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(
+					tt.gateway,
+					apiKeyProvider("openai", llmwardenv1alpha1.ProviderOpenAI, []string{"gpt-4o", "gpt-4o-mini"}),
+					apiKeyProvider("anthropic", llmwardenv1alpha1.ProviderAnthropic, []string{"claude-sonnet-4", "gpt-4o"}),
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Name: "master", Namespace: "vault-sync"},
+						Data:       map[string][]byte{"api-key": []byte("sk-test")},
+					},
+				).
+				WithStatusSubresource(&llmwardenv1alpha1.LLMProvider{}).
+				Build()
+
+			r := &LLMProviderReconciler{
+				Client:   fakeClient,
+				Scheme:   scheme,
+				Recorder: record.NewFakeRecorder(10),
+			}
+			key := types.NamespacedName{Name: tt.gateway.Name}
+			if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+
+			updated := &llmwardenv1alpha1.LLMProvider{}
+			if err := fakeClient.Get(ctx, key, updated); err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+
+			if tt.wantNotReady {
+				ready := apimeta.FindStatusCondition(updated.Status.Conditions, ConditionTypeReady)
+				if ready == nil || ready.Status != metav1.ConditionFalse || !strings.Contains(ready.Message, tt.wantReadyNote) {
+					t.Fatalf("Ready = %+v, want False mentioning %q", ready, tt.wantReadyNote)
+				}
+				return
+			}
+
+			resolved := apimeta.FindStatusCondition(updated.Status.Conditions, ConditionTypeAllowedModelsResolved)
+			if resolved == nil || resolved.Status != tt.wantResolved || resolved.Reason != tt.wantReason {
+				t.Fatalf("AllowedModelsResolved = %+v, want %s/%s", resolved, tt.wantResolved, tt.wantReason)
+			}
+			if !strings.Contains(resolved.Message, tt.wantMessage) {
+				t.Errorf("AllowedModelsResolved message = %q, want it to contain %q", resolved.Message, tt.wantMessage)
+			}
+			if !slices.Equal(updated.Status.AllowedModels, tt.wantModels) {
+				t.Errorf("status.allowedModels = %v, want %v", updated.Status.AllowedModels, tt.wantModels)
+			}
+
+			// The access side validates against the union, and rejects everything while unresolved.
+			accessReconciler := &LLMAccessReconciler{}
+			err := accessReconciler.validateModels([]string{"claude-sonnet-4"}, updated, nil)
+			if wantOK := tt.wantResolved == metav1.ConditionTrue; (err == nil) != wantOK {
+				t.Errorf("validateModels(claude-sonnet-4) error = %v, want success %v", err, wantOK)
+			}
+		})
+	}
+}
+
+func TestUpstreamAllowedModels_ResolvedUpstream(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+	// An upstream that resolves its own list contributes its status, not its spec.
+	upstream := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "azure"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			AllowedModels:    []string{"gpt-4o"},
+			AllowedModelsRef: &llmwardenv1alpha1.ConfigMapReference{Name: "models", Namespace: "default", Key: "models"},
+		},
+		Status: llmwardenv1alpha1.LLMProviderStatus{AllowedModels: []string{"gpt-4o", "o1"}},
+	}
+	gateway := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "gateway"},
+		Spec:       llmwardenv1alpha1.LLMProviderSpec{UpstreamProviderRefs: []string{"azure"}},
+	}
+	r := &LLMProviderReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(upstream).Build(),
+		Scheme: scheme,
+	}
+
+	lists, err := r.upstreamAllowedModels(ctx, gateway)
+	if err != nil {
+		t.Fatalf("upstreamAllowedModels() error = %v", err)
+	}
+	if got := mergeModels(lists...); !slices.Equal(got, []string{"gpt-4o", "o1"}) {
+		t.Errorf("upstream models = %v, want [gpt-4o o1]", got)
+	}
+
+	gateway.Spec.UpstreamProviderRefs = []string{"gateway"}
+	if _, err := r.upstreamAllowedModels(ctx, gateway); err == nil {
+		t.Errorf("upstreamAllowedModels() with a self-reference error = nil, want an error")
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/metrics"
+	"github.com/llmwarden/llmwarden/internal/provisioner"
+)
+
+// NamespacedLLMProviderReconciler reconciles a NamespacedLLMProvider object. It runs the
+// same checks as the LLMProviderReconciler against an LLMProvider view of the object,
+// after making sure the provider stays within its namespace.
+type NamespacedLLMProviderReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=llmwarden.io,resources=namespacedllmproviders,verbs=get;list;watch
+// +kubebuilder:rbac:groups=llmwarden.io,resources=namespacedllmproviders/status,verbs=get;update;patch
+
+// Reconcile validates the NamespacedLLMProvider and updates its status.
+func (r *NamespacedLLMProviderReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+	startTime := time.Now()
+
+	namespaced := &llmwardenv1alpha1.NamespacedLLMProvider{}
+	if err := r.Get(ctx, req.NamespacedName, namespaced); err != nil {
+		if apierrors.IsNotFound(err) {
+			metrics.ReconciliationDuration.WithLabelValues("namespacedllmprovider", "success").Observe(time.Since(startTime).Seconds())
+			return ctrl.Result{}, nil
+		}
+		metrics.ReconciliationDuration.WithLabelValues("namespacedllmprovider", "error").Observe(time.Since(startTime).Seconds())
+		return ctrl.Result{}, err
+	}
+
+	view := provisioner.NamespacedProviderView(namespaced)
+	condStatus, message := (&LLMProviderReconciler{Client: r.Client, Scheme: r.Scheme, Recorder: r.Recorder}).refreshStatus(ctx, view)
+	namespaced.Status = view.Status
+
+	llmAccessList := &llmwardenv1alpha1.LLMAccessList{}
+	if err := r.List(ctx, llmAccessList, client.InNamespace(namespaced.Namespace)); err != nil {
+		log.Error(err, "Failed to list LLMAccess resources")
+	} else {
+		accessCount := int32(0)
+		for _, access := range llmAccessList.Items {
+			if access.Spec.ProviderRef.Name == namespaced.Name &&
+				access.Spec.ProviderRef.Kind == llmwardenv1alpha1.ProviderKindNamespacedLLMProvider {
+				accessCount++
+			}
+		}
+		namespaced.Status.AccessCount = accessCount
+	}
+
+	if err := r.Status().Update(ctx, namespaced); err != nil {
+		metrics.ReconciliationDuration.WithLabelValues("namespacedllmprovider", "error").Observe(time.Since(startTime).Seconds())
+		return ctrl.Result{}, fmt.Errorf("failed to update provider status: %w", err)
+	}
+
+	// Label health by namespace/name so a namespaced provider never shares a series with a
+	// cluster-scoped one of the same name.
+	providerLabel := providerIndexKey(namespaced.Namespace, namespaced.Name)
+	if condStatus == metav1.ConditionTrue {
+		metrics.ProviderHealth.WithLabelValues(providerLabel, "healthy").Set(1)
+		metrics.ProviderHealth.WithLabelValues(providerLabel, "unhealthy").Set(0)
+		r.Recorder.Event(namespaced, corev1.EventTypeNormal, "ProviderHealthy",
+			"LLM provider is healthy and ready")
+	} else {
+		metrics.ProviderHealth.WithLabelValues(providerLabel, "healthy").Set(0)
+		metrics.ProviderHealth.WithLabelValues(providerLabel, "unhealthy").Set(1)
+		r.Recorder.Event(namespaced, corev1.EventTypeWarning, "ProviderUnhealthy",
+			fmt.Sprintf("LLM provider health check failed: %s", message))
+	}
+
+	metrics.ReconciliationDuration.WithLabelValues("namespacedllmprovider", "success").Observe(time.Since(startTime).Seconds())
+
+	// Requeue periodically for health checks; this also picks up allowedModelsRef changes.
+	return ctrl.Result{RequeueAfter: providerRequeueInterval}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NamespacedLLMProviderReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&llmwardenv1alpha1.NamespacedLLMProvider{}).
+		Named("namespacedllmprovider").
+		Complete(r)
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package credcopy implements the manager's copy-credentials subcommand, run by the init
+// containers the pod injector adds to fill memory-medium credential volumes and to write
+// merged env files. Running it from the operator's own image means no third-party image
+// has to be pulled into workload pods.
+package credcopy
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// Command is the manager subcommand that runs Run.
+const Command = "copy-credentials"
+
+// Run parses the arguments following Command and either copies the files of --src into
+// --dst or, with --env-file, writes them as a dotenv file of that name in --dst.
+func Run(args []string) error {
+	flags := flag.NewFlagSet(Command, flag.ContinueOnError)
+	src := flags.String("src", "", "Directory of the mounted secret volume to read.")
+	dst := flags.String("dst", "", "Directory to write to.")
+	envFile := flags.String("env-file", "", "If set, write the files as NAME=value lines of this file in --dst.")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *src == "" || *dst == "" {
+		return errors.New("--src and --dst are required")
+	}
+	if *envFile != "" {
+		return WriteEnvFile(*src, filepath.Join(*dst, *envFile))
+	}
+	return Copy(*src, *dst)
+}
+
+// Copy copies the files of the secret volume mounted at src into dst, keeping their
+// modes. Secret volume entries are symlinks into a hidden timestamped directory, so links
+// are followed and hidden entries skipped.
+func Copy(src, dst string) error {
+	names, err := entries(src)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		path := filepath.Join(src, name)
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(dst, name), data, info.Mode().Perm()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteEnvFile writes each file of the secret volume mounted at src as a NAME=value line
+// of the dotenv file path, in name order. Trailing newlines of the values are stripped.
+func WriteEnvFile(src, path string) error {
+	names, err := entries(src)
+	if err != nil {
+		return err
+	}
+	var b strings.Builder
+	for _, name := range names {
+		data, err := os.ReadFile(filepath.Join(src, name))
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&b, "%s=%s\n", name, strings.TrimRight(string(data), "\n"))
+	}
+	return os.WriteFile(path, []byte(b.String()), 0o644)
+}
+
+// entries returns the sorted names of the non-hidden entries of dir.
+func entries(dir string) ([]string, error) {
+	dirEntries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, entry := range dirEntries {
+		if !strings.HasPrefix(entry.Name(), ".") {
+			names = append(names, entry.Name())
+		}
+	}
+	slices.Sort(names)
+	return names, nil
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credcopy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// secretVolume lays out dir like a mounted secret volume: the files live in a hidden
+// timestamped directory and are reached through symlinks.
+func secretVolume(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	data := filepath.Join(dir, "..2026_01_01_00_00_00.000000000")
+	if err := os.Mkdir(data, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(data, name), []byte(content), 0o440); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Symlink(filepath.Join(data, name), filepath.Join(dir, name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Symlink(data, filepath.Join(dir, "..data")); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestRunCopy(t *testing.T) {
+	src := secretVolume(t, map[string]string{"apiKey": "sk-test", "baseUrl": "https://api.openai.com/v1"})
+	dst := t.TempDir()
+
+	if err := Run([]string{"--src", src, "--dst", dst}); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	entries, err := os.ReadDir(dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("dst has %d entries, want the two files without the hidden ones", len(entries))
+	}
+	for name, want := range map[string]string{"apiKey": "sk-test", "baseUrl": "https://api.openai.com/v1"} {
+		path := filepath.Join(dst, name)
+		info, err := os.Lstat(path)
+		if err != nil {
+			t.Fatalf("Lstat(%s) error = %v", name, err)
+		}
+		if !info.Mode().IsRegular() || info.Mode().Perm() != 0o440 {
+			t.Errorf("%s mode = %v, want a regular file with mode 0440", name, info.Mode())
+		}
+		if got, _ := os.ReadFile(path); string(got) != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestRunEnvFile(t *testing.T) {
+	src := secretVolume(t, map[string]string{"OPENAI_BASE_URL": "https://api.openai.com/v1\n", "OPENAI_API_KEY": "sk-test"})
+	dst := t.TempDir()
+
+	if err := Run([]string{"--src", src, "--dst", dst, "--env-file", ".env"}); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	got, err := os.ReadFile(filepath.Join(dst, ".env"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "OPENAI_API_KEY=sk-test\nOPENAI_BASE_URL=https://api.openai.com/v1\n"
+	if string(got) != want {
+		t.Errorf("env file = %q, want %q", got, want)
+	}
+}
+
+func TestRunRequiresDirectories(t *testing.T) {
+	if err := Run([]string{"--src", t.TempDir()}); err == nil {
+		t.Error("Run() without --dst succeeded, want an error")
+	}
+}
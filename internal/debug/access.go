@@ -0,0 +1,167 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package debug provides read-only diagnostic HTTP endpoints served alongside
+// the manager's metrics endpoint. Handlers read exclusively from the manager's
+// cache and never expose secret values.
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	webhookv1alpha1 "github.com/llmwarden/llmwarden/internal/webhook/v1alpha1"
+)
+
+// AccessPath is the path prefix the access handler is registered under.
+// Requests take the form /debug/access/{namespace}/{name}.
+const AccessPath = "/debug/access/"
+
+var debuglog = logf.Log.WithName("debug")
+
+// AccessSummary is the JSON document returned for a single LLMAccess.
+// It deliberately carries secret key names only, never secret values.
+type AccessSummary struct {
+	Namespace         string             `json:"namespace"`
+	Name              string             `json:"name"`
+	Provider          string             `json:"provider"`
+	Conditions        []metav1.Condition `json:"conditions"`
+	SecretName        string             `json:"secretName"`
+	SecretKeys        []string           `json:"secretKeys"`
+	LastRotation      *metav1.Time       `json:"lastRotation,omitempty"`
+	NextRotation      *metav1.Time       `json:"nextRotation,omitempty"`
+	InjectedWorkloads int                `json:"injectedWorkloads"`
+}
+
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+
+// AccessHandler serves read-only LLMAccess health summaries for dashboards.
+// Reader should be the manager's cached client so requests never hit the apiserver.
+type AccessHandler struct {
+	Reader client.Reader
+}
+
+// ServeHTTP implements http.Handler.
+func (h *AccessHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, AccessPath), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		http.Error(w, "expected path "+AccessPath+"{namespace}/{name}", http.StatusBadRequest)
+		return
+	}
+	key := types.NamespacedName{Namespace: parts[0], Name: parts[1]}
+
+	summary, err := h.summarize(r.Context(), key)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			http.Error(w, fmt.Sprintf("LLMAccess %s not found", key), http.StatusNotFound)
+			return
+		}
+		debuglog.Error(err, "Failed to build access summary", "access", key.String())
+		http.Error(w, "failed to build access summary", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(summary); err != nil {
+		debuglog.Error(err, "Failed to encode access summary", "access", key.String())
+	}
+}
+
+// summarize assembles the AccessSummary for the LLMAccess identified by key.
+func (h *AccessHandler) summarize(ctx context.Context, key types.NamespacedName) (*AccessSummary, error) {
+	access := &llmwardenv1alpha1.LLMAccess{}
+	if err := h.Reader.Get(ctx, key, access); err != nil {
+		return nil, err
+	}
+
+	summary := &AccessSummary{
+		Namespace:    access.Namespace,
+		Name:         access.Name,
+		Provider:     access.Spec.ProviderRef.Name,
+		Conditions:   access.Status.Conditions,
+		SecretName:   access.Spec.SecretName,
+		SecretKeys:   []string{},
+		LastRotation: access.Status.LastRotation,
+		NextRotation: access.Status.NextRotation,
+	}
+	if summary.Conditions == nil {
+		summary.Conditions = []metav1.Condition{}
+	}
+
+	// Report key names only; values are never read into the response.
+	secret := &corev1.Secret{}
+	err := h.Reader.Get(ctx, types.NamespacedName{Namespace: access.Namespace, Name: access.Spec.SecretName}, secret)
+	switch {
+	case err == nil:
+		for k := range secret.Data {
+			summary.SecretKeys = append(summary.SecretKeys, k)
+		}
+		slices.Sort(summary.SecretKeys)
+	case !apierrors.IsNotFound(err):
+		return nil, fmt.Errorf("getting secret %s/%s: %w", access.Namespace, access.Spec.SecretName, err)
+	}
+
+	count, err := h.countInjectedWorkloads(ctx, access)
+	if err != nil {
+		return nil, err
+	}
+	summary.InjectedWorkloads = count
+
+	return summary, nil
+}
+
+// countInjectedWorkloads counts pods matched by the access's selectors that the pod
+// injector annotated with the access's provider.
+func (h *AccessHandler) countInjectedWorkloads(ctx context.Context, access *llmwardenv1alpha1.LLMAccess) (int, error) {
+	if !webhookv1alpha1.HasWorkloadSelectors(access) {
+		return 0, nil
+	}
+
+	pods := &corev1.PodList{}
+	if err := h.Reader.List(ctx, pods, client.InNamespace(access.Namespace)); err != nil {
+		return 0, fmt.Errorf("listing pods in %s: %w", access.Namespace, err)
+	}
+
+	count := 0
+	for _, pod := range pods.Items {
+		if !webhookv1alpha1.WorkloadSelected(&pod, access) {
+			continue
+		}
+		injected := strings.Split(pod.Annotations[webhookv1alpha1.InjectedProvidersAnnotation()], ",")
+		if slices.Contains(injected, access.Spec.ProviderRef.Name) {
+			count++
+		}
+	}
+	return count, nil
+}
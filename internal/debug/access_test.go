@@ -0,0 +1,144 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	webhookv1alpha1 "github.com/llmwarden/llmwarden/internal/webhook/v1alpha1"
+)
+
+func TestAccessHandler_ServeHTTP(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	nextRotation := metav1.NewTime(time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC))
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "chatbot-access",
+			Namespace: "test-ns",
+		},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai-prod"},
+			SecretName:  "openai-creds",
+			WorkloadSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "chatbot"},
+			},
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+			},
+		},
+		Status: llmwardenv1alpha1.LLMAccessStatus{
+			Conditions: []metav1.Condition{{
+				Type:   "Ready",
+				Status: metav1.ConditionTrue,
+				Reason: "CredentialProvisioned",
+			}},
+			NextRotation: &nextRotation,
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai-creds", Namespace: "test-ns"},
+		Data: map[string][]byte{
+			"provider": []byte("openai"),
+			"apiKey":   []byte("sk-super-secret"),
+		},
+	}
+	injectedPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "chatbot-1",
+			Namespace:   "test-ns",
+			Labels:      map[string]string{"app": "chatbot"},
+			Annotations: map[string]string{webhookv1alpha1.InjectedProvidersAnnotation(): "openai-prod"},
+		},
+	}
+	uninjectedPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "chatbot-2",
+			Namespace: "test-ns",
+			Labels:    map[string]string{"app": "chatbot"},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRuntimeObjects(access, secret, injectedPod, uninjectedPod).
+		Build()
+	handler := &AccessHandler{Reader: fakeClient}
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		wantStatus int
+	}{
+		{name: "returns summary for seeded access", method: http.MethodGet, path: "/debug/access/test-ns/chatbot-access", wantStatus: http.StatusOK},
+		{name: "not found for unknown access", method: http.MethodGet, path: "/debug/access/test-ns/missing", wantStatus: http.StatusNotFound},
+		{name: "bad request for malformed path", method: http.MethodGet, path: "/debug/access/test-ns", wantStatus: http.StatusBadRequest},
+		{name: "rejects non-GET methods", method: http.MethodPost, path: "/debug/access/test-ns/chatbot-access", wantStatus: http.StatusMethodNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, nil))
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("ServeHTTP() status = %d, want %d (body: %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			if strings.Contains(rec.Body.String(), "sk-super-secret") {
+				t.Fatal("response must not contain secret values")
+			}
+
+			var got AccessSummary
+			if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if got.Provider != "openai-prod" || got.SecretName != "openai-creds" {
+				t.Errorf("unexpected identity fields: %+v", got)
+			}
+			if strings.Join(got.SecretKeys, ",") != "apiKey,provider" {
+				t.Errorf("SecretKeys = %v, want [apiKey provider]", got.SecretKeys)
+			}
+			if len(got.Conditions) != 1 || got.Conditions[0].Type != "Ready" {
+				t.Errorf("Conditions = %v, want a single Ready condition", got.Conditions)
+			}
+			if got.NextRotation == nil || !got.NextRotation.Equal(&nextRotation) {
+				t.Errorf("NextRotation = %v, want %v", got.NextRotation, nextRotation)
+			}
+			if got.InjectedWorkloads != 1 {
+				t.Errorf("InjectedWorkloads = %d, want 1", got.InjectedWorkloads)
+			}
+		})
+	}
+}
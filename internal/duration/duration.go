@@ -0,0 +1,85 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package duration parses the "<integer><unit>" durations of the llmwarden CRDs, such as
+// rotation intervals ("7d") and TTLs ("12h"), so the controller and the admission
+// webhooks agree on which values are valid.
+package duration
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Pattern is the grammar accepted by Parse. It mirrors the kubebuilder validation
+// pattern on rotation intervals: a single integer followed by exactly one unit, with no
+// sign, fraction, whitespace or additional segments.
+var Pattern = regexp.MustCompile(`^(\d+)([dhm])$`)
+
+// Max is the longest duration Parse accepts.
+const Max = 365 * 24 * time.Hour
+
+// Parse parses duration strings like "30d", "7d", "24h"
+// Maximum allowed: 365 days to prevent DoS via excessive durations
+func Parse(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, fmt.Errorf("empty duration string")
+	}
+
+	match := Pattern.FindStringSubmatch(s)
+	if match == nil {
+		return 0, fmt.Errorf("invalid duration format %q: expected a single integer followed by one of d, h, m", s)
+	}
+
+	value, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration value: %w", err)
+	}
+	// Prevent integer overflow and reject non-positive intervals ("0d" is ambiguous).
+	if value <= 0 || value > 365 {
+		return 0, fmt.Errorf("duration value out of range (1-365): %d", value)
+	}
+
+	var duration time.Duration
+	switch match[2] {
+	case "d":
+		duration = time.Duration(value) * 24 * time.Hour
+	case "h":
+		duration = time.Duration(value) * time.Hour
+	case "m":
+		duration = time.Duration(value) * time.Minute
+	}
+
+	// Additional safety check: max 365 days
+	if duration > Max {
+		return 0, fmt.Errorf("duration exceeds maximum allowed (365 days): %s", s)
+	}
+
+	return duration, nil
+}
+
+// Normalize returns s in canonical form, without leading zeros ("07d" becomes "7d"), or
+// the error Parse returns for it.
+func Normalize(s string) (string, error) {
+	if _, err := Parse(s); err != nil {
+		return "", err
+	}
+	match := Pattern.FindStringSubmatch(s)
+	return strings.TrimLeft(match[1], "0") + match[2], nil
+}
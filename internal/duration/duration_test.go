@@ -0,0 +1,127 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package duration
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "days", input: "7d", want: 7 * 24 * time.Hour},
+		{name: "hours", input: "24h", want: 24 * time.Hour},
+		{name: "minutes", input: "30m", want: 30 * time.Minute},
+		{name: "maximum days", input: "365d", want: 365 * 24 * time.Hour},
+		{name: "leading zeros", input: "07d", want: 7 * 24 * time.Hour},
+		{name: "empty", input: "", wantErr: true},
+		{name: "multi-segment", input: "7d8h", wantErr: true},
+		{name: "fractional", input: "1.5h", wantErr: true},
+		{name: "leading whitespace", input: "  7d", wantErr: true},
+		{name: "trailing whitespace", input: "7d ", wantErr: true},
+		{name: "signed", input: "+7d", wantErr: true},
+		{name: "negative", input: "-7d", wantErr: true},
+		{name: "missing unit", input: "7", wantErr: true},
+		{name: "missing value", input: "d", wantErr: true},
+		{name: "unsupported unit", input: "7x", wantErr: true},
+		{name: "seconds not accepted", input: "30s", wantErr: true},
+		{name: "zero", input: "0d", wantErr: true},
+		{name: "out of range", input: "366d", wantErr: true},
+		{name: "overflowing value", input: "99999999999999999999d", wantErr: true},
+		{name: "non-ascii digits", input: "٧d", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Parse(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	for _, seed := range []string{"7d", "24h", "30m", "365d", "7d8h", "1.5h", "  7d", "0d", "-1h", ""} {
+		f.Add(seed)
+	}
+
+	units := map[string]time.Duration{"d": 24 * time.Hour, "h": time.Hour, "m": time.Minute}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		d, err := Parse(s)
+		if err != nil {
+			return
+		}
+
+		if !Pattern.MatchString(s) {
+			t.Fatalf("Parse(%q) accepted input outside the validation pattern", s)
+		}
+		if d <= 0 || d > Max {
+			t.Fatalf("Parse(%q) = %v, outside (0, 365d]", s, d)
+		}
+
+		// Re-encoding the parsed value in the input's unit must yield the same duration.
+		unit := s[len(s)-1:]
+		canonical := fmt.Sprintf("%d%s", d/units[unit], unit)
+		again, err := Parse(canonical)
+		if err != nil {
+			t.Fatalf("Parse(%q) failed on round-trip of %q: %v", canonical, s, err)
+		}
+		if again != d {
+			t.Fatalf("round-trip of %q via %q = %v, want %v", s, canonical, again, d)
+		}
+	})
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "canonical", input: "7d", want: "7d"},
+		{name: "leading zeros", input: "07d", want: "7d"},
+		{name: "several leading zeros", input: "0030m", want: "30m"},
+		{name: "zero", input: "0d", wantErr: true},
+		{name: "overflowing value", input: "99999999999999999999d", wantErr: true},
+		{name: "out of range", input: "366d", wantErr: true},
+		{name: "invalid format", input: "7d8h", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Normalize(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Normalize(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -76,6 +76,12 @@ type ExternalSecretTarget struct {
 
 	// CreationPolicy controls Secret lifecycle relative to the ExternalSecret.
 	CreationPolicy SecretCreationPolicy
+
+	// Annotations are set on the resulting Secret via the target template. Optional.
+	Annotations map[string]string
+
+	// Labels are set on the resulting Secret via the target template. Optional.
+	Labels map[string]string
 }
 
 // ExternalSecretData maps a single remote secret reference to a local secret key.
@@ -124,3 +130,24 @@ type Adapter interface {
 	// Returns a best-effort status; never returns nil.
 	ParseSyncStatus(obj *unstructured.Unstructured) *SyncStatus
 }
+
+// templateMetadata returns spec.target.template.metadata for target, shared by the v1beta1
+// and v1 adapters. It is empty when there are no annotations or labels to template.
+func templateMetadata(target ExternalSecretTarget) map[string]any {
+	metadata := map[string]any{}
+	if len(target.Annotations) > 0 {
+		annotations := make(map[string]any, len(target.Annotations))
+		for k, v := range target.Annotations {
+			annotations[k] = v
+		}
+		metadata["annotations"] = annotations
+	}
+	if len(target.Labels) > 0 {
+		labels := make(map[string]any, len(target.Labels))
+		for k, v := range target.Labels {
+			labels[k] = v
+		}
+		metadata["labels"] = labels
+	}
+	return metadata
+}
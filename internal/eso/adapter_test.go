@@ -42,6 +42,11 @@ type adapterTestCase struct {
 	wantRemoteKey       string
 	wantRemoteProperty  string // "" means property must be absent
 	wantRemoteVersion   string // "" means version must be absent
+	// wantTargetAnnotations are expected under spec.target.template.metadata.annotations;
+	// nil means the template must be absent
+	wantTargetAnnotations map[string]string
+	// wantTargetLabels are expected under spec.target.template.metadata.labels
+	wantTargetLabels map[string]string
 }
 
 func adapterCases() []adapterTestCase {
@@ -122,6 +127,42 @@ func adapterCases() []adapterTestCase {
 			wantNamespace: "ns",
 			wantName:      "es",
 		},
+		{
+			name:      "target annotations are templated onto the secret",
+			namespace: "ns",
+			esName:    "es",
+			spec: ExternalSecretSpec{
+				RefreshInterval: "5m",
+				StoreRef:        StoreRef{Name: "store", Kind: "SecretStore"},
+				Target: ExternalSecretTarget{
+					Name:           "secret",
+					CreationPolicy: SecretCreationPolicyOwner,
+					Annotations:    map[string]string{"llmwarden.io/encryption-class": "kms-prod"},
+				},
+				Data: []ExternalSecretData{{SecretKey: "k", RemoteRef: RemoteRef{Key: "r"}}},
+			},
+			wantNamespace:         "ns",
+			wantName:              "es",
+			wantTargetAnnotations: map[string]string{"llmwarden.io/encryption-class": "kms-prod"},
+		},
+		{
+			name:      "target labels are templated onto the secret",
+			namespace: "ns",
+			esName:    "es",
+			spec: ExternalSecretSpec{
+				RefreshInterval: "5m",
+				StoreRef:        StoreRef{Name: "store", Kind: "SecretStore"},
+				Target: ExternalSecretTarget{
+					Name:           "secret",
+					CreationPolicy: SecretCreationPolicyOwner,
+					Labels:         map[string]string{"cost-center": "cc-42"},
+				},
+				Data: []ExternalSecretData{{SecretKey: "k", RemoteRef: RemoteRef{Key: "r"}}},
+			},
+			wantNamespace:    "ns",
+			wantName:         "es",
+			wantTargetLabels: map[string]string{"cost-center": "cc-42"},
+		},
 	}
 }
 
@@ -192,6 +233,23 @@ func runAdapterTests(t *testing.T, adapter Adapter, expectedVersion string) {
 				}
 			}
 
+			gotAnnotations, found, _ := unstructured.NestedStringMap(obj.Object, "spec", "target", "template", "metadata", "annotations")
+			if tc.wantTargetAnnotations == nil && found {
+				t.Errorf("spec.target.template.metadata.annotations = %v, want absent", gotAnnotations)
+			}
+			for k, wantV := range tc.wantTargetAnnotations {
+				if gotV := gotAnnotations[k]; gotV != wantV {
+					t.Errorf("spec.target.template.metadata.annotations[%s] = %q, want %q", k, gotV, wantV)
+				}
+			}
+
+			gotTargetLabels, _, _ := unstructured.NestedStringMap(obj.Object, "spec", "target", "template", "metadata", "labels")
+			for k, wantV := range tc.wantTargetLabels {
+				if gotV := gotTargetLabels[k]; gotV != wantV {
+					t.Errorf("spec.target.template.metadata.labels[%s] = %q, want %q", k, gotV, wantV)
+				}
+			}
+
 			// data[0]
 			if tc.wantRemoteKey != "" {
 				dataSlice, _, _ := unstructured.NestedSlice(obj.Object, "spec", "data")
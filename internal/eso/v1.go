@@ -69,6 +69,9 @@ func (a *V1Adapter) buildSpec(spec ExternalSecretSpec) map[string]any {
 		"name":           spec.Target.Name,
 		"creationPolicy": string(spec.Target.CreationPolicy),
 	}
+	if metadata := templateMetadata(spec.Target); len(metadata) > 0 {
+		target["template"] = map[string]any{"metadata": metadata}
+	}
 
 	data := make([]any, 0, len(spec.Data))
 	for _, d := range spec.Data {
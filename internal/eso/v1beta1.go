@@ -73,6 +73,9 @@ func (a *V1Beta1Adapter) buildSpec(spec ExternalSecretSpec) map[string]any {
 		"name":           spec.Target.Name,
 		"creationPolicy": string(spec.Target.CreationPolicy),
 	}
+	if metadata := templateMetadata(spec.Target); len(metadata) > 0 {
+		target["template"] = map[string]any{"metadata": metadata}
+	}
 
 	// Data entries: remote → local secret key mappings
 	data := make([]any, 0, len(spec.Data))
@@ -0,0 +1,63 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package health provides readiness checks for the operator's external dependencies.
+package health
+
+import (
+	"fmt"
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+)
+
+// ExternalSecretCheck reports not-ready when an LLMProvider uses externalSecret auth but
+// the ExternalSecret kind the ESO adapter targets is not served by the cluster, since
+// every access for such a provider would fail to provision.
+type ExternalSecretCheck struct {
+	Reader client.Reader
+	Mapper meta.RESTMapper
+	// GVK is the ExternalSecret kind the configured ESO adapter creates.
+	GVK schema.GroupVersionKind
+}
+
+// Check implements healthz.Checker.
+func (c *ExternalSecretCheck) Check(req *http.Request) error {
+	providers := &llmwardenv1alpha1.LLMProviderList{}
+	if err := c.Reader.List(req.Context(), providers); err != nil {
+		return fmt.Errorf("listing LLMProviders: %w", err)
+	}
+
+	var esoProvider string
+	for _, provider := range providers.Items {
+		if provider.Spec.Auth.Type == llmwardenv1alpha1.AuthTypeExternalSecret {
+			esoProvider = provider.Name
+			break
+		}
+	}
+	if esoProvider == "" {
+		return nil
+	}
+
+	if _, err := c.Mapper.RESTMapping(c.GVK.GroupKind(), c.GVK.Version); err != nil {
+		return fmt.Errorf("LLMProvider %s uses externalSecret auth but %s is not served: %w", esoProvider, c.GVK, err)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/eso"
+)
+
+func TestExternalSecretCheck(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+	gvk := eso.NewV1Adapter().GVK()
+
+	provider := func(name string, authType llmwardenv1alpha1.AuthType) *llmwardenv1alpha1.LLMProvider {
+		return &llmwardenv1alpha1.LLMProvider{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: llmwardenv1alpha1.LLMProviderSpec{
+				Provider: llmwardenv1alpha1.ProviderOpenAI,
+				Auth:     llmwardenv1alpha1.AuthConfig{Type: authType},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		providers []client.Object
+		esoServed bool
+		wantReady bool
+	}{
+		{
+			name:      "no providers is ready without ESO",
+			wantReady: true,
+		},
+		{
+			name:      "apiKey providers are ready without ESO",
+			providers: []client.Object{provider("openai", llmwardenv1alpha1.AuthTypeAPIKey)},
+			wantReady: true,
+		},
+		{
+			name: "externalSecret provider is not ready without ESO",
+			providers: []client.Object{
+				provider("openai", llmwardenv1alpha1.AuthTypeAPIKey),
+				provider("vault-openai", llmwardenv1alpha1.AuthTypeExternalSecret),
+			},
+			wantReady: false,
+		},
+		{
+			name:      "externalSecret provider is ready when ESO is served",
+			providers: []client.Object{provider("vault-openai", llmwardenv1alpha1.AuthTypeExternalSecret)},
+			esoServed: true,
+			wantReady: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapper := meta.NewDefaultRESTMapper(nil)
+			if tt.esoServed {
+				mapper.Add(gvk, meta.RESTScopeNamespace)
+			}
+			check := &ExternalSecretCheck{
+				Reader: fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.providers...).Build(),
+				Mapper: mapper,
+				GVK:    gvk,
+			}
+
+			err := check.Check(httptest.NewRequest("GET", "/readyz", nil))
+			if got := err == nil; got != tt.wantReady {
+				t.Errorf("Check() error = %v, want ready %v", err, tt.wantReady)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package labelkeys builds the keys of the labels llmwarden stamps on the resources it
+// manages and of the annotations the pod injector records on pods. Their prefix defaults
+// to llmwarden.io and can be changed with SetPrefix, e.g. so that several llmwarden
+// installations in one cluster don't claim each other's resources. SetPrefix must be
+// called before any controller or webhook starts; the prefix is not guarded for
+// concurrent changes.
+package labelkeys
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultPrefix is the prefix used unless SetPrefix changes it.
+const DefaultPrefix = "llmwarden.io"
+
+// ManagedByValue is the value of the managed-by label on managed resources.
+const ManagedByValue = "llmwarden"
+
+var prefix = DefaultPrefix
+
+// SetPrefix changes the prefix of every key built by this package. It must be a DNS
+// subdomain, as label key prefixes are.
+func SetPrefix(p string) error {
+	if errs := validation.IsDNS1123Subdomain(p); len(errs) > 0 {
+		return fmt.Errorf("invalid label prefix %q: %s", p, strings.Join(errs, "; "))
+	}
+	prefix = p
+	return nil
+}
+
+// Prefix returns the current prefix, without the trailing slash.
+func Prefix() string {
+	return prefix
+}
+
+// Key returns name under the current prefix, e.g. "llmwarden.io/access".
+func Key(name string) string {
+	return prefix + "/" + name
+}
+
+// IsReserved reports whether key is under the current prefix.
+func IsReserved(key string) bool {
+	return strings.HasPrefix(key, prefix+"/")
+}
+
+// ManagedBy is the label marking the secrets and ExternalSecrets the provisioners manage.
+func ManagedBy() string { return Key("managed-by") }
+
+// Provider is the label naming the provider a managed resource was provisioned for.
+func Provider() string { return Key("provider") }
+
+// Access is the label naming the LLMAccess a managed resource belongs to.
+func Access() string { return Key("access") }
+
+// AuthType is the label recording the auth type a managed resource was provisioned with.
+func AuthType() string { return Key("auth-type") }
+
+// RetainedFrom is the label naming the deleted LLMAccess a retained secret belonged to.
+func RetainedFrom() string { return Key("retained-from") }
+
+// ManagedSelector selects the resources the provisioners manage.
+func ManagedSelector() client.MatchingLabels {
+	return client.MatchingLabels{ManagedBy(): ManagedByValue}
+}
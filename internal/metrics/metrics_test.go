@@ -0,0 +1,66 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+func TestUsageMetricsRegistered(t *testing.T) {
+	tests := []struct {
+		name      string
+		collector prometheus.Collector
+	}{
+		{name: "tokens consumed", collector: TokensConsumed},
+		{name: "requests made", collector: RequestsMade},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := metrics.Registry.Register(tt.collector)
+			var alreadyRegistered prometheus.AlreadyRegisteredError
+			if !errors.As(err, &alreadyRegistered) {
+				t.Errorf("Register() error = %v, want AlreadyRegisteredError", err)
+			}
+		})
+	}
+}
+
+func TestSetBuildInfo(t *testing.T) {
+	SetBuildInfo("v0.1.0", "abc123")
+	SetBuildInfo("v0.2.0", "def456")
+
+	if got := testutil.ToFloat64(BuildInfo.WithLabelValues("v0.2.0", "def456", runtime.Version())); got != 1 {
+		t.Errorf("llmwarden_build_info{version=v0.2.0} = %v, want 1", got)
+	}
+	// Only the latest build is reported.
+	if got := testutil.CollectAndCount(BuildInfo); got != 1 {
+		t.Errorf("llmwarden_build_info series = %d, want 1", got)
+	}
+
+	err := metrics.Registry.Register(BuildInfo)
+	var alreadyRegistered prometheus.AlreadyRegisteredError
+	if !errors.As(err, &alreadyRegistered) {
+		t.Errorf("Register() error = %v, want AlreadyRegisteredError", err)
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"fmt"
+	"io"
+
+	"sigs.k8s.io/yaml"
+)
+
+// RuleFile is a Prometheus rule file. Its groups can also be pasted into the spec of a
+// prometheus-operator PrometheusRule.
+type RuleFile struct {
+	Groups []RuleGroup `json:"groups"`
+}
+
+// RuleGroup is a named group of rules evaluated together.
+type RuleGroup struct {
+	Name     string          `json:"name"`
+	Interval string          `json:"interval,omitempty"`
+	Rules    []RecordingRule `json:"rules"`
+}
+
+// RecordingRule records the result of Expr as the series Record.
+type RecordingRule struct {
+	Record string `json:"record"`
+	Expr   string `json:"expr"`
+}
+
+// RecordingRules returns recording rules deriving rates and ratios from the metrics
+// defined in this package, for dashboards and alerts to build on.
+func RecordingRules() RuleFile {
+	return RuleFile{Groups: []RuleGroup{
+		{
+			Name:     "llmwarden.credentials",
+			Interval: "1m",
+			Rules: []RecordingRule{
+				{
+					Record: "llmwarden:credential_rotations:rate5m",
+					Expr:   "sum by (provider) (rate(llmwarden_credential_rotations_total[5m]))",
+				},
+				{
+					Record: "llmwarden:credential_rotation_errors:rate5m",
+					Expr:   "sum by (provider) (rate(llmwarden_credential_rotation_errors_total[5m]))",
+				},
+				{
+					Record: "llmwarden:credential_rotation_errors:ratio_rate5m",
+					Expr: "llmwarden:credential_rotation_errors:rate5m / " +
+						"(llmwarden:credential_rotations:rate5m + llmwarden:credential_rotation_errors:rate5m)",
+				},
+				{
+					Record: "llmwarden:secret_provisioning_errors:ratio_rate5m",
+					Expr: `sum by (provider) (rate(llmwarden_secret_provisioning_total{result="error"}[5m])) / ` +
+						"sum by (provider) (rate(llmwarden_secret_provisioning_total[5m]))",
+				},
+				{
+					Record: "llmwarden:drift_repairs:increase1h",
+					Expr:   "sum by (provider, namespace) (increase(llmwarden_drift_repairs_total[1h]))",
+				},
+				{
+					Record: "llmwarden:rotation_notification_failures:increase1h",
+					Expr:   "sum by (provider) (increase(llmwarden_rotation_notification_failures_total[1h]))",
+				},
+			},
+		},
+		{
+			Name:     "llmwarden.webhook",
+			Interval: "1m",
+			Rules: []RecordingRule{
+				{
+					Record: "llmwarden:webhook_injections:rate5m",
+					Expr:   "sum by (namespace, provider) (rate(llmwarden_webhook_injections_total[5m]))",
+				},
+				{
+					Record: "llmwarden:webhook_injection_limit_exceeded:rate5m",
+					Expr:   "sum by (namespace, access) (rate(llmwarden_webhook_injection_limit_exceeded_total[5m]))",
+				},
+				{
+					Record: "llmwarden:uninjected_matching_pods:sum",
+					Expr:   "sum by (namespace, access) (llmwarden_uninjected_matching_pods)",
+				},
+			},
+		},
+		{
+			Name:     "llmwarden.controller",
+			Interval: "1m",
+			Rules: []RecordingRule{
+				{
+					Record: "llmwarden:reconciliation_duration_seconds:p99_5m",
+					Expr: "histogram_quantile(0.99, " +
+						"sum by (controller, le) (rate(llmwarden_reconciliation_duration_seconds_bucket[5m])))",
+				},
+				{
+					Record: "llmwarden:reconciliation_errors:ratio_rate5m",
+					Expr: `sum by (controller) (rate(llmwarden_reconciliation_duration_seconds_count{result="error"}[5m])) / ` +
+						"sum by (controller) (rate(llmwarden_reconciliation_duration_seconds_count[5m]))",
+				},
+				{
+					Record: "llmwarden:unhealthy_providers:count",
+					Expr:   `count(llmwarden_provider_health{status="unhealthy"} == 1)`,
+				},
+			},
+		},
+		{
+			Name:     "llmwarden.usage",
+			Interval: "1m",
+			Rules: []RecordingRule{
+				{
+					Record: "llmwarden:tokens_consumed:rate5m",
+					Expr:   "sum by (provider, namespace) (rate(llmwarden_tokens_consumed_total[5m]))",
+				},
+				{
+					Record: "llmwarden:requests_made:rate5m",
+					Expr:   "sum by (provider, namespace) (rate(llmwarden_requests_made_total[5m]))",
+				},
+			},
+		},
+	}}
+}
+
+// WriteRecordingRules writes RecordingRules to w as a YAML rule file.
+func WriteRecordingRules(w io.Writer) error {
+	out, err := yaml.Marshal(RecordingRules())
+	if err != nil {
+		return fmt.Errorf("marshaling recording rules: %w", err)
+	}
+	_, err = w.Write(out)
+	return err
+}
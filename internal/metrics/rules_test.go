@@ -0,0 +1,110 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	// fqNamePattern extracts the metric name from prometheus.Desc's String.
+	fqNamePattern = regexp.MustCompile(`fqName: "([^"]+)"`)
+	// metricPattern matches raw metric names in a PromQL expression; recorded series use
+	// colons and don't match.
+	metricPattern = regexp.MustCompile(`\bllmwarden_[a-z0-9_]+\b`)
+)
+
+// definedMetrics returns the names of the metrics in collectors.
+func definedMetrics(t *testing.T) map[string]bool {
+	t.Helper()
+	defined := make(map[string]bool)
+	descs := make(chan *prometheus.Desc, 16)
+	go func() {
+		for _, c := range collectors {
+			c.Describe(descs)
+		}
+		close(descs)
+	}()
+	for desc := range descs {
+		match := fqNamePattern.FindStringSubmatch(desc.String())
+		if match == nil {
+			t.Fatalf("cannot read the metric name of %s", desc)
+		}
+		defined[match[1]] = true
+	}
+	return defined
+}
+
+// seriesBase strips the suffix of a histogram or summary series, e.g. _bucket.
+func seriesBase(name string) string {
+	for _, suffix := range []string{"_bucket", "_sum", "_count"} {
+		if base, ok := strings.CutSuffix(name, suffix); ok {
+			return base
+		}
+	}
+	return name
+}
+
+func TestRecordingRules_ReferenceDefinedMetrics(t *testing.T) {
+	defined := definedMetrics(t)
+	recorded := make(map[string]bool)
+
+	for _, group := range RecordingRules().Groups {
+		if len(group.Rules) == 0 {
+			t.Errorf("group %s has no rules", group.Name)
+		}
+		for _, rule := range group.Rules {
+			if !strings.HasPrefix(rule.Record, "llmwarden:") {
+				t.Errorf("record %q, want the llmwarden: prefix", rule.Record)
+			}
+			if recorded[rule.Record] {
+				t.Errorf("record %q is defined twice", rule.Record)
+			}
+			recorded[rule.Record] = true
+
+			for _, name := range metricPattern.FindAllString(rule.Expr, -1) {
+				if !defined[name] && !defined[seriesBase(name)] {
+					t.Errorf("rule %s references %s, which this package does not define", rule.Record, name)
+				}
+			}
+		}
+	}
+}
+
+func TestWriteRecordingRules(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteRecordingRules(&buf); err != nil {
+		t.Fatalf("WriteRecordingRules() error = %v", err)
+	}
+
+	var got RuleFile
+	if err := yaml.UnmarshalStrict(buf.Bytes(), &got); err != nil {
+		t.Fatalf("output is not a rule file: %v\n%s", err, buf.String())
+	}
+	if len(got.Groups) != len(RecordingRules().Groups) {
+		t.Errorf("got %d groups, want %d", len(got.Groups), len(RecordingRules().Groups))
+	}
+	if !strings.Contains(buf.String(), "record: llmwarden:credential_rotation_errors:ratio_rate5m") {
+		t.Errorf("output = %s, want the rotation error ratio rule", buf.String())
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package notify tells external systems about credential rotations.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// RotationEvent is the JSON payload posted when an LLMAccess's credential is rotated.
+// It never carries the credential itself.
+type RotationEvent struct {
+	Access    string    `json:"access"`
+	Namespace string    `json:"namespace"`
+	Provider  string    `json:"provider"`
+	RotatedAt time.Time `json:"rotatedAt"`
+}
+
+// RotationNotifier posts RotationEvents to a webhook URL.
+type RotationNotifier struct {
+	URL        string
+	HTTPClient *http.Client
+}
+
+// Notify posts event to the notifier's URL. Any non-2xx response is an error.
+func (n *RotationNotifier) Notify(ctx context.Context, event RotationEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("encoding rotation event: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("building rotation notification: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	httpClient := n.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("posting rotation notification: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d from rotation notification URL", resp.StatusCode)
+	}
+	return nil
+}
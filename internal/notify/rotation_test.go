@@ -0,0 +1,84 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRotationNotifier_Notify(t *testing.T) {
+	rotatedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{name: "accepted notification", status: http.StatusAccepted},
+		{name: "rejected notification is an error", status: http.StatusInternalServerError, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got map[string]any
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					t.Errorf("method = %s, want POST", r.Method)
+				}
+				if ct := r.Header.Get("Content-Type"); ct != "application/json" {
+					t.Errorf("Content-Type = %q, want application/json", ct)
+				}
+				if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+					t.Errorf("decoding payload: %v", err)
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			n := &RotationNotifier{URL: server.URL, HTTPClient: server.Client()}
+			err := n.Notify(context.Background(), RotationEvent{
+				Access:    "openai-access",
+				Namespace: "team-a",
+				Provider:  "openai",
+				RotatedAt: rotatedAt,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Notify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			want := map[string]any{
+				"access":    "openai-access",
+				"namespace": "team-a",
+				"provider":  "openai",
+				"rotatedAt": "2026-03-01T12:00:00Z",
+			}
+			if len(got) != len(want) {
+				t.Errorf("payload = %v, want exactly %v", got, want)
+			}
+			for k, v := range want {
+				if got[k] != v {
+					t.Errorf("payload[%s] = %v, want %v", k, got[k], v)
+				}
+			}
+		})
+	}
+}
@@ -17,9 +17,13 @@ limitations under the License.
 package provisioner
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"maps"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -29,18 +33,99 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/labelkeys"
+	"github.com/llmwarden/llmwarden/internal/metrics"
 )
 
+// SourceVersionAnnotation records the resourceVersion of the provider's source secret
+// that a target secret was last provisioned from. HealthCheck uses it to tell manual
+// edits of the target apart from legitimate source changes that have not been copied yet.
+func SourceVersionAnnotation() string { return labelkeys.Key("source-version") }
+
+// ForceCleanupAnnotation, set to "true" on an LLMAccess, lets Cleanup delete its target
+// secret even while running pods still reference it.
+func ForceCleanupAnnotation() string { return labelkeys.Key("force-cleanup") }
+
+// ErrSecretInUse is returned by Cleanup when running pods still reference the secret.
+var ErrSecretInUse = errors.New("secret is in use")
+
+// secretReplacedKey is the context key set by WithSecretReplaced.
+type secretReplacedKey struct{}
+
+// WithSecretReplaced returns a context telling Cleanup that another provisioner is about to
+// write a secret under the same name, so pods referencing it will find the new one and the
+// in-use check is skipped.
+func WithSecretReplaced(ctx context.Context) context.Context {
+	return context.WithValue(ctx, secretReplacedKey{}, true)
+}
+
+// secretReplaced reports whether ctx was returned by WithSecretReplaced.
+func secretReplaced(ctx context.Context) bool {
+	replaced, _ := ctx.Value(secretReplacedKey{}).(bool)
+	return replaced
+}
+
+// ErrSourceSecretForbidden is returned by Provision when the controller isn't allowed to
+// read one of the provider's source secrets. Forbidden writes of the target secret are
+// returned as they are.
+var ErrSourceSecretForbidden = errors.New("forbidden from reading the provider's source secret")
+
+// ErrTargetEqualsSourceSecret is returned by Provision when an access's target secret is
+// one of the provider's source secrets, which writing would overwrite.
+var ErrTargetEqualsSourceSecret = errors.New("target secret is the provider's source secret")
+
+// ErrSecretTooLarge is returned by Provision when the target secret would exceed the
+// apiserver's size limit for secret data (corev1.MaxSecretSize).
+var ErrSecretTooLarge = errors.New("secret is too large")
+
+// RemediableError is a provisioning failure the user can fix by changing a secret or the
+// provider spec. Hint says how, naming the secret and the spec field involved.
+type RemediableError struct {
+	Err  error
+	Hint string
+}
+
+func (e *RemediableError) Error() string { return e.Err.Error() }
+
+func (e *RemediableError) Unwrap() error { return e.Err }
+
+// Remediation returns the hint of the RemediableError in err's chain, or "" if there is none.
+func Remediation(err error) string {
+	var remediable *RemediableError
+	if errors.As(err, &remediable) {
+		return remediable.Hint
+	}
+	return ""
+}
+
+// ManagedKeysAnnotation lists, comma-separated, the keys llmwarden wrote to a target
+// secret on the last provision, so keys dropped from the configuration can be removed
+// without touching keys added by anyone else.
+func ManagedKeysAnnotation() string { return labelkeys.Key("managed-keys") }
+
+// EncryptionClassAnnotation carries the secretEncryptionClass configured on the access or
+// provider so KMS/sealed-secret tooling and admission policies can act on managed secrets.
+func EncryptionClassAnnotation() string { return labelkeys.Key("encryption-class") }
+
+// baseManagedKeys are always owned by the provisioner, including on secrets written
+// before ManagedKeysAnnotation existed.
+var baseManagedKeys = []string{DefaultCredentialKey, "baseUrl", "provider"}
+
 // ApiKeyProvisioner implements the Provisioner interface for API key-based authentication.
 // It copies credentials from a provider's master secret into namespace-scoped secrets
 // for LLMAccess resources.
 type ApiKeyProvisioner struct {
 	client client.Client
 	scheme *runtime.Scheme
+
+	// PodReader lists the pods Cleanup checks for references to the secret. The manager's
+	// API reader keeps full pod objects out of the cache; nil uses the client.
+	PodReader client.Reader
 }
 
 // NewApiKeyProvisioner creates a new ApiKeyProvisioner.
@@ -59,43 +144,65 @@ func (p *ApiKeyProvisioner) Provision(ctx context.Context, provider *llmwardenv1
 		return nil, fmt.Errorf("provider %s does not have apiKey configuration", provider.Name)
 	}
 
-	// Fetch the source secret from the provider's namespace
-	sourceSecret := &corev1.Secret{}
-	sourceKey := types.NamespacedName{
-		Name:      provider.Spec.Auth.APIKey.SecretRef.Name,
-		Namespace: provider.Spec.Auth.APIKey.SecretRef.Namespace,
-	}
-	if err := p.client.Get(ctx, sourceKey, sourceSecret); err != nil {
-		if apierrors.IsNotFound(err) {
-			return nil, fmt.Errorf("provider secret %s/%s not found: %w", sourceKey.Namespace, sourceKey.Name, err)
-		}
-		return nil, fmt.Errorf("failed to get provider secret: %w", err)
+	// Writing the target would replace the master key with the derived shape.
+	if err := checkTargetIsNotSource(provider.Spec.Auth.APIKey, access); err != nil {
+		return nil, err
 	}
 
-	// Verify the key exists in the source secret
-	secretKey := provider.Spec.Auth.APIKey.SecretRef.Key
-	apiKeyData, exists := sourceSecret.Data[secretKey]
-	if !exists {
-		return nil, fmt.Errorf("key %s not found in secret %s/%s", secretKey, sourceKey.Namespace, sourceKey.Name)
+	// Fetch the API key from the provider's source secret, and the incoming key while a
+	// rotation window is open
+	currentRef, nextRef := apiKeySources(provider.Spec.Auth.APIKey, access)
+	currentField := "spec.auth.apiKey.secretRef"
+	if nextRef == nil && provider.Spec.Auth.APIKey.NextSecretRef != nil {
+		currentField = "spec.auth.apiKey.nextSecretRef"
+	}
+	sourceSecret, apiKeyData, err := p.readAPIKey(ctx, currentRef, currentField)
+	if err != nil {
+		return nil, err
 	}
 
 	// Prepare secret data with standard keys
+	credentialKey := CredentialKey(provider)
 	secretData := make(map[string][]byte)
-	secretData["apiKey"] = apiKeyData
+	secretData[credentialKey] = apiKeyData
+	if nextRef != nil {
+		_, nextKeyData, err := p.readAPIKey(ctx, *nextRef, "spec.auth.apiKey.nextSecretRef")
+		if err != nil {
+			return nil, fmt.Errorf("next API key: %w", err)
+		}
+		secretData[credentialKey+"Next"] = nextKeyData
+	}
+	if ProvisionsCACert(provider) {
+		_, caData, err := p.readAPIKey(ctx, *provider.Spec.Endpoint.CASecretRef, "spec.endpoint.caSecretRef")
+		if err != nil {
+			return nil, fmt.Errorf("endpoint CA bundle: %w", err)
+		}
+		secretData[CACertKey] = caData
+	}
 
 	// Prepare string data for metadata
 	stringData := make(map[string]string)
 
-	// Add base URL if configured
-	if provider.Spec.Endpoint != nil && provider.Spec.Endpoint.BaseURL != "" {
-		stringData["baseUrl"] = provider.Spec.Endpoint.BaseURL
+	// Add base URL if configured or derivable from the provider's regional template
+	baseURL, err := EffectiveBaseURL(provider)
+	if err != nil {
+		return nil, err
+	}
+	if baseURL != "" {
+		stringData["baseUrl"] = baseURL
 	}
 
 	// Add provider type
 	stringData["provider"] = string(provider.Spec.Provider)
 
 	// Collect keys for result
-	secretKeys := []string{"apiKey"}
+	secretKeys := []string{credentialKey}
+	if nextRef != nil {
+		secretKeys = append(secretKeys, credentialKey+"Next")
+	}
+	if _, ok := secretData[CACertKey]; ok {
+		secretKeys = append(secretKeys, CACertKey)
+	}
 	if _, ok := stringData["baseUrl"]; ok {
 		secretKeys = append(secretKeys, "baseUrl")
 	}
@@ -109,11 +216,43 @@ func (p *ApiKeyProvisioner) Provision(ctx context.Context, provider *llmwardenv1
 		},
 	}
 
-	_, err := controllerutil.CreateOrUpdate(ctx, p.client, targetSecret, func() error {
-		// Set owner reference for garbage collection
-		if err := controllerutil.SetControllerReference(access, targetSecret, p.scheme); err != nil {
+	endpointChanged := false
+	previousHash := ""
+	err = p.writeSecret(ctx, provider, targetSecret, func() error {
+		// Set owner reference for garbage collection, unless the secret must outlive the
+		// access. A secret retained by a previous access is adopted.
+		if RetainSecret(access) {
+			targetSecret.OwnerReferences = slices.DeleteFunc(targetSecret.OwnerReferences, func(ref metav1.OwnerReference) bool {
+				return ref.UID == access.UID
+			})
+		} else if err := controllerutil.SetControllerReference(access, targetSecret, p.scheme); err != nil {
 			return fmt.Errorf("failed to set owner reference: %w", err)
 		}
+		delete(targetSecret.Labels, labelkeys.RetainedFrom())
+
+		// Track endpoint migrations on existing secrets so callers can surface them.
+		// StringData is write-only on the API server, but objects that have not
+		// round-tripped (e.g. in fake clients) may still carry it.
+		previousBaseURL := string(targetSecret.Data["baseUrl"])
+		if v, ok := targetSecret.StringData["baseUrl"]; ok {
+			previousBaseURL = v
+		}
+		if targetSecret.ResourceVersion != "" && previousBaseURL != stringData["baseUrl"] {
+			endpointChanged = true
+		}
+		previousHash = ""
+		if previous, ok := targetSecret.Data[credentialKey]; ok && targetSecret.ResourceVersion != "" {
+			previousHash = CredentialFingerprint(previous)
+		}
+		// Rebuild the managed keys from scratch: drop every key we wrote last time that
+		// the current configuration no longer produces (e.g. baseUrl after the endpoint
+		// is removed), then write the desired set below.
+		for _, key := range previouslyManagedKeys(targetSecret) {
+			if !slices.Contains(secretKeys, key) {
+				delete(targetSecret.Data, key)
+				delete(targetSecret.StringData, key)
+			}
+		}
 
 		// Set data
 		if targetSecret.Data == nil {
@@ -130,15 +269,27 @@ func (p *ApiKeyProvisioner) Provision(ctx context.Context, provider *llmwardenv1
 		if targetSecret.Labels == nil {
 			targetSecret.Labels = make(map[string]string)
 		}
-		targetSecret.Labels["llmwarden.io/managed-by"] = "llmwarden"
-		targetSecret.Labels["llmwarden.io/provider"] = provider.Name
-		targetSecret.Labels["llmwarden.io/access"] = access.Name
-		targetSecret.Labels["llmwarden.io/auth-type"] = string(provider.Spec.Auth.Type)
+		maps.Copy(targetSecret.Labels, PropagatedLabels(provider))
+		targetSecret.Labels[labelkeys.ManagedBy()] = labelkeys.ManagedByValue
+		targetSecret.Labels[labelkeys.Provider()] = provider.Name
+		targetSecret.Labels[labelkeys.Access()] = access.Name
+		targetSecret.Labels[labelkeys.AuthType()] = string(provider.Spec.Auth.Type)
+
+		if targetSecret.Annotations == nil {
+			targetSecret.Annotations = make(map[string]string)
+		}
+		targetSecret.Annotations[SourceVersionAnnotation()] = sourceSecret.ResourceVersion
+		targetSecret.Annotations[ManagedKeysAnnotation()] = strings.Join(secretKeys, ",")
+		if class := encryptionClass(provider, access); class != "" {
+			targetSecret.Annotations[EncryptionClassAnnotation()] = class
+		} else {
+			delete(targetSecret.Annotations, EncryptionClassAnnotation())
+		}
 
 		// Set type
 		targetSecret.Type = corev1.SecretTypeOpaque
 
-		return nil
+		return checkSecretSize(targetSecret)
 	})
 
 	if err != nil {
@@ -150,7 +301,7 @@ func (p *ApiKeyProvisioner) Provision(ctx context.Context, provider *llmwardenv1
 		"provider":     provider.Name,
 		"providerType": string(provider.Spec.Provider),
 		"authType":     string(provider.Spec.Auth.Type),
-		"sourceSecret": fmt.Sprintf("%s/%s", sourceKey.Namespace, sourceKey.Name),
+		"sourceSecret": fmt.Sprintf("%s/%s", currentRef.Namespace, currentRef.Name),
 		"targetSecret": fmt.Sprintf("%s/%s", access.Namespace, access.Spec.SecretName),
 	}
 
@@ -166,20 +317,202 @@ func (p *ApiKeyProvisioner) Provision(ctx context.Context, provider *llmwardenv1
 	}
 
 	return &ProvisionResult{
-		SecretName:      access.Spec.SecretName,
-		SecretNamespace: access.Namespace,
-		SecretKeys:      secretKeys,
-		ExpiresAt:       expiresAt,
-		NeedsRotation:   needsRotation,
-		ProvisionedAt:   time.Now(),
-		Metadata:        metadata,
+		SecretName:             access.Spec.SecretName,
+		SecretNamespace:        access.Namespace,
+		SecretKeys:             secretKeys,
+		ExpiresAt:              expiresAt,
+		NeedsRotation:          needsRotation,
+		ProvisionedAt:          time.Now(),
+		EndpointChanged:        endpointChanged,
+		CredentialHash:         CredentialFingerprint(apiKeyData),
+		PreviousCredentialHash: previousHash,
+		Source:                 apiKeySource(currentRef),
+		Metadata:               metadata,
 	}, nil
 }
 
+// readAPIKey fetches the source secret ref points at and extracts the API key from it.
+// field is the provider spec path of ref, which the remediation hints of a missing
+// secret, key or value point to.
+func (p *ApiKeyProvisioner) readAPIKey(ctx context.Context, ref llmwardenv1alpha1.SecretReference, field string) (*corev1.Secret, []byte, error) {
+	sourceSecret := &corev1.Secret{}
+	sourceKey := types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}
+	if err := p.client.Get(ctx, sourceKey, sourceSecret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil, &RemediableError{
+				Err: fmt.Errorf("provider secret %s/%s not found: %w", sourceKey.Namespace, sourceKey.Name, err),
+				Hint: fmt.Sprintf("Create secret %s/%s with key '%s', or update %s",
+					sourceKey.Namespace, sourceKey.Name, ref.Key, field),
+			}
+		}
+		if apierrors.IsForbidden(err) {
+			return nil, nil, fmt.Errorf("%w %s/%s: %w", ErrSourceSecretForbidden, sourceKey.Namespace, sourceKey.Name, err)
+		}
+		return nil, nil, fmt.Errorf("failed to get provider secret: %w", err)
+	}
+
+	// Verify the key exists in the source secret
+	rawData, exists := sourceSecret.Data[ref.Key]
+	if !exists {
+		return nil, nil, &RemediableError{
+			Err: fmt.Errorf("key %s not found in secret %s/%s", ref.Key, sourceKey.Namespace, sourceKey.Name),
+			Hint: fmt.Sprintf("Add key '%s' to secret %s/%s, or update %s.key",
+				ref.Key, sourceKey.Namespace, sourceKey.Name, field),
+		}
+	}
+	if len(rawData) == 0 {
+		return nil, nil, &RemediableError{
+			Err: fmt.Errorf("key %s in secret %s/%s is empty", ref.Key, sourceKey.Namespace, sourceKey.Name),
+			Hint: fmt.Sprintf("Set a value for key '%s' in secret %s/%s, or update %s.key",
+				ref.Key, sourceKey.Namespace, sourceKey.Name, field),
+		}
+	}
+	apiKeyData, err := ExtractAPIKey(rawData, ref.Property)
+	if err != nil {
+		return nil, nil, &RemediableError{
+			Err: fmt.Errorf("key %s in secret %s/%s: %w", ref.Key, sourceKey.Namespace, sourceKey.Name, err),
+			Hint: fmt.Sprintf("Store a JSON object with a non-empty string property %q under key '%s' in secret %s/%s, or update %s.property",
+				ref.Property, ref.Key, sourceKey.Namespace, sourceKey.Name, field),
+		}
+	}
+	return sourceSecret, apiKeyData, nil
+}
+
+// apiKeySources returns the source of apiKey and, while a rotation window is open, the
+// source of apiKeyNext. Once the controller has promoted the next key for this access it
+// becomes the only key.
+func apiKeySources(auth *llmwardenv1alpha1.APIKeyAuth, access *llmwardenv1alpha1.LLMAccess) (llmwardenv1alpha1.SecretReference, *llmwardenv1alpha1.SecretReference) {
+	if auth.NextSecretRef == nil {
+		return auth.SecretRef, nil
+	}
+	if rotation := access.Status.KeyRotation; rotation != nil && rotation.Promoted && rotation.NextSecretRef == *auth.NextSecretRef {
+		return *auth.NextSecretRef, nil
+	}
+	return auth.SecretRef, auth.NextSecretRef
+}
+
+// checkSecretSize returns an error wrapping ErrSecretTooLarge if the secret's values,
+// with StringData folded into Data as the apiserver does, exceed corev1.MaxSecretSize. It
+// names the largest key, which is usually the one to trim.
+func checkSecretSize(secret *corev1.Secret) error {
+	sizes := make(map[string]int, len(secret.Data)+len(secret.StringData))
+	for key, value := range secret.Data {
+		sizes[key] = len(value)
+	}
+	for key, value := range secret.StringData {
+		sizes[key] = len(value)
+	}
+	total, largest := 0, ""
+	for key, size := range sizes {
+		total += size
+		if largest == "" || size > sizes[largest] || (size == sizes[largest] && key < largest) {
+			largest = key
+		}
+	}
+	if total <= corev1.MaxSecretSize {
+		return nil
+	}
+	return fmt.Errorf("%w: secret %s/%s would hold %d bytes of data, over the %d byte limit; its largest key %q holds %d bytes",
+		ErrSecretTooLarge, secret.Namespace, secret.Name, total, corev1.MaxSecretSize, largest, sizes[largest])
+}
+
+// checkTargetIsNotSource returns an error wrapping ErrTargetEqualsSourceSecret if the
+// access's target secret is the provider's secretRef or nextSecretRef secret.
+func checkTargetIsNotSource(auth *llmwardenv1alpha1.APIKeyAuth, access *llmwardenv1alpha1.LLMAccess) error {
+	refs := []llmwardenv1alpha1.SecretReference{auth.SecretRef}
+	if auth.NextSecretRef != nil {
+		refs = append(refs, *auth.NextSecretRef)
+	}
+	for _, ref := range refs {
+		if ref.Namespace == access.Namespace && ref.Name == access.Spec.SecretName {
+			return fmt.Errorf("%w: secretName %s in namespace %s is the provider's source secret; choose a different secretName",
+				ErrTargetEqualsSourceSecret, access.Spec.SecretName, access.Namespace)
+		}
+	}
+	return nil
+}
+
+// encryptionClass returns the secretEncryptionClass for the access's target secret; the
+// access's setting takes precedence over the provider's.
+func encryptionClass(provider *llmwardenv1alpha1.LLMProvider, access *llmwardenv1alpha1.LLMAccess) string {
+	if access.Spec.SecretEncryptionClass != "" {
+		return access.Spec.SecretEncryptionClass
+	}
+	return provider.Spec.SecretEncryptionClass
+}
+
+// previouslyManagedKeys returns the keys llmwarden owned in secret as of its last provision.
+func previouslyManagedKeys(secret *corev1.Secret) []string {
+	keys := slices.Clone(baseManagedKeys)
+	if recorded := secret.Annotations[ManagedKeysAnnotation()]; recorded != "" {
+		for _, key := range strings.Split(recorded, ",") {
+			if !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys
+}
+
+// apiKeySource references the entry in the provider's source secret that holds the API key.
+func apiKeySource(ref llmwardenv1alpha1.SecretReference) *corev1.ObjectReference {
+	fieldPath := fmt.Sprintf("data[%s]", ref.Key)
+	if ref.Property != "" {
+		fieldPath += "." + ref.Property
+	}
+	return &corev1.ObjectReference{
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Namespace:  ref.Namespace,
+		Name:       ref.Name,
+		FieldPath:  fieldPath,
+	}
+}
+
+// writeSecret runs CreateOrUpdate for a managed target secret, retrying on conflicts.
+// Each conflict is counted in SecretWriteConflicts so that secrets something else keeps
+// fighting over show up as hotspots instead of disappearing into silent retries.
+func (p *ApiKeyProvisioner) writeSecret(ctx context.Context, provider *llmwardenv1alpha1.LLMProvider, secret *corev1.Secret, mutate controllerutil.MutateFn) error {
+	key := client.ObjectKeyFromObject(secret)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		// Start every attempt from an empty object so CreateOrUpdate reads the latest
+		// version rather than decoding it over the state of the failed attempt.
+		*secret = corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}
+		_, err := controllerutil.CreateOrUpdate(ctx, p.client, secret, mutate)
+		if apierrors.IsConflict(err) {
+			metrics.SecretWriteConflicts.WithLabelValues(provider.Name, secret.Namespace).Inc()
+		}
+		return err
+	})
+}
+
 // Cleanup removes the secret created for the LLMAccess.
 // The secret will be automatically deleted via owner references when the LLMAccess is deleted,
 // but this method provides explicit cleanup if needed.
+//
+// A secret still referenced by running pods is not deleted, since that would break them
+// mid-flight; Cleanup returns an error wrapping ErrSecretInUse instead so the caller retries.
+// The check is skipped when ForceCleanupAnnotation is set on the LLMAccess, when ctx comes
+// from WithSecretReplaced, and when the LLMAccess is being deleted, since owner references
+// remove the secret then anyway.
 func (p *ApiKeyProvisioner) Cleanup(ctx context.Context, provider *llmwardenv1alpha1.LLMProvider, access *llmwardenv1alpha1.LLMAccess) error {
+	// Provision never wrote a target that is the source secret, so there is nothing of
+	// ours to delete, and deleting it would remove the provider's master key.
+	if provider.Spec.Auth.APIKey != nil && checkTargetIsNotSource(provider.Spec.Auth.APIKey, access) != nil {
+		return nil
+	}
+
+	if access.Annotations[ForceCleanupAnnotation()] != "true" && access.DeletionTimestamp.IsZero() && !secretReplaced(ctx) {
+		pods, err := p.podsUsingSecret(ctx, access.Namespace, access.Spec.SecretName)
+		if err != nil {
+			return err
+		}
+		if len(pods) > 0 {
+			return fmt.Errorf("%w: secret %s/%s is referenced by pods %s; set the %s=true annotation on the LLMAccess to delete it anyway",
+				ErrSecretInUse, access.Namespace, access.Spec.SecretName, strings.Join(pods, ", "), ForceCleanupAnnotation())
+		}
+	}
+
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      access.Spec.SecretName,
@@ -199,12 +532,71 @@ func (p *ApiKeyProvisioner) Cleanup(ctx context.Context, provider *llmwardenv1al
 	return nil
 }
 
+// podsUsingSecret returns the names of running pods in namespace that mount secretName as
+// a volume or read it through env or envFrom.
+func (p *ApiKeyProvisioner) podsUsingSecret(ctx context.Context, namespace, secretName string) ([]string, error) {
+	reader := client.Reader(p.client)
+	if p.PodReader != nil {
+		reader = p.PodReader
+	}
+	podList := &corev1.PodList{}
+	if err := reader.List(ctx, podList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list pods using secret %s/%s: %w", namespace, secretName, err)
+	}
+
+	var names []string
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if !pod.DeletionTimestamp.IsZero() || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		if podReferencesSecret(pod, secretName) {
+			names = append(names, pod.Name)
+		}
+	}
+	return names, nil
+}
+
+// podReferencesSecret reports whether any volume or container of pod references secretName.
+func podReferencesSecret(pod *corev1.Pod, secretName string) bool {
+	for _, vol := range pod.Spec.Volumes {
+		if vol.Secret != nil && vol.Secret.SecretName == secretName {
+			return true
+		}
+		if vol.Projected != nil {
+			for _, src := range vol.Projected.Sources {
+				if src.Secret != nil && src.Secret.Name == secretName {
+					return true
+				}
+			}
+		}
+	}
+
+	containers := append(slices.Clone(pod.Spec.InitContainers), pod.Spec.Containers...)
+	for _, c := range containers {
+		for _, env := range c.Env {
+			if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil && env.ValueFrom.SecretKeyRef.Name == secretName {
+				return true
+			}
+		}
+		for _, envFrom := range c.EnvFrom {
+			if envFrom.SecretRef != nil && envFrom.SecretRef.Name == secretName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // HealthCheck validates that the provisioned secret exists and contains valid data.
 func (p *ApiKeyProvisioner) HealthCheck(ctx context.Context, provider *llmwardenv1alpha1.LLMProvider, access *llmwardenv1alpha1.LLMAccess) (*HealthCheckResult, error) {
 	result := &HealthCheckResult{
 		LastChecked: time.Now(),
 		Metadata:    make(map[string]string),
 	}
+	if warning := DeprecationWarning(provider, result.LastChecked); warning != "" {
+		result.Warnings = append(result.Warnings, warning)
+	}
 
 	// Check if target secret exists
 	targetSecret := &corev1.Secret{}
@@ -222,8 +614,9 @@ func (p *ApiKeyProvisioner) HealthCheck(ctx context.Context, provider *llmwarden
 		return nil, fmt.Errorf("failed to get secret: %w", err)
 	}
 
-	// Verify apiKey exists in secret
-	if _, exists := targetSecret.Data["apiKey"]; !exists {
+	// Verify the credential exists in secret
+	targetKey, exists := targetSecret.Data[CredentialKey(provider)]
+	if !exists {
 		result.Healthy = false
 		result.Message = "API key not found in secret"
 		return result, nil
@@ -231,14 +624,23 @@ func (p *ApiKeyProvisioner) HealthCheck(ctx context.Context, provider *llmwarden
 
 	// Check if source secret still exists
 	if provider.Spec.Auth.APIKey != nil {
+		currentRef, _ := apiKeySources(provider.Spec.Auth.APIKey, access)
 		sourceSecret := &corev1.Secret{}
 		sourceKey := types.NamespacedName{
-			Name:      provider.Spec.Auth.APIKey.SecretRef.Name,
-			Namespace: provider.Spec.Auth.APIKey.SecretRef.Namespace,
+			Name:      currentRef.Name,
+			Namespace: currentRef.Namespace,
 		}
 		err := p.client.Get(ctx, sourceKey, sourceSecret)
 		if err != nil {
 			result.Warnings = append(result.Warnings, fmt.Sprintf("Source secret %s/%s not accessible", sourceKey.Namespace, sourceKey.Name))
+		} else if targetSecret.Annotations[SourceVersionAnnotation()] == sourceSecret.ResourceVersion &&
+			!bytes.Equal(targetKey, desiredAPIKey(sourceSecret, currentRef)) {
+			// The source is unchanged since the last provision, so a differing key
+			// means the target secret was edited out of band.
+			result.Healthy = false
+			result.Drifted = true
+			result.Message = "API key drifted from source secret"
+			return result, nil
 		}
 	}
 
@@ -260,6 +662,39 @@ func (p *ApiKeyProvisioner) HealthCheck(ctx context.Context, provider *llmwarden
 	return result, nil
 }
 
+// ExtractAPIKey returns the API key stored in a source secret value. When property is
+// empty the raw value is the key; otherwise the value must be a JSON object and the
+// named top-level field, which must be a non-empty string, is the key.
+func ExtractAPIKey(raw []byte, property string) ([]byte, error) {
+	if property == "" {
+		return raw, nil
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, fmt.Errorf("value is not a JSON object: %w", err)
+	}
+	value, ok := fields[property]
+	if !ok {
+		return nil, fmt.Errorf("property %q not found in JSON value", property)
+	}
+	str, ok := value.(string)
+	if !ok || str == "" {
+		return nil, fmt.Errorf("property %q must be a non-empty string", property)
+	}
+	return []byte(str), nil
+}
+
+// desiredAPIKey returns the API key the target secret should hold for the given source
+// secret, or nil if it cannot be determined.
+func desiredAPIKey(sourceSecret *corev1.Secret, ref llmwardenv1alpha1.SecretReference) []byte {
+	key, err := ExtractAPIKey(sourceSecret.Data[ref.Key], ref.Property)
+	if err != nil {
+		return nil
+	}
+	return key
+}
+
 // parseRotationDuration parses a rotation interval string supporting d/h/m suffixes.
 // Returns defaultDur when the string is empty or cannot be parsed.
 func parseRotationDuration(s string, defaultDur time.Duration) time.Duration {
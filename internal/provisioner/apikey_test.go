@@ -18,15 +18,25 @@ package provisioner
 
 import (
 	"context"
+	"errors"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/labelkeys"
+	"github.com/llmwarden/llmwarden/internal/metrics"
 )
 
 func TestApiKeyProvisioner_Provision(t *testing.T) {
@@ -365,6 +375,997 @@ func TestApiKeyProvisioner_Provision(t *testing.T) {
 	}
 }
 
+func TestApiKeyProvisioner_ProvisionJSONProperty(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	tests := []struct {
+		name       string
+		value      string
+		property   string
+		wantErr    bool
+		wantAPIKey string
+	}{
+		{
+			name:       "extracts property from JSON value",
+			value:      `{"apiKey":"sk-from-json","org":"org-123"}`,
+			property:   "apiKey",
+			wantAPIKey: "sk-from-json",
+		},
+		{
+			name:     "error when property is missing",
+			value:    `{"org":"org-123"}`,
+			property: "apiKey",
+			wantErr:  true,
+		},
+		{
+			name:     "error when value is not JSON",
+			value:    "sk-plain-text-key",
+			property: "apiKey",
+			wantErr:  true,
+		},
+		{
+			name:     "error when property is not a string",
+			value:    `{"apiKey":{"nested":"sk-nested"}}`,
+			property: "apiKey",
+			wantErr:  true,
+		},
+		{
+			name:       "raw value used when property is empty",
+			value:      `{"apiKey":"sk-from-json"}`,
+			wantAPIKey: `{"apiKey":"sk-from-json"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			sourceSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "source-secret",
+					Namespace: "provider-ns",
+				},
+				Data: map[string][]byte{
+					"credentials": []byte(tt.value),
+				},
+			}
+
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithRuntimeObjects(sourceSecret).
+				Build()
+
+			provisioner := NewApiKeyProvisioner(fakeClient, scheme)
+
+			provider := &llmwardenv1alpha1.LLMProvider{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-provider",
+				},
+				Spec: llmwardenv1alpha1.LLMProviderSpec{
+					Provider: llmwardenv1alpha1.ProviderOpenAI,
+					Auth: llmwardenv1alpha1.AuthConfig{
+						Type: llmwardenv1alpha1.AuthTypeAPIKey,
+						APIKey: &llmwardenv1alpha1.APIKeyAuth{
+							SecretRef: llmwardenv1alpha1.SecretReference{
+								Name:      "source-secret",
+								Namespace: "provider-ns",
+								Key:       "credentials",
+								Property:  tt.property,
+							},
+						},
+					},
+				},
+			}
+
+			access := &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-access",
+					Namespace: "test-ns",
+				},
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					SecretName: "json-secret",
+					ProviderRef: llmwardenv1alpha1.ProviderReference{
+						Name: "test-provider",
+					},
+					Injection: llmwardenv1alpha1.InjectionConfig{
+						Env: []llmwardenv1alpha1.EnvVarMapping{
+							{Name: "OPENAI_API_KEY", SecretKey: "apiKey"},
+						},
+					},
+				},
+			}
+
+			result, err := provisioner.Provision(ctx, provider, access)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Provision() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			wantFieldPath := "data[credentials]"
+			if tt.property != "" {
+				wantFieldPath += "." + tt.property
+			}
+			if result.Source == nil || result.Source.Name != "source-secret" || result.Source.Namespace != "provider-ns" ||
+				result.Source.FieldPath != wantFieldPath {
+				t.Errorf("result.Source = %+v, want provider-ns/source-secret %s", result.Source, wantFieldPath)
+			}
+
+			targetSecret := &corev1.Secret{}
+			if err := fakeClient.Get(ctx, types.NamespacedName{Name: "json-secret", Namespace: "test-ns"}, targetSecret); err != nil {
+				t.Fatalf("Failed to get target secret: %v", err)
+			}
+			if got := string(targetSecret.Data["apiKey"]); got != tt.wantAPIKey {
+				t.Errorf("apiKey = %q, want %q", got, tt.wantAPIKey)
+			}
+		})
+	}
+}
+
+func TestApiKeyProvisioner_ProvisionEndpointChange(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	ctx := context.Background()
+
+	sourceSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "source-secret",
+			Namespace: "provider-ns",
+		},
+		Data: map[string][]byte{
+			"api-key": []byte("sk-source-key"),
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRuntimeObjects(sourceSecret).
+		Build()
+
+	provisioner := NewApiKeyProvisioner(fakeClient, scheme)
+
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-access",
+			Namespace: "test-ns",
+		},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			SecretName: "endpoint-secret",
+			ProviderRef: llmwardenv1alpha1.ProviderReference{
+				Name: "test-provider",
+			},
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				Env: []llmwardenv1alpha1.EnvVarMapping{
+					{Name: "OPENAI_API_KEY", SecretKey: "apiKey"},
+				},
+			},
+		},
+	}
+
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-provider",
+		},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderOpenAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef: llmwardenv1alpha1.SecretReference{
+						Name:      "source-secret",
+						Namespace: "provider-ns",
+						Key:       "api-key",
+					},
+				},
+			},
+			Endpoint: &llmwardenv1alpha1.EndpointConfig{
+				BaseURL: "https://old.example.com/v1",
+			},
+		},
+	}
+
+	steps := []struct {
+		name        string
+		baseURL     string
+		wantChanged bool
+	}{
+		{name: "initial provision is not a migration", baseURL: "https://old.example.com/v1", wantChanged: false},
+		{name: "unchanged endpoint", baseURL: "https://old.example.com/v1", wantChanged: false},
+		{name: "endpoint migrated", baseURL: "https://new.example.com/v1", wantChanged: true},
+		{name: "endpoint removed", baseURL: "", wantChanged: true},
+	}
+
+	for _, step := range steps {
+		if step.baseURL == "" {
+			provider.Spec.Endpoint = nil
+		} else {
+			provider.Spec.Endpoint = &llmwardenv1alpha1.EndpointConfig{BaseURL: step.baseURL}
+		}
+
+		result, err := provisioner.Provision(ctx, provider, access)
+		if err != nil {
+			t.Fatalf("%s: Provision() error = %v", step.name, err)
+		}
+		if result.EndpointChanged != step.wantChanged {
+			t.Errorf("%s: EndpointChanged = %v, want %v", step.name, result.EndpointChanged, step.wantChanged)
+		}
+
+		targetSecret := &corev1.Secret{}
+		if err := fakeClient.Get(ctx, types.NamespacedName{Name: "endpoint-secret", Namespace: "test-ns"}, targetSecret); err != nil {
+			t.Fatalf("%s: failed to get target secret: %v", step.name, err)
+		}
+		if got := targetSecret.StringData["baseUrl"]; got != step.baseURL {
+			t.Errorf("%s: baseUrl = %q, want %q", step.name, got, step.baseURL)
+		}
+	}
+}
+
+func TestApiKeyProvisioner_ProvisionDerivedBaseURL(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	ctx := context.Background()
+
+	sourceSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "source-secret", Namespace: "provider-ns"},
+		Data:       map[string][]byte{"api-key": []byte("sk-source-key")},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRuntimeObjects(sourceSecret).
+		Build()
+
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "azure"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderAzureOpenAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef: llmwardenv1alpha1.SecretReference{Name: "source-secret", Namespace: "provider-ns", Key: "api-key"},
+				},
+			},
+			Endpoint: &llmwardenv1alpha1.EndpointConfig{ResourceName: "contoso"},
+		},
+	}
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-access", Namespace: "test-ns"},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			SecretName:  "azure-secret",
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "azure"},
+		},
+	}
+
+	p := NewApiKeyProvisioner(fakeClient, scheme)
+	result, err := p.Provision(ctx, provider, access)
+	if err != nil {
+		t.Fatalf("Provision() error = %v", err)
+	}
+	if !slices.Contains(result.SecretKeys, "baseUrl") {
+		t.Errorf("SecretKeys = %v, want baseUrl", result.SecretKeys)
+	}
+
+	targetSecret := &corev1.Secret{}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Name: "azure-secret", Namespace: "test-ns"}, targetSecret); err != nil {
+		t.Fatalf("failed to get target secret: %v", err)
+	}
+	if got := targetSecret.StringData["baseUrl"]; got != "https://contoso.openai.azure.com" {
+		t.Errorf("baseUrl = %q, want the derived Azure endpoint", got)
+	}
+
+	provider.Spec.Endpoint = &llmwardenv1alpha1.EndpointConfig{}
+	if _, err := p.Provision(ctx, provider, access); err == nil {
+		t.Error("Provision() error = nil, want an error for the missing resourceName")
+	}
+}
+
+func TestApiKeyProvisioner_ProvisionCACert(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	ctx := context.Background()
+
+	sourceSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "source-secret", Namespace: "provider-ns"},
+		Data:       map[string][]byte{"api-key": []byte("sk-source-key")},
+	}
+	caSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "private-llm-ca", Namespace: "provider-ns"},
+		Data:       map[string][]byte{"ca.crt": []byte("-----BEGIN CERTIFICATE-----")},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRuntimeObjects(sourceSecret, caSecret).
+		Build()
+
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "private-llm"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderCustom,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef: llmwardenv1alpha1.SecretReference{Name: "source-secret", Namespace: "provider-ns", Key: "api-key"},
+				},
+			},
+			Endpoint: &llmwardenv1alpha1.EndpointConfig{
+				BaseURL: "https://llm.internal.example.com/v1",
+				CASecretRef: &llmwardenv1alpha1.SecretReference{
+					Name: "private-llm-ca", Namespace: "provider-ns", Key: "ca.crt",
+				},
+			},
+		},
+	}
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-access", Namespace: "test-ns"},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			SecretName:  "private-llm-secret",
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "private-llm"},
+		},
+	}
+
+	p := NewApiKeyProvisioner(fakeClient, scheme)
+	result, err := p.Provision(ctx, provider, access)
+	if err != nil {
+		t.Fatalf("Provision() error = %v", err)
+	}
+	if !slices.Contains(result.SecretKeys, CACertKey) {
+		t.Errorf("SecretKeys = %v, want %s", result.SecretKeys, CACertKey)
+	}
+
+	targetSecret := &corev1.Secret{}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Name: "private-llm-secret", Namespace: "test-ns"}, targetSecret); err != nil {
+		t.Fatalf("failed to get target secret: %v", err)
+	}
+	if got := string(targetSecret.Data[CACertKey]); got != "-----BEGIN CERTIFICATE-----" {
+		t.Errorf("%s = %q, want the provider's CA bundle", CACertKey, got)
+	}
+
+	provider.Spec.Endpoint.CASecretRef.Name = "missing-ca"
+	_, err = p.Provision(ctx, provider, access)
+	if err == nil {
+		t.Fatal("Provision() error = nil, want an error for the missing CA secret")
+	}
+	wantHint := "Create secret provider-ns/missing-ca with key 'ca.crt', or update spec.endpoint.caSecretRef"
+	if hint := Remediation(err); hint != wantHint {
+		t.Errorf("Remediation() = %q, want %q", hint, wantHint)
+	}
+}
+
+func TestApiKeyProvisioner_TargetEqualsSourceSecret(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	ctx := context.Background()
+
+	sourceSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai-master", Namespace: "team-a"},
+		Data:       map[string][]byte{"api-key": []byte("sk-master-key")},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRuntimeObjects(sourceSecret).
+		Build()
+
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderOpenAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef: llmwardenv1alpha1.SecretReference{Name: "openai-master", Namespace: "team-a", Key: "api-key"},
+				},
+			},
+		},
+	}
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-access", Namespace: "team-a"},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			SecretName:  "openai-master",
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai"},
+		},
+	}
+
+	p := NewApiKeyProvisioner(fakeClient, scheme)
+	if _, err := p.Provision(ctx, provider, access); !errors.Is(err, ErrTargetEqualsSourceSecret) {
+		t.Fatalf("Provision() error = %v, want ErrTargetEqualsSourceSecret", err)
+	}
+	if err := p.Cleanup(ctx, provider, access); err != nil {
+		t.Fatalf("Cleanup() error = %v", err)
+	}
+
+	got := &corev1.Secret{}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Name: "openai-master", Namespace: "team-a"}, got); err != nil {
+		t.Fatalf("source secret was deleted: %v", err)
+	}
+	if len(got.Labels) != 0 || len(got.Annotations) != 0 || len(got.StringData) != 0 {
+		t.Errorf("source secret metadata was modified: labels=%v annotations=%v stringData=%v", got.Labels, got.Annotations, got.StringData)
+	}
+	if len(got.Data) != 1 || string(got.Data["api-key"]) != "sk-master-key" {
+		t.Errorf("source secret data = %v, want it unchanged", got.Data)
+	}
+
+	// The same name in another namespace is a separate secret and is provisioned normally.
+	access.Namespace = "team-b"
+	if _, err := p.Provision(ctx, provider, access); err != nil {
+		t.Errorf("Provision() in another namespace error = %v", err)
+	}
+}
+
+func TestApiKeyProvisioner_ProvisionSecretTooLarge(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	ctx := context.Background()
+
+	// The key alone fills the limit; provider and baseUrl push the target over it.
+	sourceSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "vertex-master", Namespace: "vault-sync"},
+		Data:       map[string][]byte{"sa.json": []byte(strings.Repeat("x", corev1.MaxSecretSize))},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRuntimeObjects(sourceSecret).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				if secret, ok := obj.(*corev1.Secret); ok && checkSecretSize(secret) != nil {
+					t.Errorf("oversized secret %s was sent to the apiserver", secret.Name)
+				}
+				return c.Create(ctx, obj, opts...)
+			},
+		}).
+		Build()
+
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "vertex"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderGCPVertexAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef: llmwardenv1alpha1.SecretReference{Name: "vertex-master", Namespace: "vault-sync", Key: "sa.json"},
+				},
+			},
+			Endpoint: &llmwardenv1alpha1.EndpointConfig{BaseURL: "https://proxy.example.com/v1"},
+		},
+	}
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: "vertex-access", Namespace: "team-a"},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			SecretName:  "vertex-credentials",
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "vertex"},
+		},
+	}
+
+	p := NewApiKeyProvisioner(fakeClient, scheme)
+	_, err := p.Provision(ctx, provider, access)
+	if !errors.Is(err, ErrSecretTooLarge) {
+		t.Fatalf("Provision() error = %v, want ErrSecretTooLarge", err)
+	}
+	for _, want := range []string{"team-a/vertex-credentials", `largest key "apiKey"`, "1048576 byte limit"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Provision() error = %q, want it to mention %s", err, want)
+		}
+	}
+	target := &corev1.Secret{}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Name: "vertex-credentials", Namespace: "team-a"}, target); !apierrors.IsNotFound(err) {
+		t.Errorf("Get(target) error = %v, want NotFound", err)
+	}
+
+	// A source value that leaves room for the other keys is provisioned.
+	sourceSecret.Data["sa.json"] = []byte(strings.Repeat("x", corev1.MaxSecretSize-1024))
+	if err := fakeClient.Update(ctx, sourceSecret); err != nil {
+		t.Fatalf("Update(source) error = %v", err)
+	}
+	if _, err := p.Provision(ctx, provider, access); err != nil {
+		t.Errorf("Provision() error = %v, want the secret provisioned under the limit", err)
+	}
+}
+
+func TestApiKeyProvisioner_ProvisionCustomLabelPrefix(t *testing.T) {
+	if err := labelkeys.SetPrefix("llm.acme.example"); err != nil {
+		t.Fatalf("SetPrefix() error = %v", err)
+	}
+	t.Cleanup(func() { _ = labelkeys.SetPrefix(labelkeys.DefaultPrefix) })
+
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	ctx := context.Background()
+
+	sourceSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "source-secret", Namespace: "provider-ns"},
+		Data:       map[string][]byte{"api-key": []byte("sk-source-key")},
+	}
+	// A secret managed by another installation using the default prefix.
+	otherInstance := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "other-secret",
+			Namespace: "test-ns",
+			Labels:    map[string]string{"llmwarden.io/managed-by": "llmwarden"},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRuntimeObjects(sourceSecret, otherInstance).
+		Build()
+
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderOpenAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef: llmwardenv1alpha1.SecretReference{Name: "source-secret", Namespace: "provider-ns", Key: "api-key"},
+				},
+			},
+			PropagatedLabels: map[string]string{"llm.acme.example/access": "spoofed"},
+		},
+	}
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-access", Namespace: "test-ns"},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			SecretName:  "openai-secret",
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai"},
+		},
+	}
+
+	p := NewApiKeyProvisioner(fakeClient, scheme)
+	if _, err := p.Provision(ctx, provider, access); err != nil {
+		t.Fatalf("Provision() error = %v", err)
+	}
+
+	targetSecret := &corev1.Secret{}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Name: "openai-secret", Namespace: "test-ns"}, targetSecret); err != nil {
+		t.Fatalf("failed to get target secret: %v", err)
+	}
+	wantLabels := map[string]string{
+		"llm.acme.example/managed-by": "llmwarden",
+		"llm.acme.example/provider":   "openai",
+		"llm.acme.example/access":     "test-access",
+		"llm.acme.example/auth-type":  "apiKey",
+	}
+	for k, want := range wantLabels {
+		if got := targetSecret.Labels[k]; got != want {
+			t.Errorf("label %s = %q, want %q", k, got, want)
+		}
+	}
+	if got, ok := targetSecret.Labels["llmwarden.io/managed-by"]; ok {
+		t.Errorf("label llmwarden.io/managed-by = %q, want only the custom prefix", got)
+	}
+	for _, key := range []string{"llm.acme.example/source-version", "llm.acme.example/managed-keys"} {
+		if _, ok := targetSecret.Annotations[key]; !ok {
+			t.Errorf("annotation %s missing; annotations = %v", key, targetSecret.Annotations)
+		}
+	}
+
+	// The managed-resource selector matches what was written, and not the secrets of an
+	// installation using another prefix.
+	secrets := &corev1.SecretList{}
+	if err := fakeClient.List(ctx, secrets, client.InNamespace("test-ns"), labelkeys.ManagedSelector()); err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	var names []string
+	for _, secret := range secrets.Items {
+		names = append(names, secret.Name)
+	}
+	if !slices.Equal(names, []string{"openai-secret"}) {
+		t.Errorf("managed secrets = %v, want [openai-secret]", names)
+	}
+}
+
+func TestApiKeyProvisioner_ProvisionPropagatedLabels(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	ctx := context.Background()
+
+	sourceSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "source-secret", Namespace: "provider-ns"},
+		Data:       map[string][]byte{"api-key": []byte("sk-source-key")},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRuntimeObjects(sourceSecret).
+		Build()
+
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderOpenAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef: llmwardenv1alpha1.SecretReference{Name: "source-secret", Namespace: "provider-ns", Key: "api-key"},
+				},
+			},
+			PropagatedLabels: map[string]string{
+				"team":                    "ml-platform",
+				"cost-center":             "cc-42",
+				"llmwarden.io/managed-by": "someone-else",
+			},
+		},
+	}
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-access", Namespace: "test-ns"},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			SecretName:  "openai-secret",
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai"},
+		},
+	}
+
+	p := NewApiKeyProvisioner(fakeClient, scheme)
+	if _, err := p.Provision(ctx, provider, access); err != nil {
+		t.Fatalf("Provision() error = %v", err)
+	}
+
+	targetSecret := &corev1.Secret{}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Name: "openai-secret", Namespace: "test-ns"}, targetSecret); err != nil {
+		t.Fatalf("failed to get target secret: %v", err)
+	}
+	wantLabels := map[string]string{
+		"team":                    "ml-platform",
+		"cost-center":             "cc-42",
+		"llmwarden.io/managed-by": "llmwarden",
+		"llmwarden.io/provider":   "openai",
+	}
+	for k, want := range wantLabels {
+		if got := targetSecret.Labels[k]; got != want {
+			t.Errorf("label %s = %q, want %q", k, got, want)
+		}
+	}
+}
+
+func TestApiKeyProvisioner_ProvisionRemovesStaleManagedKeys(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	ctx := context.Background()
+
+	sourceSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "source-secret", Namespace: "provider-ns"},
+		Data:       map[string][]byte{"api-key": []byte("sk-source-key")},
+	}
+	// A target secret provisioned when the configuration still produced organizationId.
+	// userNote was added by someone else and is not ours to remove.
+	targetSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "stale-secret",
+			Namespace:   "test-ns",
+			Annotations: map[string]string{ManagedKeysAnnotation(): "apiKey,organizationId,provider"},
+		},
+		Data: map[string][]byte{
+			"apiKey":         []byte("sk-source-key"),
+			"organizationId": []byte("org-123"),
+			"provider":       []byte("openai"),
+			"userNote":       []byte("keep me"),
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRuntimeObjects(sourceSecret, targetSecret).
+		Build()
+
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-provider"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderOpenAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef: llmwardenv1alpha1.SecretReference{
+						Name:      "source-secret",
+						Namespace: "provider-ns",
+						Key:       "api-key",
+					},
+				},
+			},
+		},
+	}
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-access", Namespace: "test-ns"},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			SecretName:  "stale-secret",
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "test-provider"},
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+			},
+		},
+	}
+
+	if _, err := NewApiKeyProvisioner(fakeClient, scheme).Provision(ctx, provider, access); err != nil {
+		t.Fatalf("Provision() error = %v", err)
+	}
+
+	got := &corev1.Secret{}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Name: "stale-secret", Namespace: "test-ns"}, got); err != nil {
+		t.Fatalf("failed to get target secret: %v", err)
+	}
+	if _, ok := got.Data["organizationId"]; ok {
+		t.Error("stale managed key organizationId was not removed")
+	}
+	if string(got.Data["userNote"]) != "keep me" {
+		t.Errorf("userNote = %q, want unmanaged key preserved", got.Data["userNote"])
+	}
+	if string(got.Data["apiKey"]) != "sk-source-key" {
+		t.Errorf("apiKey = %q, want %q", got.Data["apiKey"], "sk-source-key")
+	}
+	if want := "apiKey,provider"; got.Annotations[ManagedKeysAnnotation()] != want {
+		t.Errorf("%s = %q, want %q", ManagedKeysAnnotation(), got.Annotations[ManagedKeysAnnotation()], want)
+	}
+}
+
+func TestApiKeyProvisioner_ProvisionEncryptionClass(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	tests := []struct {
+		name          string
+		providerClass string
+		accessClass   string
+		wantClass     string
+	}{
+		{name: "no class leaves the secret unannotated"},
+		{name: "provider class is stamped", providerClass: "kms-prod", wantClass: "kms-prod"},
+		{name: "access class overrides provider", providerClass: "kms-prod", accessClass: "kms-pci", wantClass: "kms-pci"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			sourceSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "source-secret", Namespace: "provider-ns"},
+				Data:       map[string][]byte{"api-key": []byte("sk-source-key")},
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(sourceSecret).Build()
+
+			provider := &llmwardenv1alpha1.LLMProvider{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-provider"},
+				Spec: llmwardenv1alpha1.LLMProviderSpec{
+					Provider: llmwardenv1alpha1.ProviderOpenAI,
+					Auth: llmwardenv1alpha1.AuthConfig{
+						Type: llmwardenv1alpha1.AuthTypeAPIKey,
+						APIKey: &llmwardenv1alpha1.APIKeyAuth{
+							SecretRef: llmwardenv1alpha1.SecretReference{
+								Name:      "source-secret",
+								Namespace: "provider-ns",
+								Key:       "api-key",
+							},
+						},
+					},
+					SecretEncryptionClass: tt.providerClass,
+				},
+			}
+			access := &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-access", Namespace: "test-ns"},
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					SecretName:  "encrypted-secret",
+					ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "test-provider"},
+					Injection: llmwardenv1alpha1.InjectionConfig{
+						Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+					},
+					SecretEncryptionClass: tt.accessClass,
+				},
+			}
+
+			if _, err := NewApiKeyProvisioner(fakeClient, scheme).Provision(ctx, provider, access); err != nil {
+				t.Fatalf("Provision() error = %v", err)
+			}
+
+			got := &corev1.Secret{}
+			if err := fakeClient.Get(ctx, types.NamespacedName{Name: "encrypted-secret", Namespace: "test-ns"}, got); err != nil {
+				t.Fatalf("failed to get target secret: %v", err)
+			}
+			class, ok := got.Annotations[EncryptionClassAnnotation()]
+			if tt.wantClass == "" && ok {
+				t.Errorf("%s = %q, want no annotation", EncryptionClassAnnotation(), class)
+			}
+			if tt.wantClass != "" && class != tt.wantClass {
+				t.Errorf("%s = %q, want %q", EncryptionClassAnnotation(), class, tt.wantClass)
+			}
+		})
+	}
+}
+
+func TestApiKeyProvisioner_ProvisionKeyRotation(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	ctx := context.Background()
+
+	sourceSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "source-secret", Namespace: "provider-ns"},
+		Data: map[string][]byte{
+			"api-key":      []byte("sk-current-key"),
+			"api-key-next": []byte("sk-next-key"),
+		},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRuntimeObjects(sourceSecret).
+		Build()
+
+	nextRef := llmwardenv1alpha1.SecretReference{Name: "source-secret", Namespace: "provider-ns", Key: "api-key-next"}
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-provider"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderOpenAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef: llmwardenv1alpha1.SecretReference{
+						Name:      "source-secret",
+						Namespace: "provider-ns",
+						Key:       "api-key",
+					},
+					NextSecretRef: &nextRef,
+				},
+			},
+		},
+	}
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-access", Namespace: "test-ns"},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			SecretName:  "rotating-secret",
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "test-provider"},
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+			},
+		},
+	}
+
+	p := NewApiKeyProvisioner(fakeClient, scheme)
+	targetKey := types.NamespacedName{Name: "rotating-secret", Namespace: "test-ns"}
+
+	// During the window both keys are served.
+	result, err := p.Provision(ctx, provider, access)
+	if err != nil {
+		t.Fatalf("Provision() error = %v", err)
+	}
+	got := &corev1.Secret{}
+	if err := fakeClient.Get(ctx, targetKey, got); err != nil {
+		t.Fatalf("failed to get target secret: %v", err)
+	}
+	if string(got.Data["apiKey"]) != "sk-current-key" {
+		t.Errorf("apiKey = %q during window, want %q", got.Data["apiKey"], "sk-current-key")
+	}
+	if string(got.Data["apiKeyNext"]) != "sk-next-key" {
+		t.Errorf("apiKeyNext = %q during window, want %q", got.Data["apiKeyNext"], "sk-next-key")
+	}
+	if !slices.Contains(result.SecretKeys, "apiKeyNext") {
+		t.Errorf("SecretKeys = %v, want apiKeyNext during window", result.SecretKeys)
+	}
+
+	// After promotion the next key is the only key.
+	access.Status.KeyRotation = &llmwardenv1alpha1.KeyRotationStatus{
+		NextSecretRef: nextRef,
+		WindowStart:   metav1.NewTime(time.Now().Add(-48 * time.Hour)),
+		Promoted:      true,
+	}
+	result, err = p.Provision(ctx, provider, access)
+	if err != nil {
+		t.Fatalf("Provision() after promotion error = %v", err)
+	}
+	got = &corev1.Secret{}
+	if err := fakeClient.Get(ctx, targetKey, got); err != nil {
+		t.Fatalf("failed to get target secret: %v", err)
+	}
+	if string(got.Data["apiKey"]) != "sk-next-key" {
+		t.Errorf("apiKey = %q after promotion, want %q", got.Data["apiKey"], "sk-next-key")
+	}
+	if _, ok := got.Data["apiKeyNext"]; ok {
+		t.Error("apiKeyNext still present after promotion")
+	}
+	if result.Source.FieldPath != "data[api-key-next]" {
+		t.Errorf("Source.FieldPath = %q after promotion, want %q", result.Source.FieldPath, "data[api-key-next]")
+	}
+
+	health, err := p.HealthCheck(ctx, provider, access)
+	if err != nil {
+		t.Fatalf("HealthCheck() error = %v", err)
+	}
+	if health.Drifted {
+		t.Error("HealthCheck() reported drift for a promoted key")
+	}
+}
+
+func TestApiKeyProvisioner_TargetKey(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	ctx := context.Background()
+
+	sourceSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "source-secret", Namespace: "provider-ns"},
+		Data:       map[string][]byte{"api-key": []byte("sk-source-key")},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRuntimeObjects(sourceSecret).
+		Build()
+
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-provider"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderOpenAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type:      llmwardenv1alpha1.AuthTypeAPIKey,
+				TargetKey: "OPENAI_API_KEY",
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef: llmwardenv1alpha1.SecretReference{
+						Name:      "source-secret",
+						Namespace: "provider-ns",
+						Key:       "api-key",
+					},
+				},
+			},
+		},
+	}
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-access", Namespace: "test-ns"},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			SecretName:  "target-secret",
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "test-provider"},
+		},
+	}
+
+	p := NewApiKeyProvisioner(fakeClient, scheme)
+	targetKey := types.NamespacedName{Name: "target-secret", Namespace: "test-ns"}
+
+	for _, credentialKey := range []string{"OPENAI_API_KEY", DefaultCredentialKey} {
+		if credentialKey == DefaultCredentialKey {
+			provider.Spec.Auth.TargetKey = ""
+		}
+
+		result, err := p.Provision(ctx, provider, access)
+		if err != nil {
+			t.Fatalf("Provision() with key %s error = %v", credentialKey, err)
+		}
+		if !slices.Contains(result.SecretKeys, credentialKey) {
+			t.Errorf("SecretKeys = %v, want %s", result.SecretKeys, credentialKey)
+		}
+
+		got := &corev1.Secret{}
+		if err := fakeClient.Get(ctx, targetKey, got); err != nil {
+			t.Fatalf("failed to get target secret: %v", err)
+		}
+		if string(got.Data[credentialKey]) != "sk-source-key" {
+			t.Errorf("Data[%s] = %q, want %q", credentialKey, got.Data[credentialKey], "sk-source-key")
+		}
+		for key := range got.Data {
+			if key != credentialKey && (key == "OPENAI_API_KEY" || key == DefaultCredentialKey) {
+				t.Errorf("secret still holds the credential under the previous key %s", key)
+			}
+		}
+
+		health, err := p.HealthCheck(ctx, provider, access)
+		if err != nil {
+			t.Fatalf("HealthCheck() with key %s error = %v", credentialKey, err)
+		}
+		if !health.Healthy {
+			t.Errorf("HealthCheck() with key %s unhealthy: %s", credentialKey, health.Message)
+		}
+	}
+}
+
 func TestApiKeyProvisioner_Cleanup(t *testing.T) {
 	scheme := runtime.NewScheme()
 	_ = llmwardenv1alpha1.AddToScheme(scheme)
@@ -444,6 +1445,152 @@ func TestApiKeyProvisioner_Cleanup(t *testing.T) {
 	}
 }
 
+func TestApiKeyProvisioner_CleanupSecretInUse(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	ctx := context.Background()
+
+	envPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "env-consumer", Namespace: "test-ns"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name: "app",
+				Env: []corev1.EnvVar{{
+					Name: "OPENAI_API_KEY",
+					ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "in-use-secret"},
+						Key:                  "apiKey",
+					}},
+				}},
+			}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	volumePod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "volume-consumer", Namespace: "test-ns"},
+		Spec: corev1.PodSpec{
+			Volumes: []corev1.Volume{{
+				Name:         "creds",
+				VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "in-use-secret"}},
+			}},
+			Containers: []corev1.Container{{Name: "app"}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	// Completed pods and pods referencing other secrets don't block cleanup.
+	completedPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "finished-job", Namespace: "test-ns"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:    "job",
+				EnvFrom: []corev1.EnvFromSource{{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "unused-secret"}}}},
+			}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+	}
+	secret := func(name string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-ns"},
+			Data:       map[string][]byte{"apiKey": []byte("sk-test")},
+		}
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRuntimeObjects(secret("in-use-secret"), secret("unused-secret"), envPod, volumePod, completedPod).
+		Build()
+	p := NewApiKeyProvisioner(fakeClient, scheme)
+	provider := &llmwardenv1alpha1.LLMProvider{ObjectMeta: metav1.ObjectMeta{Name: "test-provider"}}
+
+	access := func(secretName string, annotations map[string]string) *llmwardenv1alpha1.LLMAccess {
+		return &llmwardenv1alpha1.LLMAccess{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-access", Namespace: "test-ns", Annotations: annotations},
+			Spec:       llmwardenv1alpha1.LLMAccessSpec{SecretName: secretName},
+		}
+	}
+	exists := func(name string) bool {
+		err := fakeClient.Get(ctx, types.NamespacedName{Name: name, Namespace: "test-ns"}, &corev1.Secret{})
+		return err == nil
+	}
+
+	err := p.Cleanup(ctx, provider, access("in-use-secret", nil))
+	if !errors.Is(err, ErrSecretInUse) {
+		t.Fatalf("Cleanup() error = %v, want ErrSecretInUse", err)
+	}
+	for _, pod := range []string{"env-consumer", "volume-consumer"} {
+		if !strings.Contains(err.Error(), pod) {
+			t.Errorf("Cleanup() error = %q, want it to name pod %s", err, pod)
+		}
+	}
+	if !exists("in-use-secret") {
+		t.Error("secret referenced by running pods was deleted")
+	}
+
+	if err := p.Cleanup(ctx, provider, access("unused-secret", nil)); err != nil {
+		t.Fatalf("Cleanup() of unused secret error = %v", err)
+	}
+	if exists("unused-secret") {
+		t.Error("unused secret was not deleted")
+	}
+
+	// A secret about to be rewritten under the same name, or one whose access is being
+	// deleted, is removed even though pods reference it.
+	for _, name := range []string{"replaced-secret", "deleted-access-secret"} {
+		if err := fakeClient.Create(ctx, secret(name)); err != nil {
+			t.Fatalf("Create(%s) error = %v", name, err)
+		}
+	}
+	for _, pod := range []*corev1.Pod{envPod, volumePod} {
+		if err := fakeClient.Delete(ctx, pod); err != nil {
+			t.Fatalf("Delete(%s) error = %v", pod.Name, err)
+		}
+	}
+	usePod := func(name, secretName string) {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-ns"},
+			Spec: corev1.PodSpec{
+				Volumes: []corev1.Volume{{
+					Name:         "creds",
+					VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: secretName}},
+				}},
+				Containers: []corev1.Container{{Name: "app"}},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+		if err := fakeClient.Create(ctx, pod); err != nil {
+			t.Fatalf("Create(%s) error = %v", name, err)
+		}
+	}
+	usePod("replaced-consumer", "replaced-secret")
+	usePod("deleted-access-consumer", "deleted-access-secret")
+	usePod("in-use-consumer", "in-use-secret")
+
+	if err := p.Cleanup(WithSecretReplaced(ctx), provider, access("replaced-secret", nil)); err != nil {
+		t.Fatalf("Cleanup() of a replaced secret error = %v", err)
+	}
+	if exists("replaced-secret") {
+		t.Error("secret replaced by another provisioner was not deleted")
+	}
+	deleting := access("deleted-access-secret", nil)
+	now := metav1.Now()
+	deleting.DeletionTimestamp = &now
+	if err := p.Cleanup(ctx, provider, deleting); err != nil {
+		t.Fatalf("Cleanup() for a deleted access error = %v", err)
+	}
+	if exists("deleted-access-secret") {
+		t.Error("secret of a deleted access was not deleted")
+	}
+
+	if err := p.Cleanup(ctx, provider, access("in-use-secret", map[string]string{ForceCleanupAnnotation(): "true"})); err != nil {
+		t.Fatalf("forced Cleanup() error = %v", err)
+	}
+	if exists("in-use-secret") {
+		t.Error("forced cleanup did not delete the in-use secret")
+	}
+}
+
 func TestApiKeyProvisioner_HealthCheck(t *testing.T) {
 	scheme := runtime.NewScheme()
 	_ = llmwardenv1alpha1.AddToScheme(scheme)
@@ -454,6 +1601,7 @@ func TestApiKeyProvisioner_HealthCheck(t *testing.T) {
 		targetSecret *corev1.Secret
 		sourceSecret *corev1.Secret
 		wantHealthy  bool
+		wantDrifted  bool
 		wantMessage  string
 	}{
 		{
@@ -501,6 +1649,57 @@ func TestApiKeyProvisioner_HealthCheck(t *testing.T) {
 			wantHealthy:  false,
 			wantMessage:  "API key not found in secret",
 		},
+		{
+			name: "drifted when target edited since last provision",
+			targetSecret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "health-secret",
+					Namespace:   "test-ns",
+					Annotations: map[string]string{SourceVersionAnnotation(): "42"},
+				},
+				Data: map[string][]byte{
+					"apiKey": []byte("sk-tampered"),
+				},
+			},
+			sourceSecret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "source-secret",
+					Namespace:       "provider-ns",
+					ResourceVersion: "42",
+				},
+				Data: map[string][]byte{
+					"api-key": []byte("sk-source-key"),
+				},
+			},
+			wantHealthy: false,
+			wantDrifted: true,
+			wantMessage: "API key drifted from source secret",
+		},
+		{
+			name: "not drifted when source changed since last provision",
+			targetSecret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "health-secret",
+					Namespace:   "test-ns",
+					Annotations: map[string]string{SourceVersionAnnotation(): "41"},
+				},
+				Data: map[string][]byte{
+					"apiKey": []byte("sk-old-source-key"),
+				},
+			},
+			sourceSecret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "source-secret",
+					Namespace:       "provider-ns",
+					ResourceVersion: "42",
+				},
+				Data: map[string][]byte{
+					"api-key": []byte("sk-source-key"),
+				},
+			},
+			wantHealthy: true,
+			wantMessage: "Secret exists and contains valid API key",
+		},
 	}
 
 	for _, tt := range tests {
@@ -572,6 +1771,10 @@ func TestApiKeyProvisioner_HealthCheck(t *testing.T) {
 				t.Errorf("HealthCheck() Healthy = %v, want %v", result.Healthy, tt.wantHealthy)
 			}
 
+			if result.Drifted != tt.wantDrifted {
+				t.Errorf("HealthCheck() Drifted = %v, want %v", result.Drifted, tt.wantDrifted)
+			}
+
 			if result.Message != tt.wantMessage {
 				t.Errorf("HealthCheck() Message = %v, want %v", result.Message, tt.wantMessage)
 			}
@@ -582,3 +1785,139 @@ func TestApiKeyProvisioner_HealthCheck(t *testing.T) {
 		})
 	}
 }
+
+func TestApiKeyProvisioner_SecretWriteConflict(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	ctx := context.Background()
+
+	sourceSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "source-secret", Namespace: "provider-ns"},
+		Data:       map[string][]byte{"api-key": []byte("sk-source-key")},
+	}
+	existingTarget := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "target-secret", Namespace: "conflict-ns"},
+		Data:       map[string][]byte{"apiKey": []byte("sk-stale-key")},
+	}
+
+	// Fail the first update as if another writer got there first.
+	conflicts := 0
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRuntimeObjects(sourceSecret, existingTarget).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+				if _, ok := obj.(*corev1.Secret); ok && conflicts == 0 {
+					conflicts++
+					return apierrors.NewConflict(corev1.Resource("secrets"), obj.GetName(), errors.New("object was modified"))
+				}
+				return c.Update(ctx, obj, opts...)
+			},
+		}).
+		Build()
+
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "conflict-provider"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderOpenAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef: llmwardenv1alpha1.SecretReference{Name: "source-secret", Namespace: "provider-ns", Key: "api-key"},
+				},
+			},
+		},
+	}
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-access", Namespace: "conflict-ns"},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			SecretName:  "target-secret",
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "conflict-provider"},
+		},
+	}
+
+	counter := metrics.SecretWriteConflicts.WithLabelValues("conflict-provider", "conflict-ns")
+	before := testutil.ToFloat64(counter)
+
+	p := NewApiKeyProvisioner(fakeClient, scheme)
+	if _, err := p.Provision(ctx, provider, access); err != nil {
+		t.Fatalf("Provision() error = %v, want the conflict to be retried", err)
+	}
+
+	if got := testutil.ToFloat64(counter) - before; got != 1 {
+		t.Errorf("SecretWriteConflicts increased by %v, want 1", got)
+	}
+
+	target := &corev1.Secret{}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Name: "target-secret", Namespace: "conflict-ns"}, target); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got := string(target.Data["apiKey"]); got != "sk-source-key" {
+		t.Errorf("apiKey = %q, want the retried write to land", got)
+	}
+}
+
+func TestApiKeyProvisioner_HealthCheckDeprecatedProvider(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "health-secret", Namespace: "test-ns"},
+				Data:       map[string][]byte{"apiKey": []byte("sk-healthy-key")},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "source-secret", Namespace: "provider-ns"},
+				Data:       map[string][]byte{"api-key": []byte("sk-healthy-key")},
+			},
+		).
+		Build()
+	provisioner := NewApiKeyProvisioner(fakeClient, scheme)
+
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-provider"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderOpenAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef: llmwardenv1alpha1.SecretReference{Name: "source-secret", Namespace: "provider-ns", Key: "api-key"},
+				},
+			},
+			DeprecationMessage: "use test-provider-v2",
+		},
+	}
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-access", Namespace: "test-ns"},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			SecretName:  "health-secret",
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "test-provider"},
+		},
+	}
+
+	result, err := provisioner.HealthCheck(context.Background(), provider, access)
+	if err != nil {
+		t.Fatalf("HealthCheck() error = %v", err)
+	}
+	// Deprecation is advisory: the access stays healthy but carries the warning.
+	if !result.Healthy {
+		t.Errorf("Healthy = false (%s), want true", result.Message)
+	}
+	if !slices.ContainsFunc(result.Warnings, func(w string) bool { return strings.Contains(w, "use test-provider-v2") }) {
+		t.Errorf("Warnings = %v, want the provider's deprecation message", result.Warnings)
+	}
+
+	provider.Spec.DeprecationMessage = ""
+	result, err = provisioner.HealthCheck(context.Background(), provider, access)
+	if err != nil {
+		t.Fatalf("HealthCheck() error = %v", err)
+	}
+	if len(result.Warnings) != 0 {
+		t.Errorf("Warnings = %v for a provider that is no longer deprecated, want none", result.Warnings)
+	}
+}
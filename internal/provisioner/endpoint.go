@@ -0,0 +1,84 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provisioner
+
+import (
+	"fmt"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+)
+
+// EffectiveBaseURL returns the base URL written to access secrets as baseUrl. An explicit
+// spec.endpoint.baseURL wins; otherwise azure-openai and aws-bedrock derive it from their
+// regional template. An empty result means the provider's default endpoint is used.
+//
+// A spec.endpoint without baseURL asks for a derived URL, so missing template inputs are
+// an error rather than a silent fallback to the default endpoint.
+func EffectiveBaseURL(provider *llmwardenv1alpha1.LLMProvider) (string, error) {
+	endpoint := provider.Spec.Endpoint
+	if endpoint != nil && endpoint.BaseURL != "" {
+		return endpoint.BaseURL, nil
+	}
+
+	switch provider.Spec.Provider {
+	case llmwardenv1alpha1.ProviderAzureOpenAI:
+		if endpoint == nil {
+			return "", nil
+		}
+		if endpoint.ResourceName == "" {
+			return "", fmt.Errorf("spec.endpoint.resourceName is required to derive the %s base URL", provider.Spec.Provider)
+		}
+		return fmt.Sprintf("https://%s.openai.azure.com", endpoint.ResourceName), nil
+
+	case llmwardenv1alpha1.ProviderAWSBedrock:
+		region := ""
+		if endpoint != nil {
+			region = endpoint.Region
+		}
+		if region == "" {
+			if wi := provider.Spec.Auth.WorkloadIdentity; wi != nil && wi.AWS != nil {
+				region = wi.AWS.Region
+			}
+		}
+		if region == "" {
+			if endpoint == nil {
+				return "", nil
+			}
+			return "", fmt.Errorf("spec.endpoint.region or spec.auth.workloadIdentity.aws.region is required to derive the %s base URL",
+				provider.Spec.Provider)
+		}
+		return fmt.Sprintf("https://bedrock-runtime.%s.amazonaws.com", region), nil
+
+	default:
+		if endpoint != nil && (endpoint.Region != "" || endpoint.ResourceName != "") {
+			return "", fmt.Errorf("provider %s has no regional endpoint template; set spec.endpoint.baseURL instead",
+				provider.Spec.Provider)
+		}
+		return "", nil
+	}
+}
+
+// CACertKey is the key access secrets carry the provider's endpoint CA bundle under.
+const CACertKey = "caCert"
+
+// ProvisionsCACert reports whether access secrets for the provider carry its endpoint CA
+// bundle under CACertKey. Only the apiKey provisioner copies it; with other auth types the
+// secret's contents come from elsewhere.
+func ProvisionsCACert(provider *llmwardenv1alpha1.LLMProvider) bool {
+	return provider.Spec.Auth.Type == llmwardenv1alpha1.AuthTypeAPIKey &&
+		provider.Spec.Endpoint != nil && provider.Spec.Endpoint.CASecretRef != nil
+}
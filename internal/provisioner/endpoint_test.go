@@ -0,0 +1,116 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provisioner
+
+import (
+	"strings"
+	"testing"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+)
+
+func TestEffectiveBaseURL(t *testing.T) {
+	awsIdentity := &llmwardenv1alpha1.WorkloadIdentityAuth{
+		AWS: &llmwardenv1alpha1.AWSWorkloadIdentity{
+			RoleArn: "arn:aws:iam::111111111111:role/bedrock",
+			Region:  "eu-west-1",
+		},
+	}
+
+	tests := []struct {
+		name         string
+		providerType llmwardenv1alpha1.ProviderType
+		endpoint     *llmwardenv1alpha1.EndpointConfig
+		identity     *llmwardenv1alpha1.WorkloadIdentityAuth
+		want         string
+		wantErr      string
+	}{
+		{
+			name:         "explicit baseURL wins over the template",
+			providerType: llmwardenv1alpha1.ProviderAzureOpenAI,
+			endpoint:     &llmwardenv1alpha1.EndpointConfig{BaseURL: "https://proxy.internal", ResourceName: "contoso"},
+			want:         "https://proxy.internal",
+		},
+		{
+			name:         "azure derives the URL from the resource name",
+			providerType: llmwardenv1alpha1.ProviderAzureOpenAI,
+			endpoint:     &llmwardenv1alpha1.EndpointConfig{ResourceName: "contoso"},
+			want:         "https://contoso.openai.azure.com",
+		},
+		{
+			name:         "azure without a resource name is an error",
+			providerType: llmwardenv1alpha1.ProviderAzureOpenAI,
+			endpoint:     &llmwardenv1alpha1.EndpointConfig{Region: "eastus"},
+			wantErr:      "spec.endpoint.resourceName is required",
+		},
+		{
+			name:         "bedrock derives the URL from the endpoint region",
+			providerType: llmwardenv1alpha1.ProviderAWSBedrock,
+			endpoint:     &llmwardenv1alpha1.EndpointConfig{Region: "us-east-1"},
+			identity:     awsIdentity,
+			want:         "https://bedrock-runtime.us-east-1.amazonaws.com",
+		},
+		{
+			name:         "bedrock falls back to the workload identity region",
+			providerType: llmwardenv1alpha1.ProviderAWSBedrock,
+			identity:     awsIdentity,
+			want:         "https://bedrock-runtime.eu-west-1.amazonaws.com",
+		},
+		{
+			name:         "bedrock endpoint without any region is an error",
+			providerType: llmwardenv1alpha1.ProviderAWSBedrock,
+			endpoint:     &llmwardenv1alpha1.EndpointConfig{},
+			wantErr:      "spec.endpoint.region or spec.auth.workloadIdentity.aws.region is required",
+		},
+		{
+			name:         "no endpoint and no template input uses the provider default",
+			providerType: llmwardenv1alpha1.ProviderAzureOpenAI,
+		},
+		{
+			name:         "template inputs on a provider without a template are an error",
+			providerType: llmwardenv1alpha1.ProviderOpenAI,
+			endpoint:     &llmwardenv1alpha1.EndpointConfig{Region: "us-east-1"},
+			wantErr:      "has no regional endpoint template",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := &llmwardenv1alpha1.LLMProvider{
+				Spec: llmwardenv1alpha1.LLMProviderSpec{
+					Provider: tt.providerType,
+					Auth:     llmwardenv1alpha1.AuthConfig{WorkloadIdentity: tt.identity},
+					Endpoint: tt.endpoint,
+				},
+			}
+
+			got, err := EffectiveBaseURL(provider)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("EffectiveBaseURL() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EffectiveBaseURL() unexpected error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("EffectiveBaseURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -18,19 +18,23 @@ package provisioner
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"maps"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
 
 	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
 	"github.com/llmwarden/llmwarden/internal/eso"
+	"github.com/llmwarden/llmwarden/internal/labelkeys"
 )
 
 // ExternalSecretProvisioner implements the Provisioner interface for ESO-based authentication.
@@ -44,8 +48,18 @@ type ExternalSecretProvisioner struct {
 	client  client.Client
 	scheme  *runtime.Scheme
 	adapter eso.Adapter
+
+	// DryRunValidation makes Provision validate a new ExternalSecret with a server-side
+	// dry-run create before creating it, so schema and admission errors (such as ESO's
+	// validating webhook rejecting the spec) are reported as ErrExternalSecretRejected.
+	DryRunValidation bool
 }
 
+// ErrExternalSecretRejected is returned by Provision when the apiserver rejects the dry-run
+// create of a new ExternalSecret. It deliberately doesn't wrap the apiserver's error, whose
+// status code (often 403 for webhook denials) says nothing about llmwarden's permissions.
+var ErrExternalSecretRejected = errors.New("ExternalSecret rejected by dry-run")
+
 // NewExternalSecretProvisioner creates a new ExternalSecretProvisioner with the given ESO adapter.
 // Use eso.NewV1Beta1Adapter() for production; inject a test adapter in unit tests.
 func NewExternalSecretProvisioner(k8sClient client.Client, scheme *runtime.Scheme, adapter eso.Adapter) *ExternalSecretProvisioner {
@@ -60,43 +74,11 @@ func NewExternalSecretProvisioner(k8sClient client.Client, scheme *runtime.Schem
 // external store referenced in the LLMProvider's externalSecret config.
 // The ExternalSecret is owned by the LLMAccess resource for automatic garbage collection.
 func (p *ExternalSecretProvisioner) Provision(ctx context.Context, provider *llmwardenv1alpha1.LLMProvider, access *llmwardenv1alpha1.LLMAccess) (*ProvisionResult, error) {
-	if provider.Spec.Auth.ExternalSecret == nil {
-		return nil, fmt.Errorf("provider %s does not have externalSecret configuration", provider.Name)
+	spec, err := p.desiredSpec(provider, access)
+	if err != nil {
+		return nil, err
 	}
-
 	esoConfig := provider.Spec.Auth.ExternalSecret
-
-	// Determine the effective refresh interval:
-	// LLMAccess rotation.interval takes precedence over the provider's refreshInterval.
-	refreshInterval := p.effectiveRefreshInterval(access, esoConfig.RefreshInterval)
-
-	// Build our internal ExternalSecret spec from the provider + access config.
-	spec := eso.ExternalSecretSpec{
-		RefreshInterval: refreshInterval,
-		StoreRef: eso.StoreRef{
-			Name: esoConfig.Store.Name,
-			Kind: string(esoConfig.Store.Kind),
-		},
-		// The target secret name is driven by what LLMAccess declared it wants.
-		Target: eso.ExternalSecretTarget{
-			Name: access.Spec.SecretName,
-			// "Owner" means the ExternalSecret owns the resulting Secret.
-			// The Secret is deleted when the ExternalSecret is deleted.
-			CreationPolicy: eso.SecretCreationPolicyOwner,
-		},
-		Data: []eso.ExternalSecretData{
-			{
-				// We expose the credential under the standard "apiKey" key so the
-				// rest of the injection pipeline (webhook env var mapping) remains uniform.
-				SecretKey: "apiKey",
-				RemoteRef: eso.RemoteRef{
-					Key:      esoConfig.RemoteRef.Key,
-					Property: esoConfig.RemoteRef.Property,
-				},
-			},
-		},
-	}
-
 	labels := p.standardLabels(provider, access)
 
 	// ExternalSecret name matches the target secret name so it's easy to find.
@@ -108,7 +90,18 @@ func (p *ExternalSecretProvisioner) Provision(ctx context.Context, provider *llm
 	existing.SetNamespace(access.Namespace)
 	existing.SetName(esName)
 
-	_, err := controllerutil.CreateOrUpdate(ctx, p.client, existing, func() error {
+	// A changed refreshInterval (e.g. the access's rotation.interval was edited) updates
+	// the existing ExternalSecret in place rather than recreating it, so ESO keeps its
+	// sync state and the owner reference and target secret stay as they are.
+	var previousRefreshInterval string
+	_, err = controllerutil.CreateOrUpdate(ctx, p.client, existing, func() error {
+		if existing.GetResourceVersion() != "" {
+			current, _, _ := unstructured.NestedString(existing.Object, "spec", "refreshInterval")
+			if current != spec.RefreshInterval {
+				previousRefreshInterval = current
+			}
+		}
+
 		// Build the desired spec from our adapter.
 		desired := p.adapter.Build(access.Namespace, esName, labels, spec)
 
@@ -127,7 +120,18 @@ func (p *ExternalSecretProvisioner) Provision(ctx context.Context, provider *llm
 		// Set owner reference so the ExternalSecret is garbage-collected when
 		// the LLMAccess is deleted, and changes to the ExternalSecret trigger
 		// reconciliation of the owning LLMAccess.
-		return controllerutil.SetControllerReference(access, existing, p.scheme)
+		if err := controllerutil.SetControllerReference(access, existing, p.scheme); err != nil {
+			return err
+		}
+
+		// Let the apiserver and ESO's admission webhooks judge a new ExternalSecret
+		// before it is created for real.
+		if p.DryRunValidation && existing.GetResourceVersion() == "" {
+			if err := p.client.Create(ctx, existing.DeepCopy(), client.DryRunAll); err != nil {
+				return fmt.Errorf("%w: %s", ErrExternalSecretRejected, err.Error())
+			}
+		}
+		return nil
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create/update ExternalSecret %s/%s: %w", access.Namespace, esName, err)
@@ -140,24 +144,127 @@ func (p *ExternalSecretProvisioner) Provision(ctx context.Context, provider *llm
 		SecretName:      access.Spec.SecretName,
 		SecretNamespace: access.Namespace,
 		// The actual keys in the resulting Secret depend on ESO syncing.
-		// We report "apiKey" as the expected key per our spec.
-		SecretKeys:    []string{"apiKey"},
+		// We report the credential key as the expected key per our spec.
+		SecretKeys:    []string{CredentialKey(provider)},
 		ProvisionedAt: time.Now(),
 		// ESO manages refresh via refreshInterval; we don't need additional rotation.
-		NeedsRotation: false,
+		NeedsRotation:           false,
+		PreviousRefreshInterval: previousRefreshInterval,
+		// Until ESO reports Ready the target secret may be missing or stale.
+		Pending:        !syncStatus.Ready,
+		PendingMessage: syncStatus.Message,
+		Source:         p.storeSource(esoConfig, access.Namespace),
 		Metadata: map[string]string{
 			"provider":        provider.Name,
 			"providerType":    string(provider.Spec.Provider),
 			"authType":        string(provider.Spec.Auth.Type),
 			"store":           esoConfig.Store.Name,
 			"storeKind":       string(esoConfig.Store.Kind),
-			"refreshInterval": refreshInterval,
+			"refreshInterval": spec.RefreshInterval,
 			"syncReady":       fmt.Sprintf("%v", syncStatus.Ready),
 			"syncMessage":     syncStatus.Message,
 		},
 	}, nil
 }
 
+// desiredSpec builds the ExternalSecret spec for the access from the provider's
+// externalSecret config.
+func (p *ExternalSecretProvisioner) desiredSpec(provider *llmwardenv1alpha1.LLMProvider, access *llmwardenv1alpha1.LLMAccess) (eso.ExternalSecretSpec, error) {
+	if provider.Spec.Auth.ExternalSecret == nil {
+		return eso.ExternalSecretSpec{}, fmt.Errorf("provider %s does not have externalSecret configuration", provider.Name)
+	}
+
+	esoConfig := provider.Spec.Auth.ExternalSecret
+
+	// Determine the effective refresh interval:
+	// LLMAccess rotation.interval takes precedence over the provider's refreshInterval.
+	refreshInterval := p.effectiveRefreshInterval(access, esoConfig.RefreshInterval)
+
+	// Build our internal ExternalSecret spec from the provider + access config.
+	spec := eso.ExternalSecretSpec{
+		RefreshInterval: refreshInterval,
+		StoreRef: eso.StoreRef{
+			Name: esoConfig.Store.Name,
+			Kind: string(esoConfig.Store.Kind),
+		},
+		// The target secret name is driven by what LLMAccess declared it wants.
+		Target: eso.ExternalSecretTarget{
+			Name: access.Spec.SecretName,
+			// "Owner" means the ExternalSecret owns the resulting Secret.
+			// The Secret is deleted when the ExternalSecret is deleted.
+			CreationPolicy: eso.SecretCreationPolicyOwner,
+		},
+		Data: []eso.ExternalSecretData{
+			{
+				// We expose the credential under the provider's credential key so the
+				// rest of the injection pipeline (webhook env var mapping) remains uniform.
+				SecretKey: CredentialKey(provider),
+				RemoteRef: eso.RemoteRef{
+					Key:      esoConfig.RemoteRef.Key,
+					Property: esoConfig.RemoteRef.Property,
+				},
+			},
+		},
+	}
+
+	// With the Retain reclaim policy the Secret must survive the ExternalSecret, which
+	// is deleted with the access.
+	if RetainSecret(access) {
+		spec.Target.CreationPolicy = eso.SecretCreationPolicyOrphan
+	}
+	if class := encryptionClass(provider, access); class != "" {
+		spec.Target.Annotations = map[string]string{EncryptionClassAnnotation(): class}
+	}
+	propagated := PropagatedLabels(provider)
+	if len(propagated) > 0 {
+		spec.Target.Labels = propagated
+	}
+
+	return spec, nil
+}
+
+// Render returns the ExternalSecret Provision would apply for the access, without
+// applying it. The object carries no owner reference or server-populated fields.
+func (p *ExternalSecretProvisioner) Render(provider *llmwardenv1alpha1.LLMProvider, access *llmwardenv1alpha1.LLMAccess) (*unstructured.Unstructured, error) {
+	spec, err := p.desiredSpec(provider, access)
+	if err != nil {
+		return nil, err
+	}
+	return p.adapter.Build(access.Namespace, access.Spec.SecretName, p.standardLabels(provider, access), spec), nil
+}
+
+// RenderYAML returns Render's ExternalSecret as YAML, for diffing against what ESO expects.
+func (p *ExternalSecretProvisioner) RenderYAML(provider *llmwardenv1alpha1.LLMProvider, access *llmwardenv1alpha1.LLMAccess) ([]byte, error) {
+	obj, err := p.Render(provider, access)
+	if err != nil {
+		return nil, err
+	}
+	out, err := yaml.Marshal(obj.Object)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling ExternalSecret %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+	}
+	return out, nil
+}
+
+// storeSource references the store the ExternalSecret reads from. A SecretStore lives in
+// the ExternalSecret's namespace; a ClusterSecretStore is cluster-scoped.
+func (p *ExternalSecretProvisioner) storeSource(esoConfig *llmwardenv1alpha1.ExternalSecretAuth, namespace string) *corev1.ObjectReference {
+	fieldPath := fmt.Sprintf("remoteRef[%s]", esoConfig.RemoteRef.Key)
+	if esoConfig.RemoteRef.Property != "" {
+		fieldPath += "." + esoConfig.RemoteRef.Property
+	}
+	ref := &corev1.ObjectReference{
+		APIVersion: p.adapter.GVK().GroupVersion().String(),
+		Kind:       string(esoConfig.Store.Kind),
+		Name:       esoConfig.Store.Name,
+		FieldPath:  fieldPath,
+	}
+	if esoConfig.Store.Kind != llmwardenv1alpha1.SecretStoreKindClusterSecretStore {
+		ref.Namespace = namespace
+	}
+	return ref
+}
+
 // Cleanup deletes the ESO ExternalSecret created for the LLMAccess.
 // The resulting Kubernetes Secret will also be deleted because the ExternalSecret
 // uses CreationPolicy=Owner.
@@ -179,13 +286,17 @@ func (p *ExternalSecretProvisioner) Cleanup(ctx context.Context, _ *llmwardenv1a
 	return nil
 }
 
-// HealthCheck reports whether the ESO ExternalSecret exists and has successfully synced.
-// ESO reports sync status via status conditions on the ExternalSecret resource.
-func (p *ExternalSecretProvisioner) HealthCheck(ctx context.Context, _ *llmwardenv1alpha1.LLMProvider, access *llmwardenv1alpha1.LLMAccess) (*HealthCheckResult, error) {
+// HealthCheck reports whether the ESO ExternalSecret exists and has successfully synced,
+// and whether the synced secret holds the credential key. ESO reports sync status via
+// status conditions on the ExternalSecret resource.
+func (p *ExternalSecretProvisioner) HealthCheck(ctx context.Context, provider *llmwardenv1alpha1.LLMProvider, access *llmwardenv1alpha1.LLMAccess) (*HealthCheckResult, error) {
 	result := &HealthCheckResult{
 		LastChecked: time.Now(),
 		Metadata:    make(map[string]string),
 	}
+	if warning := DeprecationWarning(provider, result.LastChecked); warning != "" {
+		result.Warnings = append(result.Warnings, warning)
+	}
 
 	esObj := &unstructured.Unstructured{}
 	esObj.SetGroupVersionKind(p.adapter.GVK())
@@ -211,11 +322,46 @@ func (p *ExternalSecretProvisioner) HealthCheck(ctx context.Context, _ *llmwarde
 	if !syncStatus.Ready {
 		result.Warnings = append(result.Warnings,
 			fmt.Sprintf("ExternalSecret not yet synced by ESO: %s", syncStatus.Message))
+		return result, nil
 	}
 
+	// ESO reports Ready whenever the remoteRef resolved, even if it resolved to something
+	// without the key we template, so check the synced secret itself.
+	missing, err := p.missingSecretKeys(ctx, access.Namespace, access.Spec.SecretName, CredentialKey(provider))
+	if err != nil {
+		return nil, err
+	}
+	if len(missing) > 0 {
+		result.Healthy = false
+		result.Reason = ReasonSyncedButKeyMissing
+		result.Message = fmt.Sprintf("ExternalSecret %s is synced but secret %s/%s is missing keys %v; check the provider's remoteRef",
+			access.Spec.SecretName, access.Namespace, access.Spec.SecretName, missing)
+	}
 	return result, nil
 }
 
+// ReasonSyncedButKeyMissing is the HealthCheckResult reason for an ExternalSecret that ESO
+// reports synced while the resulting secret lacks an expected key.
+const ReasonSyncedButKeyMissing = "SyncedButKeyMissing"
+
+// missingSecretKeys returns the keys absent or empty in the named secret. A missing secret
+// is missing every key.
+func (p *ExternalSecretProvisioner) missingSecretKeys(ctx context.Context, namespace, name string, keys ...string) ([]string, error) {
+	secret := &corev1.Secret{}
+	if err := p.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, secret); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get synced secret %s/%s: %w", namespace, name, err)
+		}
+	}
+	var missing []string
+	for _, key := range keys {
+		if len(secret.Data[key]) == 0 {
+			missing = append(missing, key)
+		}
+	}
+	return missing, nil
+}
+
 // effectiveRefreshInterval returns the refresh interval to use for the ExternalSecret.
 // LLMAccess.spec.rotation.interval takes precedence over the provider's refreshInterval.
 // This is the "rotation policy passthrough" — we translate our rotation config into
@@ -230,12 +376,13 @@ func (p *ExternalSecretProvisioner) effectiveRefreshInterval(access *llmwardenv1
 	return "1h" // ESO default
 }
 
-// standardLabels returns the set of labels applied to all ExternalSecrets managed by llmwarden.
+// standardLabels returns the set of labels applied to all ExternalSecrets managed by llmwarden,
+// including the provider's propagated labels.
 func (p *ExternalSecretProvisioner) standardLabels(provider *llmwardenv1alpha1.LLMProvider, access *llmwardenv1alpha1.LLMAccess) map[string]string {
-	return map[string]string{
-		"llmwarden.io/managed-by": "llmwarden",
-		"llmwarden.io/provider":   provider.Name,
-		"llmwarden.io/access":     access.Name,
-		"llmwarden.io/auth-type":  string(provider.Spec.Auth.Type),
-	}
+	labels := PropagatedLabels(provider)
+	labels[labelkeys.ManagedBy()] = labelkeys.ManagedByValue
+	labels[labelkeys.Provider()] = provider.Name
+	labels[labelkeys.Access()] = access.Name
+	labels[labelkeys.AuthType()] = string(provider.Spec.Auth.Type)
+	return labels
 }
@@ -20,11 +20,15 @@ import (
 	"context"
 	"testing"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
 
 	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
 	"github.com/llmwarden/llmwarden/internal/eso"
@@ -82,10 +86,11 @@ func testAccess(namespace, secretName, rotationInterval string) *llmwardenv1alph
 	return access
 }
 
-// newTestScheme builds a scheme with llmwarden types registered (core types not needed for ES tests).
+// newTestScheme builds a scheme with llmwarden and core types registered.
 func newTestScheme() *runtime.Scheme {
 	s := runtime.NewScheme()
 	_ = llmwardenv1alpha1.AddToScheme(s)
+	_ = corev1.AddToScheme(s)
 	return s
 }
 
@@ -100,6 +105,7 @@ func TestExternalSecretProvisioner_Provision(t *testing.T) {
 		wantStoreRef        map[string]string
 		wantRemoteKey       string
 		wantRemoteProperty  string
+		wantSecretKey       string // "" means the default credential key
 		wantLabels          map[string]string
 	}{
 		{
@@ -168,6 +174,18 @@ func TestExternalSecretProvisioner_Provision(t *testing.T) {
 			wantErr:             false,
 			wantRefreshInterval: "1h", // default
 		},
+		{
+			name: "maps the remote value to the provider's target key",
+			provider: func() *llmwardenv1alpha1.LLMProvider {
+				provider := testProvider("vault-backend", "ClusterSecretStore", "secret/openai", "", "1h")
+				provider.Spec.Auth.TargetKey = "OPENAI_API_KEY"
+				return provider
+			}(),
+			access:        testAccess("test-ns", "openai-creds", ""),
+			wantESName:    "openai-creds",
+			wantRemoteKey: "secret/openai",
+			wantSecretKey: "OPENAI_API_KEY",
+		},
 		{
 			name: "error when externalSecret config is nil",
 			provider: &llmwardenv1alpha1.LLMProvider{
@@ -213,6 +231,29 @@ func TestExternalSecretProvisioner_Provision(t *testing.T) {
 				t.Errorf("result.SecretNamespace = %q, want %q", result.SecretNamespace, tt.access.Namespace)
 			}
 
+			// Verify the source references the store and remote key
+			if result.Source == nil {
+				t.Fatal("result.Source is nil")
+			}
+			esoConfig := tt.provider.Spec.Auth.ExternalSecret
+			if result.Source.Kind != string(esoConfig.Store.Kind) || result.Source.Name != esoConfig.Store.Name {
+				t.Errorf("result.Source = %s/%s, want %s/%s", result.Source.Kind, result.Source.Name, esoConfig.Store.Kind, esoConfig.Store.Name)
+			}
+			wantSourceNamespace := tt.access.Namespace
+			if esoConfig.Store.Kind == llmwardenv1alpha1.SecretStoreKindClusterSecretStore {
+				wantSourceNamespace = ""
+			}
+			if result.Source.Namespace != wantSourceNamespace {
+				t.Errorf("result.Source.Namespace = %q, want %q", result.Source.Namespace, wantSourceNamespace)
+			}
+			wantFieldPath := "remoteRef[" + esoConfig.RemoteRef.Key + "]"
+			if esoConfig.RemoteRef.Property != "" {
+				wantFieldPath += "." + esoConfig.RemoteRef.Property
+			}
+			if result.Source.FieldPath != wantFieldPath {
+				t.Errorf("result.Source.FieldPath = %q, want %q", result.Source.FieldPath, wantFieldPath)
+			}
+
 			// Verify ExternalSecret was created in the fake client
 			esName := tt.access.Spec.SecretName
 			if tt.wantESName != "" {
@@ -269,6 +310,16 @@ func TestExternalSecretProvisioner_Provision(t *testing.T) {
 				if gotKey, _ := remoteRef["key"].(string); gotKey != tt.wantRemoteKey {
 					t.Errorf("spec.data[0].remoteRef.key = %q, want %q", gotKey, tt.wantRemoteKey)
 				}
+				wantSecretKey := tt.wantSecretKey
+				if wantSecretKey == "" {
+					wantSecretKey = DefaultCredentialKey
+				}
+				if gotSecretKey, _ := firstData["secretKey"].(string); gotSecretKey != wantSecretKey {
+					t.Errorf("spec.data[0].secretKey = %q, want %q", gotSecretKey, wantSecretKey)
+				}
+				if len(result.SecretKeys) != 1 || result.SecretKeys[0] != wantSecretKey {
+					t.Errorf("SecretKeys = %v, want [%s]", result.SecretKeys, wantSecretKey)
+				}
 				if tt.wantRemoteProperty != "" {
 					gotProp, _ := remoteRef["property"].(string)
 					if gotProp != tt.wantRemoteProperty {
@@ -288,6 +339,52 @@ func TestExternalSecretProvisioner_Provision(t *testing.T) {
 	}
 }
 
+func TestExternalSecretProvisioner_ProvisionPropagatedLabels(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme()
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	adapter := eso.NewV1Beta1Adapter()
+	p := NewExternalSecretProvisioner(fakeClient, scheme, adapter)
+
+	provider := testProvider("vault", "ClusterSecretStore", "secret/openai", "key", "1h")
+	provider.Spec.PropagatedLabels = map[string]string{
+		"team":                  "ml-platform",
+		"cost-center":           "cc-42",
+		"llmwarden.io/provider": "spoofed",
+	}
+	access := testAccess("test-ns", "openai-creds", "")
+
+	if _, err := p.Provision(ctx, provider, access); err != nil {
+		t.Fatalf("Provision() error = %v", err)
+	}
+
+	es := &unstructured.Unstructured{}
+	es.SetGroupVersionKind(adapter.GVK())
+	if err := fakeClient.Get(ctx, types.NamespacedName{Namespace: "test-ns", Name: "openai-creds"}, es); err != nil {
+		t.Fatalf("ExternalSecret not found: %v", err)
+	}
+
+	wantLabels := map[string]string{
+		"team":                  "ml-platform",
+		"cost-center":           "cc-42",
+		"llmwarden.io/provider": "test-provider",
+	}
+	for k, want := range wantLabels {
+		if got := es.GetLabels()[k]; got != want {
+			t.Errorf("ExternalSecret label %s = %q, want %q", k, got, want)
+		}
+	}
+
+	// The target secret is created by ESO, so the labels reach it through the template.
+	targetLabels, _, _ := unstructured.NestedStringMap(es.Object, "spec", "target", "template", "metadata", "labels")
+	if targetLabels["team"] != "ml-platform" || targetLabels["cost-center"] != "cc-42" {
+		t.Errorf("spec.target.template.metadata.labels = %v, want the propagated labels", targetLabels)
+	}
+	if _, ok := targetLabels["llmwarden.io/provider"]; ok {
+		t.Errorf("spec.target.template.metadata.labels = %v, want reserved keys dropped", targetLabels)
+	}
+}
+
 func TestExternalSecretProvisioner_Provision_Idempotent(t *testing.T) {
 	ctx := context.Background()
 	scheme := newTestScheme()
@@ -317,6 +414,152 @@ func TestExternalSecretProvisioner_Provision_Idempotent(t *testing.T) {
 	// Note: List on unstructured requires the GVK to be list kind — skip count check in fake
 }
 
+func TestExternalSecretProvisioner_ProvisionUpdatesRefreshIntervalInPlace(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme()
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	adapter := eso.NewV1Beta1Adapter()
+	p := NewExternalSecretProvisioner(fakeClient, scheme, adapter)
+
+	provider := testProvider("vault", "ClusterSecretStore", "secret/openai", "key", "1h")
+	access := testAccess("test-ns", "openai-creds", "2h")
+	key := types.NamespacedName{Namespace: "test-ns", Name: "openai-creds"}
+
+	result, err := p.Provision(ctx, provider, access)
+	if err != nil {
+		t.Fatalf("Provision() error = %v", err)
+	}
+	if result.PreviousRefreshInterval != "" {
+		t.Errorf("PreviousRefreshInterval = %q on creation, want empty", result.PreviousRefreshInterval)
+	}
+
+	// Simulate ESO having synced the ExternalSecret.
+	es := &unstructured.Unstructured{}
+	es.SetGroupVersionKind(adapter.GVK())
+	if err := fakeClient.Get(ctx, key, es); err != nil {
+		t.Fatalf("ExternalSecret not found: %v", err)
+	}
+	conditions := []any{map[string]any{"type": "Ready", "status": "True", "reason": "SecretSynced"}}
+	if err := unstructured.SetNestedSlice(es.Object, conditions, "status", "conditions"); err != nil {
+		t.Fatalf("SetNestedSlice() error = %v", err)
+	}
+	if err := fakeClient.Update(ctx, es); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	originalUID := es.GetUID()
+
+	access.Spec.Rotation.Interval = "30m"
+	result, err = p.Provision(ctx, provider, access)
+	if err != nil {
+		t.Fatalf("Provision() after interval change error = %v", err)
+	}
+	if result.PreviousRefreshInterval != "2h" || result.Metadata["refreshInterval"] != "30m" {
+		t.Errorf("PreviousRefreshInterval = %q, refreshInterval = %q, want 2h -> 30m",
+			result.PreviousRefreshInterval, result.Metadata["refreshInterval"])
+	}
+	if result.Pending {
+		t.Errorf("Pending = true, want ESO's sync state preserved: %s", result.PendingMessage)
+	}
+
+	updated := &unstructured.Unstructured{}
+	updated.SetGroupVersionKind(adapter.GVK())
+	if err := fakeClient.Get(ctx, key, updated); err != nil {
+		t.Fatalf("ExternalSecret not found after update: %v", err)
+	}
+	if got, _, _ := unstructured.NestedString(updated.Object, "spec", "refreshInterval"); got != "30m" {
+		t.Errorf("spec.refreshInterval = %q, want 30m", got)
+	}
+	if updated.GetUID() != originalUID {
+		t.Errorf("UID = %q, want %q: the ExternalSecret was recreated", updated.GetUID(), originalUID)
+	}
+	if owners := updated.GetOwnerReferences(); len(owners) != 1 || owners[0].UID != access.UID {
+		t.Errorf("ownerReferences = %v, want the access as sole owner", owners)
+	}
+	if got, _, _ := unstructured.NestedSlice(updated.Object, "status", "conditions"); len(got) != 1 {
+		t.Errorf("status.conditions = %v, want ESO's sync state preserved", got)
+	}
+
+	// Provisioning again with the same interval reports no change.
+	result, err = p.Provision(ctx, provider, access)
+	if err != nil {
+		t.Fatalf("Provision() error = %v", err)
+	}
+	if result.PreviousRefreshInterval != "" {
+		t.Errorf("PreviousRefreshInterval = %q for an unchanged interval, want empty", result.PreviousRefreshInterval)
+	}
+}
+
+func TestExternalSecretProvisioner_RenderYAML(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme()
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	adapter := eso.NewV1Beta1Adapter()
+	p := NewExternalSecretProvisioner(fakeClient, scheme, adapter)
+
+	provider := testProvider("vault", "ClusterSecretStore", "secret/openai", "key", "1h")
+	access := testAccess("test-ns", "openai-creds", "30m")
+
+	out, err := p.RenderYAML(provider, access)
+	if err != nil {
+		t.Fatalf("RenderYAML() error = %v", err)
+	}
+
+	printed := &unstructured.Unstructured{}
+	if err := yaml.Unmarshal(out, &printed.Object); err != nil {
+		t.Fatalf("printed YAML does not parse: %v\n%s", err, out)
+	}
+	if printed.GroupVersionKind() != adapter.GVK() {
+		t.Errorf("GVK = %v, want %v", printed.GroupVersionKind(), adapter.GVK())
+	}
+	if printed.GetNamespace() != "test-ns" || printed.GetName() != "openai-creds" {
+		t.Errorf("object = %s/%s, want test-ns/openai-creds", printed.GetNamespace(), printed.GetName())
+	}
+	if got, _, _ := unstructured.NestedString(printed.Object, "spec", "refreshInterval"); got != "30m" {
+		t.Errorf("spec.refreshInterval = %q, want the access rotation interval 30m", got)
+	}
+	storeRef, _, _ := unstructured.NestedStringMap(printed.Object, "spec", "secretStoreRef")
+	if storeRef["name"] != "vault" || storeRef["kind"] != "ClusterSecretStore" {
+		t.Errorf("spec.secretStoreRef = %v, want vault/ClusterSecretStore", storeRef)
+	}
+	data, _, _ := unstructured.NestedSlice(printed.Object, "spec", "data")
+	if len(data) != 1 {
+		t.Fatalf("spec.data = %v, want one entry", data)
+	}
+	entry, _ := data[0].(map[string]any)
+	remoteRef, _, _ := unstructured.NestedStringMap(entry, "remoteRef")
+	if entry["secretKey"] != "apiKey" || remoteRef["key"] != "secret/openai" || remoteRef["property"] != "key" {
+		t.Errorf("spec.data[0] = %v, want apiKey from secret/openai property key", entry)
+	}
+
+	// The rendered object is what Provision applies, and rendering applies nothing.
+	es := &unstructured.Unstructured{}
+	es.SetGroupVersionKind(adapter.GVK())
+	err = fakeClient.Get(ctx, types.NamespacedName{Namespace: "test-ns", Name: "openai-creds"}, es)
+	if !apierrors.IsNotFound(err) {
+		t.Fatalf("Get() after RenderYAML error = %v, want NotFound", err)
+	}
+	if _, err := p.Provision(ctx, provider, access); err != nil {
+		t.Fatalf("Provision() error = %v", err)
+	}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Namespace: "test-ns", Name: "openai-creds"}, es); err != nil {
+		t.Fatalf("ExternalSecret not found: %v", err)
+	}
+	if !equality.Semantic.DeepEqual(es.Object["spec"], printed.Object["spec"]) {
+		t.Errorf("applied spec = %v, want the rendered spec %v", es.Object["spec"], printed.Object["spec"])
+	}
+}
+
+func TestExternalSecretProvisioner_RenderRequiresExternalSecretAuth(t *testing.T) {
+	scheme := newTestScheme()
+	p := NewExternalSecretProvisioner(fake.NewClientBuilder().WithScheme(scheme).Build(), scheme, eso.NewV1Beta1Adapter())
+
+	provider := testProvider("vault", "ClusterSecretStore", "secret/openai", "", "")
+	provider.Spec.Auth.ExternalSecret = nil
+	if _, err := p.RenderYAML(provider, testAccess("test-ns", "openai-creds", "")); err == nil {
+		t.Error("RenderYAML() error = nil, want an error for a provider without externalSecret config")
+	}
+}
+
 func TestExternalSecretProvisioner_Cleanup(t *testing.T) {
 	ctx := context.Background()
 	scheme := newTestScheme()
@@ -380,17 +623,42 @@ func TestExternalSecretProvisioner_HealthCheck(t *testing.T) {
 		return es
 	}
 
+	syncedSecret := func(data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "openai-creds"},
+			Data:       data,
+		}
+	}
+
 	tests := []struct {
-		name        string
-		existingES  *unstructured.Unstructured
-		wantHealthy bool
-		wantMessage string
+		name           string
+		existingES     *unstructured.Unstructured
+		existingSecret *corev1.Secret
+		wantHealthy    bool
+		wantMessage    string
+		wantReason     string
 	}{
 		{
-			name:        "healthy when ESO has synced",
+			name:           "healthy when ESO has synced the expected key",
+			existingES:     buildESWithCondition("test-ns", "openai-creds", "True", "Secret synced successfully"),
+			existingSecret: syncedSecret(map[string][]byte{"apiKey": []byte("sk-synced")}),
+			wantHealthy:    true,
+			wantMessage:    "Secret synced successfully",
+		},
+		{
+			name:           "unhealthy when the synced secret lacks the expected key",
+			existingES:     buildESWithCondition("test-ns", "openai-creds", "True", "Secret synced successfully"),
+			existingSecret: syncedSecret(map[string][]byte{"password": []byte("sk-synced")}),
+			wantHealthy:    false,
+			wantMessage:    "ExternalSecret openai-creds is synced but secret test-ns/openai-creds is missing keys [apiKey]; check the provider's remoteRef",
+			wantReason:     ReasonSyncedButKeyMissing,
+		},
+		{
+			name:        "unhealthy when the synced secret doesn't exist",
 			existingES:  buildESWithCondition("test-ns", "openai-creds", "True", "Secret synced successfully"),
-			wantHealthy: true,
-			wantMessage: "Secret synced successfully",
+			wantHealthy: false,
+			wantMessage: "ExternalSecret openai-creds is synced but secret test-ns/openai-creds is missing keys [apiKey]; check the provider's remoteRef",
+			wantReason:  ReasonSyncedButKeyMissing,
 		},
 		{
 			name:        "unhealthy when ESO sync failed",
@@ -415,6 +683,9 @@ func TestExternalSecretProvisioner_HealthCheck(t *testing.T) {
 			if tt.existingES != nil {
 				builder = builder.WithObjects(tt.existingES)
 			}
+			if tt.existingSecret != nil {
+				builder = builder.WithObjects(tt.existingSecret)
+			}
 			fakeClient := builder.Build()
 
 			p := NewExternalSecretProvisioner(fakeClient, scheme, adapter)
@@ -432,6 +703,9 @@ func TestExternalSecretProvisioner_HealthCheck(t *testing.T) {
 			if result.Message != tt.wantMessage {
 				t.Errorf("HealthCheck().Message = %q, want %q", result.Message, tt.wantMessage)
 			}
+			if result.Reason != tt.wantReason {
+				t.Errorf("HealthCheck().Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
 			if result.LastChecked.IsZero() {
 				t.Error("HealthCheck().LastChecked should be set")
 			}
@@ -18,11 +18,90 @@ package provisioner
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
+
 	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/labelkeys"
 )
 
+// DefaultCredentialKey is the key provisioned secrets carry the credential under unless the
+// provider sets spec.auth.targetKey.
+const DefaultCredentialKey = "apiKey"
+
+// CredentialKey returns the key the provider's credential is written under in access secrets.
+func CredentialKey(provider *llmwardenv1alpha1.LLMProvider) string {
+	if provider.Spec.Auth.TargetKey != "" {
+		return provider.Spec.Auth.TargetKey
+	}
+	return DefaultCredentialKey
+}
+
+// CredentialFingerprint identifies a credential without revealing it: "sha256:" followed
+// by the first 16 hex digits of its SHA-256.
+func CredentialFingerprint(credential []byte) string {
+	sum := sha256.Sum256(credential)
+	return "sha256:" + hex.EncodeToString(sum[:8])
+}
+
+// ProducedSecretKeys returns the keys the provider's access secrets can carry, which
+// LLMAccess env mappings may reference: the keys provisioned for its auth type and
+// fallbacks, plus spec.producedSecretKeys. It is empty when none of its auth types
+// provisions a secret (workloadIdentity) and no keys are declared.
+func ProducedSecretKeys(provider *llmwardenv1alpha1.LLMProvider) []string {
+	var keys []string
+	add := func(newKeys ...string) {
+		for _, key := range newKeys {
+			if !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	auth := provider.Spec.Auth
+	credentialKey := CredentialKey(provider)
+	addAuthType := func(authType llmwardenv1alpha1.AuthType, apiKey *llmwardenv1alpha1.APIKeyAuth) {
+		switch authType {
+		case llmwardenv1alpha1.AuthTypeAPIKey:
+			add(credentialKey, "provider")
+			if apiKey != nil && apiKey.NextSecretRef != nil {
+				add(credentialKey + "Next")
+			}
+			if baseURL, err := EffectiveBaseURL(provider); err == nil && baseURL != "" {
+				add("baseUrl")
+			}
+			if provider.Spec.Endpoint != nil && provider.Spec.Endpoint.CASecretRef != nil {
+				add(CACertKey)
+			}
+		case llmwardenv1alpha1.AuthTypeExternalSecret:
+			add(credentialKey)
+		}
+	}
+	addAuthType(auth.Type, auth.APIKey)
+	for _, fallback := range auth.Fallbacks {
+		addAuthType(fallback.Type, fallback.APIKey)
+	}
+	add(provider.Spec.ProducedSecretKeys...)
+	return keys
+}
+
+// PropagatedLabels returns the provider's spec.propagatedLabels without the keys under the
+// label prefix (llmwarden.io/ by default), so they can't override the labels llmwarden
+// tracks resources by.
+func PropagatedLabels(provider *llmwardenv1alpha1.LLMProvider) map[string]string {
+	labels := make(map[string]string, len(provider.Spec.PropagatedLabels))
+	for k, v := range provider.Spec.PropagatedLabels {
+		if !labelkeys.IsReserved(k) {
+			labels[k] = v
+		}
+	}
+	return labels
+}
+
 // Provisioner is the interface for credential provisioning strategies.
 // Different implementations handle different authentication methods:
 // - ApiKeyProvisioner: Copies secrets from provider namespace
@@ -65,6 +144,30 @@ type ProvisionResult struct {
 	// ProvisionedAt is when the credentials were provisioned
 	ProvisionedAt time.Time
 
+	// CredentialHash is the CredentialFingerprint of the credential written to the secret,
+	// and PreviousCredentialHash that of the credential it replaced. Both are empty when
+	// the provisioner does not write the credential itself
+	CredentialHash         string
+	PreviousCredentialHash string
+
+	// EndpointChanged indicates an existing secret's baseUrl was updated because
+	// the provider endpoint changed since it was last provisioned
+	EndpointChanged bool
+
+	// PreviousRefreshInterval is the refreshInterval an existing ExternalSecret had before
+	// Provision updated it in place. Empty when the interval did not change
+	PreviousRefreshInterval string
+
+	// Pending indicates the credential source was configured but the target secret is
+	// not usable yet (e.g. ESO has not synced the ExternalSecret). PendingMessage says why.
+	Pending        bool
+	PendingMessage string
+
+	// Source references where the credential comes from: the provider's source Secret
+	// for apiKey auth, or the SecretStore/ClusterSecretStore for externalSecret auth.
+	// FieldPath identifies the entry within it, e.g. "data[apiKey]" or "remoteRef[openai/prod].key".
+	Source *corev1.ObjectReference
+
 	// Metadata contains provider-specific information
 	Metadata map[string]string
 }
@@ -77,6 +180,14 @@ type HealthCheckResult struct {
 	// Message provides details about the health status
 	Message string
 
+	// Reason is a CamelCase cause for an unhealthy result when one is known, e.g.
+	// ReasonSyncedButKeyMissing
+	Reason string
+
+	// Drifted indicates the provisioned credentials no longer match the desired
+	// state (e.g. the target secret was edited manually) and should be re-provisioned
+	Drifted bool
+
 	// LastChecked is when the health check was performed
 	LastChecked time.Time
 
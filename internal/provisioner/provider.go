@@ -0,0 +1,144 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provisioner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+)
+
+// ErrProviderOutOfNamespace is returned for a NamespacedLLMProvider that references a
+// secret, ConfigMap or secret store outside its own namespace.
+var ErrProviderOutOfNamespace = errors.New("namespaced provider references resources outside its namespace")
+
+// ResolveProvider fetches the provider the access references. A NamespacedLLMProvider is
+// looked up in the access's namespace and returned as an LLMProvider view; it is rejected
+// with ErrProviderOutOfNamespace when it reaches outside that namespace. Errors from the
+// API server are wrapped, so apierrors.IsNotFound still applies.
+func ResolveProvider(ctx context.Context, c client.Reader, access *llmwardenv1alpha1.LLMAccess) (*llmwardenv1alpha1.LLMProvider, error) {
+	ref := access.Spec.ProviderRef
+	if ref.Kind != llmwardenv1alpha1.ProviderKindNamespacedLLMProvider {
+		provider := &llmwardenv1alpha1.LLMProvider{}
+		if err := c.Get(ctx, types.NamespacedName{Name: ref.Name}, provider); err != nil {
+			return nil, fmt.Errorf("getting LLMProvider %s: %w", ref.Name, err)
+		}
+		return provider, nil
+	}
+
+	namespaced := &llmwardenv1alpha1.NamespacedLLMProvider{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: access.Namespace, Name: ref.Name}, namespaced); err != nil {
+		return nil, fmt.Errorf("getting NamespacedLLMProvider %s/%s: %w", access.Namespace, ref.Name, err)
+	}
+	provider := NamespacedProviderView(namespaced)
+	if err := ValidateProviderNamespace(provider); err != nil {
+		return nil, err
+	}
+	return provider, nil
+}
+
+// NamespacedProviderView returns the namespaced provider as an LLMProvider, so the
+// provisioning pipeline handles both kinds alike. The view keeps the namespace, which a
+// cluster-scoped LLMProvider never has, and shares no memory with the original.
+func NamespacedProviderView(provider *llmwardenv1alpha1.NamespacedLLMProvider) *llmwardenv1alpha1.LLMProvider {
+	return &llmwardenv1alpha1.LLMProvider{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: llmwardenv1alpha1.GroupVersion.String(),
+			Kind:       "LLMProvider",
+		},
+		ObjectMeta: *provider.ObjectMeta.DeepCopy(),
+		Spec:       *provider.Spec.DeepCopy(),
+		Status:     *provider.Status.DeepCopy(),
+	}
+}
+
+// ValidateProviderNamespace checks that a namespaced provider's view only references
+// secrets and ConfigMaps in its own namespace and only namespaced SecretStores, so a
+// tenant cannot use it to read credentials it has no access to. Cluster-scoped providers
+// always pass.
+func ValidateProviderNamespace(provider *llmwardenv1alpha1.LLMProvider) error {
+	namespace := provider.Namespace
+	if namespace == "" {
+		return nil
+	}
+
+	var violations []string
+	checkNamespace := func(path, refNamespace string) {
+		if refNamespace != namespace {
+			violations = append(violations, fmt.Sprintf("%s.namespace must be %q, got %q", path, namespace, refNamespace))
+		}
+	}
+	checkAuth := func(path string, apiKey *llmwardenv1alpha1.APIKeyAuth, externalSecret *llmwardenv1alpha1.ExternalSecretAuth) {
+		if apiKey != nil {
+			checkNamespace(path+".apiKey.secretRef", apiKey.SecretRef.Namespace)
+			if apiKey.NextSecretRef != nil {
+				checkNamespace(path+".apiKey.nextSecretRef", apiKey.NextSecretRef.Namespace)
+			}
+		}
+		if externalSecret != nil && externalSecret.Store.Kind != llmwardenv1alpha1.SecretStoreKindSecretStore {
+			violations = append(violations, fmt.Sprintf("%s.externalSecret.store.kind must be SecretStore, got %q",
+				path, externalSecret.Store.Kind))
+		}
+	}
+
+	auth := provider.Spec.Auth
+	checkAuth("spec.auth", auth.APIKey, auth.ExternalSecret)
+	for i, fallback := range auth.Fallbacks {
+		checkAuth(fmt.Sprintf("spec.auth.fallbacks[%d]", i), fallback.APIKey, fallback.ExternalSecret)
+	}
+	if endpoint := provider.Spec.Endpoint; endpoint != nil && endpoint.CASecretRef != nil {
+		checkNamespace("spec.endpoint.caSecretRef", endpoint.CASecretRef.Namespace)
+	}
+	if ref := provider.Spec.AllowedModelsRef; ref != nil {
+		checkNamespace("spec.allowedModelsRef", ref.Namespace)
+	}
+
+	if len(violations) > 0 {
+		return fmt.Errorf("NamespacedLLMProvider %s/%s: %w: %s",
+			namespace, provider.Name, ErrProviderOutOfNamespace, strings.Join(violations, "; "))
+	}
+	return nil
+}
+
+// DeprecationWarning describes a provider with spec.deprecationMessage or spec.sunsetDate
+// set, relative to now. It returns "" for a provider that is not deprecated.
+func DeprecationWarning(provider *llmwardenv1alpha1.LLMProvider, now time.Time) string {
+	if provider.Spec.DeprecationMessage == "" && provider.Spec.SunsetDate == nil {
+		return ""
+	}
+	warning := fmt.Sprintf("LLMProvider %s is deprecated", provider.Name)
+	if provider.Spec.DeprecationMessage != "" {
+		warning += ": " + provider.Spec.DeprecationMessage
+	}
+	if sunset := provider.Spec.SunsetDate; sunset != nil {
+		date := sunset.UTC().Format(time.DateOnly)
+		if now.Before(sunset.Time) {
+			warning += fmt.Sprintf(" (sunset on %s)", date)
+		} else {
+			warning += fmt.Sprintf(" (sunset date %s has passed)", date)
+		}
+	}
+	return warning
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provisioner
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+)
+
+func TestResolveProvider(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+
+	apiKeySpec := func(secretNamespace string) llmwardenv1alpha1.LLMProviderSpec {
+		return llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderOpenAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef: llmwardenv1alpha1.SecretReference{Name: "openai-master", Namespace: secretNamespace, Key: "api-key"},
+				},
+			},
+		}
+	}
+
+	objects := []client.Object{
+		&llmwardenv1alpha1.LLMProvider{
+			ObjectMeta: metav1.ObjectMeta{Name: "openai"},
+			Spec:       apiKeySpec("vault-sync"),
+		},
+		&llmwardenv1alpha1.NamespacedLLMProvider{
+			ObjectMeta: metav1.ObjectMeta{Name: "openai", Namespace: "team-a"},
+			Spec:       apiKeySpec("team-a"),
+		},
+		&llmwardenv1alpha1.NamespacedLLMProvider{
+			ObjectMeta: metav1.ObjectMeta{Name: "borrowed", Namespace: "team-a"},
+			Spec:       apiKeySpec("team-b"),
+		},
+		&llmwardenv1alpha1.NamespacedLLMProvider{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster-store", Namespace: "team-a"},
+			Spec: llmwardenv1alpha1.LLMProviderSpec{
+				Provider: llmwardenv1alpha1.ProviderOpenAI,
+				Auth: llmwardenv1alpha1.AuthConfig{
+					Type: llmwardenv1alpha1.AuthTypeExternalSecret,
+					ExternalSecret: &llmwardenv1alpha1.ExternalSecretAuth{
+						Store:     llmwardenv1alpha1.StoreReference{Name: "vault", Kind: llmwardenv1alpha1.SecretStoreKindClusterSecretStore},
+						RemoteRef: llmwardenv1alpha1.RemoteReference{Key: "llm/openai"},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		namespace     string
+		ref           llmwardenv1alpha1.ProviderReference
+		wantNamespace string
+		wantNotFound  bool
+		wantIsolation bool
+	}{
+		{
+			name:      "cluster-scoped provider by default",
+			namespace: "team-a",
+			ref:       llmwardenv1alpha1.ProviderReference{Name: "openai"},
+		},
+		{
+			name:          "namespaced provider in the access namespace",
+			namespace:     "team-a",
+			ref:           llmwardenv1alpha1.ProviderReference{Name: "openai", Kind: llmwardenv1alpha1.ProviderKindNamespacedLLMProvider},
+			wantNamespace: "team-a",
+		},
+		{
+			name:         "namespaced provider of another namespace is not visible",
+			namespace:    "team-b",
+			ref:          llmwardenv1alpha1.ProviderReference{Name: "openai", Kind: llmwardenv1alpha1.ProviderKindNamespacedLLMProvider},
+			wantNotFound: true,
+		},
+		{
+			name:          "namespaced provider reading another namespace's secret",
+			namespace:     "team-a",
+			ref:           llmwardenv1alpha1.ProviderReference{Name: "borrowed", Kind: llmwardenv1alpha1.ProviderKindNamespacedLLMProvider},
+			wantIsolation: true,
+		},
+		{
+			name:          "namespaced provider using a ClusterSecretStore",
+			namespace:     "team-a",
+			ref:           llmwardenv1alpha1.ProviderReference{Name: "cluster-store", Kind: llmwardenv1alpha1.ProviderKindNamespacedLLMProvider},
+			wantIsolation: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+			access := &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{Name: "access", Namespace: tt.namespace},
+				Spec:       llmwardenv1alpha1.LLMAccessSpec{ProviderRef: tt.ref},
+			}
+
+			provider, err := ResolveProvider(context.Background(), fakeClient, access)
+			switch {
+			case tt.wantNotFound:
+				if !apierrors.IsNotFound(err) {
+					t.Fatalf("ResolveProvider() error = %v, want NotFound", err)
+				}
+				return
+			case tt.wantIsolation:
+				if !errors.Is(err, ErrProviderOutOfNamespace) {
+					t.Fatalf("ResolveProvider() error = %v, want ErrProviderOutOfNamespace", err)
+				}
+				return
+			case err != nil:
+				t.Fatalf("ResolveProvider() error = %v", err)
+			}
+
+			if provider.Name != tt.ref.Name || provider.Namespace != tt.wantNamespace {
+				t.Errorf("provider = %s/%s, want %s/%s", provider.Namespace, provider.Name, tt.wantNamespace, tt.ref.Name)
+			}
+		})
+	}
+}
+
+func TestValidateProviderNamespace(t *testing.T) {
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai", Namespace: "team-a"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderOpenAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef:     llmwardenv1alpha1.SecretReference{Name: "openai", Namespace: "team-a", Key: "api-key"},
+					NextSecretRef: &llmwardenv1alpha1.SecretReference{Name: "openai-next", Namespace: "team-b", Key: "api-key"},
+				},
+			},
+			Endpoint: &llmwardenv1alpha1.EndpointConfig{
+				CASecretRef: &llmwardenv1alpha1.SecretReference{Name: "ca", Namespace: "kube-system", Key: "ca.crt"},
+			},
+		},
+	}
+
+	err := ValidateProviderNamespace(provider)
+	if !errors.Is(err, ErrProviderOutOfNamespace) {
+		t.Fatalf("ValidateProviderNamespace() error = %v, want ErrProviderOutOfNamespace", err)
+	}
+	for _, path := range []string{"spec.auth.apiKey.nextSecretRef", "spec.endpoint.caSecretRef"} {
+		if !strings.Contains(err.Error(), path) {
+			t.Errorf("error %q does not name %s", err, path)
+		}
+	}
+
+	provider.Namespace = ""
+	if err := ValidateProviderNamespace(provider); err != nil {
+		t.Errorf("ValidateProviderNamespace() on a cluster-scoped provider = %v, want nil", err)
+	}
+}
+
+func TestDeprecationWarning(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	sunset := func(t time.Time) *metav1.Time { return &metav1.Time{Time: t} }
+
+	tests := []struct {
+		name    string
+		spec    llmwardenv1alpha1.LLMProviderSpec
+		want    string
+		wantAll []string
+	}{
+		{name: "not deprecated"},
+		{
+			name: "message only",
+			spec: llmwardenv1alpha1.LLMProviderSpec{DeprecationMessage: "migrate to openai-v2"},
+			want: "LLMProvider openai is deprecated: migrate to openai-v2",
+		},
+		{
+			name:    "upcoming sunset",
+			spec:    llmwardenv1alpha1.LLMProviderSpec{DeprecationMessage: "gpt-4 is retired", SunsetDate: sunset(now.AddDate(0, 1, 0))},
+			wantAll: []string{"gpt-4 is retired", "sunset on 2026-07-01"},
+		},
+		{
+			name:    "sunset without a message",
+			spec:    llmwardenv1alpha1.LLMProviderSpec{SunsetDate: sunset(now.AddDate(0, 0, -1))},
+			wantAll: []string{"LLMProvider openai is deprecated", "sunset date 2026-05-31 has passed"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := &llmwardenv1alpha1.LLMProvider{ObjectMeta: metav1.ObjectMeta{Name: "openai"}, Spec: tt.spec}
+			got := DeprecationWarning(provider, now)
+			if tt.wantAll == nil {
+				if got != tt.want {
+					t.Errorf("DeprecationWarning() = %q, want %q", got, tt.want)
+				}
+				return
+			}
+			for _, part := range tt.wantAll {
+				if !strings.Contains(got, part) {
+					t.Errorf("DeprecationWarning() = %q, want it to contain %q", got, part)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provisioner
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/labelkeys"
+)
+
+// SecretReclaimPolicyAnnotation on an LLMAccess controls what happens to its target secret
+// when the access is deleted: SecretReclaimPolicyDelete (the default) deletes it with the
+// access, SecretReclaimPolicyRetain leaves it behind, e.g. for a migration or rollback.
+func SecretReclaimPolicyAnnotation() string { return labelkeys.Key("secret-reclaim-policy") }
+
+const (
+	SecretReclaimPolicyDelete = "Delete"
+	SecretReclaimPolicyRetain = "Retain"
+)
+
+// RetainSecret reports whether the access's target secret must outlive the access.
+func RetainSecret(access *llmwardenv1alpha1.LLMAccess) bool {
+	return access.Annotations[SecretReclaimPolicyAnnotation()] == SecretReclaimPolicyRetain
+}
+
+// ValidateSecretReclaimPolicy rejects values of SecretReclaimPolicyAnnotation other than
+// Delete and Retain.
+func ValidateSecretReclaimPolicy(access *llmwardenv1alpha1.LLMAccess) error {
+	policy, ok := access.Annotations[SecretReclaimPolicyAnnotation()]
+	if !ok || policy == SecretReclaimPolicyDelete || policy == SecretReclaimPolicyRetain {
+		return nil
+	}
+	return fmt.Errorf("annotation %s must be %s or %s, got %q",
+		SecretReclaimPolicyAnnotation(), SecretReclaimPolicyDelete, SecretReclaimPolicyRetain, policy)
+}
+
+// ReleaseSecret detaches the target secret of an access that is being deleted with the
+// Retain policy: it drops the owner references to the access and to the access's
+// ExternalSecret, so garbage collection keeps the secret, and labels it with
+// labelkeys.RetainedFrom, whose value is the access's name. A missing secret is not an error.
+func ReleaseSecret(ctx context.Context, c client.Client, access *llmwardenv1alpha1.LLMAccess) error {
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: access.Namespace, Name: access.Spec.SecretName}
+	if err := c.Get(ctx, key, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("getting secret %s: %w", key, err)
+	}
+
+	patch := client.MergeFrom(secret.DeepCopy())
+	secret.OwnerReferences = slices.DeleteFunc(secret.OwnerReferences, func(ref metav1.OwnerReference) bool {
+		return (ref.Kind == "LLMAccess" && ref.UID == access.UID) ||
+			(ref.Kind == "ExternalSecret" && ref.Name == access.Spec.SecretName)
+	})
+	if secret.Labels == nil {
+		secret.Labels = make(map[string]string)
+	}
+	secret.Labels[labelkeys.RetainedFrom()] = access.Name
+	if err := c.Patch(ctx, secret, patch); err != nil {
+		return fmt.Errorf("releasing secret %s: %w", key, err)
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provisioner
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/eso"
+	"github.com/llmwarden/llmwarden/internal/labelkeys"
+)
+
+func TestApiKeyProvisioner_ProvisionReclaimPolicy(t *testing.T) {
+	isController := true
+	scheme := newTestScheme()
+	provider := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-provider"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderOpenAI,
+			Auth: llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef: llmwardenv1alpha1.SecretReference{Name: "source-secret", Namespace: "provider-ns", Key: "api-key"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		policy    string
+		existing  *corev1.Secret
+		wantOwner bool
+	}{
+		{name: "owned by default", wantOwner: true},
+		{name: "owned with Delete", policy: SecretReclaimPolicyDelete, wantOwner: true},
+		{name: "not owned with Retain", policy: SecretReclaimPolicyRetain},
+		{
+			name:   "Retain drops the owner reference of an existing secret",
+			policy: SecretReclaimPolicyRetain,
+			existing: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "target-secret",
+					Namespace: "test-ns",
+					OwnerReferences: []metav1.OwnerReference{{
+						APIVersion: llmwardenv1alpha1.GroupVersion.String(), Kind: "LLMAccess",
+						Name: "test-access", UID: "test-uid-reclaim", Controller: &isController,
+					}},
+				},
+			},
+		},
+		{
+			name:   "Delete adopts a secret retained by a previous access",
+			policy: SecretReclaimPolicyDelete,
+			existing: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "target-secret",
+					Namespace: "test-ns",
+					Labels:    map[string]string{labelkeys.RetainedFrom(): "old-access"},
+				},
+			},
+			wantOwner: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			objects := []client.Object{&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "source-secret", Namespace: "provider-ns"},
+				Data:       map[string][]byte{"api-key": []byte("sk-source-key")},
+			}}
+			if tt.existing != nil {
+				objects = append(objects, tt.existing)
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+
+			access := &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-access", Namespace: "test-ns", UID: "test-uid-reclaim"},
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					SecretName:  "target-secret",
+					ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "test-provider"},
+					Injection: llmwardenv1alpha1.InjectionConfig{
+						Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+					},
+				},
+			}
+			if tt.policy != "" {
+				access.Annotations = map[string]string{SecretReclaimPolicyAnnotation(): tt.policy}
+			}
+
+			if _, err := NewApiKeyProvisioner(fakeClient, scheme).Provision(ctx, provider, access); err != nil {
+				t.Fatalf("Provision() error = %v", err)
+			}
+
+			got := &corev1.Secret{}
+			if err := fakeClient.Get(ctx, types.NamespacedName{Name: "target-secret", Namespace: "test-ns"}, got); err != nil {
+				t.Fatalf("failed to get target secret: %v", err)
+			}
+			owned := metav1.IsControlledBy(got, access)
+			if owned != tt.wantOwner {
+				t.Errorf("secret controlled by the access = %v, want %v (ownerReferences %v)", owned, tt.wantOwner, got.OwnerReferences)
+			}
+			if label, ok := got.Labels[labelkeys.RetainedFrom()]; ok {
+				t.Errorf("%s = %q on a provisioned secret, want it removed", labelkeys.RetainedFrom(), label)
+			}
+		})
+	}
+}
+
+func TestExternalSecretProvisioner_RenderReclaimPolicy(t *testing.T) {
+	scheme := newTestScheme()
+	p := NewExternalSecretProvisioner(fake.NewClientBuilder().WithScheme(scheme).Build(), scheme, eso.NewV1Beta1Adapter())
+	provider := testProvider("vault", "SecretStore", "secret/openai", "", "1h")
+
+	for policy, want := range map[string]eso.SecretCreationPolicy{
+		"":                        eso.SecretCreationPolicyOwner,
+		SecretReclaimPolicyDelete: eso.SecretCreationPolicyOwner,
+		SecretReclaimPolicyRetain: eso.SecretCreationPolicyOrphan,
+	} {
+		access := testAccess("test-ns", "openai-creds", "")
+		if policy != "" {
+			access.Annotations = map[string]string{SecretReclaimPolicyAnnotation(): policy}
+		}
+		rendered, err := p.Render(provider, access)
+		if err != nil {
+			t.Fatalf("Render() error = %v", err)
+		}
+		if got, _, _ := unstructured.NestedString(rendered.Object, "spec", "target", "creationPolicy"); got != string(want) {
+			t.Errorf("policy %q: spec.target.creationPolicy = %q, want %q", policy, got, want)
+		}
+	}
+}
+
+func TestReleaseSecret(t *testing.T) {
+	isController := true
+	ctx := context.Background()
+	scheme := newTestScheme()
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-access",
+			Namespace:   "test-ns",
+			UID:         "test-uid-reclaim",
+			Annotations: map[string]string{SecretReclaimPolicyAnnotation(): SecretReclaimPolicyRetain},
+		},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{SecretName: "target-secret"},
+	}
+	unrelated := metav1.OwnerReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "chatbot", UID: "deploy-uid"}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "target-secret",
+			Namespace: "test-ns",
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: llmwardenv1alpha1.GroupVersion.String(), Kind: "LLMAccess", Name: "test-access", UID: "test-uid-reclaim", Controller: &isController},
+				{APIVersion: "external-secrets.io/v1", Kind: "ExternalSecret", Name: "target-secret", UID: "es-uid"},
+				unrelated,
+			},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+
+	if err := ReleaseSecret(ctx, fakeClient, access); err != nil {
+		t.Fatalf("ReleaseSecret() error = %v", err)
+	}
+
+	got := &corev1.Secret{}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Name: "target-secret", Namespace: "test-ns"}, got); err != nil {
+		t.Fatalf("failed to get target secret: %v", err)
+	}
+	if len(got.OwnerReferences) != 1 || got.OwnerReferences[0].UID != unrelated.UID {
+		t.Errorf("ownerReferences = %v, want only the unrelated owner", got.OwnerReferences)
+	}
+	if got.Labels[labelkeys.RetainedFrom()] != "test-access" {
+		t.Errorf("%s = %q, want test-access", labelkeys.RetainedFrom(), got.Labels[labelkeys.RetainedFrom()])
+	}
+
+	// Releasing an access whose secret is already gone is a no-op.
+	access.Spec.SecretName = "missing"
+	if err := ReleaseSecret(ctx, fakeClient, access); err != nil {
+		t.Errorf("ReleaseSecret() for a missing secret error = %v, want nil", err)
+	}
+}
+
+func TestValidateSecretReclaimPolicy(t *testing.T) {
+	for _, tt := range []struct {
+		annotations map[string]string
+		wantErr     bool
+	}{
+		{annotations: nil},
+		{annotations: map[string]string{SecretReclaimPolicyAnnotation(): SecretReclaimPolicyDelete}},
+		{annotations: map[string]string{SecretReclaimPolicyAnnotation(): SecretReclaimPolicyRetain}},
+		{annotations: map[string]string{SecretReclaimPolicyAnnotation(): "retain"}, wantErr: true},
+	} {
+		access := &llmwardenv1alpha1.LLMAccess{ObjectMeta: metav1.ObjectMeta{Annotations: tt.annotations}}
+		if err := ValidateSecretReclaimPolicy(access); (err != nil) != tt.wantErr {
+			t.Errorf("ValidateSecretReclaimPolicy(%v) error = %v, wantErr %v", tt.annotations, err, tt.wantErr)
+		}
+	}
+}
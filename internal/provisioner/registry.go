@@ -0,0 +1,61 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provisioner
+
+import (
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+)
+
+// Factory builds the Provisioner for a registered auth type. It is called whenever the
+// reconciler needs a provisioner for that type, so it should be cheap.
+type Factory func(k8sClient client.Client, scheme *runtime.Scheme) Provisioner
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[llmwardenv1alpha1.AuthType]Factory{}
+)
+
+// Register makes factory the provisioner for authType. Registered factories are consulted
+// before the built-in provisioners, so a downstream build can add an auth type, or replace
+// a built-in one, from an init function without changing the reconciler. A new type must
+// also be allowed by the AuthType enum of the CRDs. Register panics if factory is nil or
+// authType is already registered.
+func Register(authType llmwardenv1alpha1.AuthType, factory Factory) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if factory == nil {
+		panic(fmt.Sprintf("provisioner: Register factory for auth type %q is nil", authType))
+	}
+	if _, dup := registry[authType]; dup {
+		panic(fmt.Sprintf("provisioner: Register called twice for auth type %q", authType))
+	}
+	registry[authType] = factory
+}
+
+// Lookup returns the factory registered for authType, if any.
+func Lookup(authType llmwardenv1alpha1.AuthType) (Factory, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	factory, ok := registry[authType]
+	return factory, ok
+}
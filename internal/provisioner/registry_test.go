@@ -0,0 +1,63 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provisioner
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+)
+
+func TestRegister(t *testing.T) {
+	const authType llmwardenv1alpha1.AuthType = "registryTest"
+	t.Cleanup(func() {
+		registryMu.Lock()
+		delete(registry, authType)
+		registryMu.Unlock()
+	})
+
+	if _, ok := Lookup(authType); ok {
+		t.Fatalf("Lookup(%q) found a factory before Register", authType)
+	}
+
+	want := &ApiKeyProvisioner{}
+	Register(authType, func(client.Client, *runtime.Scheme) Provisioner { return want })
+	factory, ok := Lookup(authType)
+	if !ok {
+		t.Fatalf("Lookup(%q) found no factory after Register", authType)
+	}
+	if got := factory(nil, nil); got != want {
+		t.Errorf("factory() = %v, want the registered provisioner", got)
+	}
+
+	assertPanics := func(name string, register func()) {
+		t.Helper()
+		defer func() {
+			if recover() == nil {
+				t.Errorf("%s did not panic", name)
+			}
+		}()
+		register()
+	}
+	assertPanics("duplicate Register", func() {
+		Register(authType, func(client.Client, *runtime.Scheme) Provisioner { return nil })
+	})
+	assertPanics("Register with a nil factory", func() { Register("registryTestNil", nil) })
+}
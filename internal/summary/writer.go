@@ -0,0 +1,162 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package summary maintains the cluster-wide LLMWardenStatus singleton with aggregate
+// provider, access and secret counts.
+package summary
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/labelkeys"
+)
+
+var summarylog = logf.Log.WithName("status-summary")
+
+// +kubebuilder:rbac:groups=llmwarden.io,resources=llmwardenstatuses,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups=llmwarden.io,resources=llmwardenstatuses/status,verbs=get;update;patch
+
+// Writer periodically recomputes the LLMWardenStatus singleton.
+type Writer struct {
+	Client   client.Client
+	Interval time.Duration
+
+	// now returns the current time; overridden in tests.
+	now func() time.Time
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Only the leader writes
+// the summary so replicas don't overwrite each other.
+func (w *Writer) NeedLeaderElection() bool {
+	return true
+}
+
+// Start implements manager.Runnable. It writes the summary right away and then every
+// Interval.
+func (w *Writer) Start(ctx context.Context) error {
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+	for {
+		if err := w.WriteOnce(ctx); err != nil {
+			summarylog.Error(err, "Writing LLMWardenStatus failed")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// WriteOnce recomputes the summary and stores it in the LLMWardenStatus singleton,
+// creating it if needed.
+func (w *Writer) WriteOnce(ctx context.Context) error {
+	summary, err := w.compute(ctx)
+	if err != nil {
+		return err
+	}
+
+	status := &llmwardenv1alpha1.LLMWardenStatus{}
+	err = w.Client.Get(ctx, client.ObjectKey{Name: llmwardenv1alpha1.LLMWardenStatusName}, status)
+	if apierrors.IsNotFound(err) {
+		status.Name = llmwardenv1alpha1.LLMWardenStatusName
+		if err := w.Client.Create(ctx, status); err != nil {
+			return fmt.Errorf("creating LLMWardenStatus: %w", err)
+		}
+	} else if err != nil {
+		return fmt.Errorf("getting LLMWardenStatus: %w", err)
+	}
+
+	status.Status = summary
+	if err := w.Client.Status().Update(ctx, status); err != nil {
+		return fmt.Errorf("updating LLMWardenStatus: %w", err)
+	}
+	return nil
+}
+
+// compute counts the providers, accesses and managed secrets visible to the client.
+func (w *Writer) compute(ctx context.Context) (llmwardenv1alpha1.LLMWardenStatusStatus, error) {
+	var summary llmwardenv1alpha1.LLMWardenStatusStatus
+
+	providers := &llmwardenv1alpha1.LLMProviderList{}
+	if err := w.Client.List(ctx, providers); err != nil {
+		return summary, fmt.Errorf("listing LLMProviders: %w", err)
+	}
+	namespacedProviders := &llmwardenv1alpha1.NamespacedLLMProviderList{}
+	if err := w.Client.List(ctx, namespacedProviders); err != nil {
+		return summary, fmt.Errorf("listing NamespacedLLMProviders: %w", err)
+	}
+	accesses := &llmwardenv1alpha1.LLMAccessList{}
+	if err := w.Client.List(ctx, accesses); err != nil {
+		return summary, fmt.Errorf("listing LLMAccesses: %w", err)
+	}
+	// Secrets are already cached for the LLMAccess controller, so this is a cache read.
+	secrets := &corev1.SecretList{}
+	if err := w.Client.List(ctx, secrets, labelkeys.ManagedSelector()); err != nil {
+		return summary, fmt.Errorf("listing managed Secrets: %w", err)
+	}
+
+	summary.ProvidersByAuthType = make(map[llmwardenv1alpha1.AuthType]int32)
+	for i := range providers.Items {
+		count(&summary.Providers, providers.Items[i].Status.Phase)
+		summary.ProvidersByAuthType[providers.Items[i].Spec.Auth.Type]++
+	}
+	for i := range namespacedProviders.Items {
+		count(&summary.Providers, namespacedProviders.Items[i].Status.Phase)
+		summary.ProvidersByAuthType[namespacedProviders.Items[i].Spec.Auth.Type]++
+	}
+
+	summary.AccessesByAuthType = make(map[llmwardenv1alpha1.AuthType]int32)
+	for i := range accesses.Items {
+		access := &accesses.Items[i]
+		count(&summary.Accesses, access.Status.Phase)
+		if access.Status.ProvisionedAuthType != "" {
+			summary.AccessesByAuthType[access.Status.ProvisionedAuthType]++
+		}
+	}
+
+	summary.ManagedSecrets = int32(len(secrets.Items))
+	now := w.now
+	if now == nil {
+		now = time.Now
+	}
+	summary.LastUpdated = &metav1.Time{Time: now()}
+	return summary, nil
+}
+
+// count adds a resource in the given phase to the summary.
+func count(summary *llmwardenv1alpha1.ResourceSummary, phase llmwardenv1alpha1.Phase) {
+	summary.Total++
+	switch phase {
+	case llmwardenv1alpha1.PhaseReady:
+		summary.Ready++
+	case llmwardenv1alpha1.PhaseDegraded:
+		summary.Degraded++
+	case llmwardenv1alpha1.PhaseError:
+		summary.Failing++
+	default:
+		summary.Pending++
+	}
+}
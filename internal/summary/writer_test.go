@@ -0,0 +1,134 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package summary
+
+import (
+	"context"
+	"maps"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/labelkeys"
+)
+
+func TestWriter_WriteOnce(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	provider := func(name string, authType llmwardenv1alpha1.AuthType, phase llmwardenv1alpha1.Phase) *llmwardenv1alpha1.LLMProvider {
+		return &llmwardenv1alpha1.LLMProvider{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       llmwardenv1alpha1.LLMProviderSpec{Auth: llmwardenv1alpha1.AuthConfig{Type: authType}},
+			Status:     llmwardenv1alpha1.LLMProviderStatus{Phase: phase},
+		}
+	}
+	access := func(name string, authType llmwardenv1alpha1.AuthType, phase llmwardenv1alpha1.Phase) *llmwardenv1alpha1.LLMAccess {
+		return &llmwardenv1alpha1.LLMAccess{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "team-a"},
+			Status:     llmwardenv1alpha1.LLMAccessStatus{Phase: phase, ProvisionedAuthType: authType},
+		}
+	}
+	secret := func(name string, labels map[string]string) *corev1.Secret {
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "team-a", Labels: labels}}
+	}
+	managed := map[string]string{labelkeys.ManagedBy(): labelkeys.ManagedByValue}
+
+	objects := []client.Object{
+		provider("openai", llmwardenv1alpha1.AuthTypeAPIKey, llmwardenv1alpha1.PhaseReady),
+		provider("anthropic", llmwardenv1alpha1.AuthTypeExternalSecret, llmwardenv1alpha1.PhaseError),
+		&llmwardenv1alpha1.NamespacedLLMProvider{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-gateway", Namespace: "team-a"},
+			Spec:       llmwardenv1alpha1.LLMProviderSpec{Auth: llmwardenv1alpha1.AuthConfig{Type: llmwardenv1alpha1.AuthTypeAPIKey}},
+		},
+		access("chatbot", llmwardenv1alpha1.AuthTypeAPIKey, llmwardenv1alpha1.PhaseReady),
+		access("summarizer", llmwardenv1alpha1.AuthTypeAPIKey, llmwardenv1alpha1.PhaseDegraded),
+		access("agent", llmwardenv1alpha1.AuthTypeExternalSecret, llmwardenv1alpha1.PhaseError),
+		access("new", "", ""),
+		secret("chatbot-openai", managed),
+		secret("summarizer-openai", managed),
+		secret("unrelated", nil),
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(&llmwardenv1alpha1.LLMWardenStatus{}).
+		Build()
+
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	w := &Writer{Client: c, now: func() time.Time { return now }}
+	if err := w.WriteOnce(ctx); err != nil {
+		t.Fatalf("WriteOnce() error = %v", err)
+	}
+
+	got := &llmwardenv1alpha1.LLMWardenStatus{}
+	if err := c.Get(ctx, client.ObjectKey{Name: llmwardenv1alpha1.LLMWardenStatusName}, got); err != nil {
+		t.Fatalf("Get() LLMWardenStatus error = %v", err)
+	}
+	status := got.Status
+
+	wantProviders := llmwardenv1alpha1.ResourceSummary{Total: 3, Ready: 1, Failing: 1, Pending: 1}
+	if status.Providers != wantProviders {
+		t.Errorf("providers = %+v, want %+v", status.Providers, wantProviders)
+	}
+	wantAccesses := llmwardenv1alpha1.ResourceSummary{Total: 4, Ready: 1, Degraded: 1, Failing: 1, Pending: 1}
+	if status.Accesses != wantAccesses {
+		t.Errorf("accesses = %+v, want %+v", status.Accesses, wantAccesses)
+	}
+	if status.ManagedSecrets != 2 {
+		t.Errorf("managedSecrets = %d, want 2", status.ManagedSecrets)
+	}
+	wantProvidersByAuth := map[llmwardenv1alpha1.AuthType]int32{
+		llmwardenv1alpha1.AuthTypeAPIKey:         2,
+		llmwardenv1alpha1.AuthTypeExternalSecret: 1,
+	}
+	if !maps.Equal(status.ProvidersByAuthType, wantProvidersByAuth) {
+		t.Errorf("providersByAuthType = %v, want %v", status.ProvidersByAuthType, wantProvidersByAuth)
+	}
+	wantAccessesByAuth := map[llmwardenv1alpha1.AuthType]int32{
+		llmwardenv1alpha1.AuthTypeAPIKey:         2,
+		llmwardenv1alpha1.AuthTypeExternalSecret: 1,
+	}
+	if !maps.Equal(status.AccessesByAuthType, wantAccessesByAuth) {
+		t.Errorf("accessesByAuthType = %v, want %v", status.AccessesByAuthType, wantAccessesByAuth)
+	}
+	if status.LastUpdated == nil || !status.LastUpdated.Time.Equal(now) {
+		t.Errorf("lastUpdated = %v, want %v", status.LastUpdated, now)
+	}
+
+	// A second write updates the existing singleton.
+	if err := c.Delete(ctx, secret("summarizer-openai", nil)); err != nil {
+		t.Fatalf("Delete() secret error = %v", err)
+	}
+	if err := w.WriteOnce(ctx); err != nil {
+		t.Fatalf("WriteOnce() error = %v", err)
+	}
+	if err := c.Get(ctx, client.ObjectKey{Name: llmwardenv1alpha1.LLMWardenStatusName}, got); err != nil {
+		t.Fatalf("Get() LLMWardenStatus error = %v", err)
+	}
+	if got.Status.ManagedSecrets != 1 {
+		t.Errorf("managedSecrets after deleting a secret = %d, want 1", got.Status.ManagedSecrets)
+	}
+}
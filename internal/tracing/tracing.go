@@ -0,0 +1,91 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing wires optional OpenTelemetry tracing for the reconcilers and the
+// admission webhook. Until Setup installs an exporter the global TracerProvider is the
+// OpenTelemetry no-op, so instrumented code paths cost next to nothing.
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/llmwarden/llmwarden/internal/version"
+)
+
+// tracerName is the instrumentation scope of every llmwarden span.
+const tracerName = "github.com/llmwarden/llmwarden"
+
+// Span names of the LLMAccess reconcile phases and the pod admission path.
+const (
+	SpanLLMAccessReconcile     = "LLMAccess.Reconcile"
+	SpanLLMAccessFetchProvider = "LLMAccess.FetchProvider"
+	SpanLLMAccessValidate      = "LLMAccess.Validate"
+	SpanLLMAccessProvision     = "LLMAccess.Provision"
+	SpanLLMAccessUpdateStatus  = "LLMAccess.UpdateStatus"
+	SpanPodInjectorHandle      = "PodInjector.Handle"
+)
+
+// Tracer returns the llmwarden tracer of the global TracerProvider.
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// Setup installs a global TracerProvider that batches spans to the OTLP/gRPC collector at
+// endpoint (host:port). With an empty endpoint it does nothing. The returned function
+// flushes and stops the exporter and must be called on shutdown.
+func Setup(ctx context.Context, endpoint string, insecure bool) (func(context.Context) error, error) {
+	if endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(endpoint)}
+	if insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("creating OTLP trace exporter for %s: %w", endpoint, err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", "llmwarden"),
+			attribute.String("service.version", version.Version),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// End records err on span, if any, and ends it. Ending an already ended span is a no-op,
+// so a phase span can be ended explicitly and again by a deferred End on early returns.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package usage scrapes request and token counts from usage-reporting sidecars
+// and exports them as operator metrics.
+package usage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/metrics"
+	webhookv1alpha1 "github.com/llmwarden/llmwarden/internal/webhook/v1alpha1"
+)
+
+// Path is the endpoint usage sidecars serve their cumulative counters on.
+const Path = "/usage"
+
+var usagelog = logf.Log.WithName("usage-scraper")
+
+// Report is the JSON document served by usage sidecars. Counts are cumulative
+// since the sidecar started.
+type Report struct {
+	Requests uint64 `json:"requests"`
+	Tokens   uint64 `json:"tokens"`
+}
+
+// sample identifies the sidecar a Report was scraped from.
+type sample struct {
+	pod    types.UID
+	access types.NamespacedName
+}
+
+// Scraper periodically polls usage sidecars and adds the observed deltas to the
+// TokensConsumed and RequestsMade counters.
+type Scraper struct {
+	Client     client.Reader
+	HTTPClient *http.Client
+	Interval   time.Duration
+
+	// PodReader lists the pods to scrape. The manager's API reader keeps full pod objects
+	// out of the cache; nil uses Client.
+	PodReader client.Reader
+
+	// last holds the previous cumulative report per sidecar so only deltas are counted.
+	last map[sample]Report
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Only the leader scrapes
+// so replicas don't double count usage.
+func (s *Scraper) NeedLeaderElection() bool {
+	return true
+}
+
+// Start implements manager.Runnable.
+func (s *Scraper) Start(ctx context.Context) error {
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := s.ScrapeOnce(ctx); err != nil {
+				usagelog.Error(err, "Usage scrape failed")
+			}
+		}
+	}
+}
+
+// ScrapeOnce polls every usage sidecar once. Unreachable sidecars are logged and skipped.
+func (s *Scraper) ScrapeOnce(ctx context.Context) error {
+	accessList := &llmwardenv1alpha1.LLMAccessList{}
+	if err := s.Client.List(ctx, accessList); err != nil {
+		return fmt.Errorf("listing LLMAccess: %w", err)
+	}
+
+	seen := make(map[sample]Report)
+	for i := range accessList.Items {
+		access := &accessList.Items[i]
+		if access.Spec.Injection.UsageSidecar == nil || !webhookv1alpha1.HasWorkloadSelectors(access) {
+			continue
+		}
+		if err := s.scrapeAccess(ctx, access, seen); err != nil {
+			usagelog.Error(err, "Failed to scrape usage for LLMAccess",
+				"namespace", access.Namespace, "name", access.Name)
+			// Keep the previous reports so the next successful scrape only counts deltas.
+			for key, report := range s.last {
+				if key.access.Namespace == access.Namespace && key.access.Name == access.Name {
+					seen[key] = report
+				}
+			}
+		}
+	}
+	// Forget sidecars that are gone so a recreated pod starts from zero.
+	s.last = seen
+	return nil
+}
+
+// podReader returns PodReader, or Client when it is unset.
+func (s *Scraper) podReader() client.Reader {
+	if s.PodReader != nil {
+		return s.PodReader
+	}
+	return s.Client
+}
+
+// scrapeAccess scrapes the sidecars of running pods selected by access.
+func (s *Scraper) scrapeAccess(ctx context.Context, access *llmwardenv1alpha1.LLMAccess, seen map[sample]Report) error {
+	pods := &corev1.PodList{}
+	if err := s.podReader().List(ctx, pods, client.InNamespace(access.Namespace)); err != nil {
+		return fmt.Errorf("listing pods in %s: %w", access.Namespace, err)
+	}
+
+	port := access.Spec.Injection.UsageSidecar.MetricsPort
+	if port == 0 {
+		port = llmwardenv1alpha1.DefaultUsageSidecarMetricsPort
+	}
+	containerName := webhookv1alpha1.UsageSidecarContainerName(access)
+	provider := access.Spec.ProviderRef.Name
+
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning || pod.Status.PodIP == "" ||
+			!webhookv1alpha1.WorkloadSelected(&pod, access) || !hasContainer(&pod, containerName) {
+			continue
+		}
+
+		key := sample{pod: pod.UID, access: types.NamespacedName{Namespace: access.Namespace, Name: access.Name}}
+		prev, known := s.last[key]
+
+		report, err := s.fetch(ctx, pod.Status.PodIP, port)
+		if err != nil {
+			usagelog.V(1).Info("Usage sidecar unreachable", "pod", pod.Name, "namespace", pod.Namespace, "error", err.Error())
+			// Keep the previous report so a transient failure doesn't recount everything.
+			if known {
+				seen[key] = prev
+			}
+			continue
+		}
+
+		seen[key] = report
+		// A counter lower than last time means the sidecar restarted; count from zero.
+		if report.Requests < prev.Requests || report.Tokens < prev.Tokens {
+			prev = Report{}
+		}
+		metrics.RequestsMade.WithLabelValues(provider, access.Namespace, access.Name).Add(float64(report.Requests - prev.Requests))
+		metrics.TokensConsumed.WithLabelValues(provider, access.Namespace, access.Name).Add(float64(report.Tokens - prev.Tokens))
+	}
+	return nil
+}
+
+// fetch retrieves the cumulative usage report from a sidecar.
+func (s *Scraper) fetch(ctx context.Context, podIP string, port int32) (Report, error) {
+	url := "http://" + net.JoinHostPort(podIP, strconv.Itoa(int(port))) + Path
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return Report{}, err
+	}
+	httpClient := s.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return Report{}, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return Report{}, fmt.Errorf("unexpected status %d from %s", resp.StatusCode, url)
+	}
+
+	var report Report
+	if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
+		return Report{}, fmt.Errorf("decoding usage report from %s: %w", url, err)
+	}
+	return report, nil
+}
+
+// hasContainer reports whether the pod has a container or init container, such as a
+// native sidecar, named name.
+func hasContainer(pod *corev1.Pod, name string) bool {
+	for _, c := range slices.Concat(pod.Spec.InitContainers, pod.Spec.Containers) {
+		if c.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usage
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/metrics"
+)
+
+func TestScraper_ScrapeOnce(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	report := Report{Requests: 3, Tokens: 120}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != Path {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(report)
+	}))
+	defer server.Close()
+
+	host, portStr, err := net.SplitHostPort(server.Listener.Addr().String())
+	if err != nil {
+		t.Fatalf("failed to parse server address: %v", err)
+	}
+	port, _ := strconv.Atoi(portStr)
+
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "chatbot-access",
+			Namespace: "usage-ns",
+		},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai-prod"},
+			SecretName:  "openai-creds",
+			WorkloadSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "chatbot"},
+			},
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				UsageSidecar: &llmwardenv1alpha1.UsageSidecarConfig{
+					Image:       "usage-proxy",
+					MetricsPort: int32(port),
+				},
+			},
+		},
+	}
+	restartAlways := corev1.ContainerRestartPolicyAlways
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "chatbot-1",
+			Namespace: "usage-ns",
+			UID:       "pod-uid-1",
+			Labels:    map[string]string{"app": "chatbot"},
+		},
+		Spec: corev1.PodSpec{
+			// The injector adds the sidecar as a native sidecar init container.
+			InitContainers: []corev1.Container{
+				{Name: "llmwarden-usage-chatbot-access", Image: "usage-proxy", RestartPolicy: &restartAlways},
+			},
+			Containers: []corev1.Container{
+				{Name: "main", Image: "chatbot"},
+			},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning, PodIP: host},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRuntimeObjects(access, pod).
+		Build()
+	scraper := &Scraper{Client: fakeClient, HTTPClient: server.Client()}
+
+	requests := metrics.RequestsMade.WithLabelValues("openai-prod", "usage-ns", "chatbot-access")
+	tokens := metrics.TokensConsumed.WithLabelValues("openai-prod", "usage-ns", "chatbot-access")
+
+	steps := []struct {
+		name         string
+		report       Report
+		wantRequests float64
+		wantTokens   float64
+	}{
+		{name: "first scrape counts cumulative totals", report: Report{Requests: 3, Tokens: 120}, wantRequests: 3, wantTokens: 120},
+		{name: "second scrape counts only deltas", report: Report{Requests: 5, Tokens: 200}, wantRequests: 5, wantTokens: 200},
+		{name: "sidecar restart counts from zero", report: Report{Requests: 1, Tokens: 10}, wantRequests: 6, wantTokens: 210},
+	}
+
+	for _, step := range steps {
+		report = step.report
+		if err := scraper.ScrapeOnce(context.Background()); err != nil {
+			t.Fatalf("%s: ScrapeOnce() error = %v", step.name, err)
+		}
+		if got := testutil.ToFloat64(requests); got != step.wantRequests {
+			t.Errorf("%s: requests = %v, want %v", step.name, got, step.wantRequests)
+		}
+		if got := testutil.ToFloat64(tokens); got != step.wantTokens {
+			t.Errorf("%s: tokens = %v, want %v", step.name, got, step.wantTokens)
+		}
+	}
+}
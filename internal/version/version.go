@@ -0,0 +1,27 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package version holds the build information the Makefile and Dockerfile inject at
+// link time with -ldflags "-X github.com/llmwarden/llmwarden/internal/version.Version=...".
+package version
+
+var (
+	// Version is the llmwarden release, "dev" for builds without ldflags
+	Version = "dev"
+
+	// GitCommit is the commit the binary was built from
+	GitCommit = "unknown"
+)
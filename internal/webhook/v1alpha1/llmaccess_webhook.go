@@ -18,10 +18,17 @@ package v1alpha1
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"slices"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -29,6 +36,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/duration"
+	"github.com/llmwarden/llmwarden/internal/labelkeys"
+	"github.com/llmwarden/llmwarden/internal/provisioner"
 )
 
 // nolint:unused
@@ -36,20 +46,38 @@ import (
 var llmaccesslog = logf.Log.WithName("llmaccess-resource")
 
 // SetupLLMAccessWebhookWithManager registers the webhook for LLMAccess in the manager.
-func SetupLLMAccessWebhookWithManager(mgr ctrl.Manager) error {
+// watchNamespaces limits validation to the namespaces the manager's cache watches;
+// empty means all namespaces.
+func SetupLLMAccessWebhookWithManager(mgr ctrl.Manager, watchNamespaces []string) error {
 	return ctrl.NewWebhookManagedBy(mgr, &llmwardenv1alpha1.LLMAccess{}).
-		WithValidator(&LLMAccessCustomValidator{Client: mgr.GetClient()}).
-		WithDefaulter(&LLMAccessCustomDefaulter{}).
+		WithValidator(&LLMAccessCustomValidator{Client: mgr.GetClient(), WatchNamespaces: watchNamespaces}).
+		WithDefaulter(&LLMAccessCustomDefaulter{Client: mgr.GetClient()}).
 		Complete()
 }
 
 // SetupPodInjectorWebhookWithManager registers the pod injector webhook with the manager.
-func SetupPodInjectorWebhookWithManager(mgr ctrl.Manager) error {
+// watchNamespaces limits injection to the namespaces the manager's cache watches;
+// empty means all namespaces. credentialCopyImage fills memory-medium credential volumes.
+// limits caps what is injected into a single pod. matchWorkflowOwnerLabels lets workload
+// selectors match the labels of a pod's workflow owner, read directly from the apiserver.
+func SetupPodInjectorWebhookWithManager(
+	mgr ctrl.Manager, watchNamespaces []string, credentialCopyImage string, limits InjectionLimits,
+	matchWorkflowOwnerLabels bool,
+) error {
 	decoder := admission.NewDecoder(mgr.GetScheme())
 
 	podInjector := &PodInjector{
-		Client:  mgr.GetClient(),
-		decoder: decoder,
+		Client:                 mgr.GetClient(),
+		decoder:                decoder,
+		ListFailureCooldown:    defaultListFailureCooldown,
+		WatchNamespaces:        watchNamespaces,
+		CredentialCopyImage:    credentialCopyImage,
+		Limits:                 limits,
+		Recorder:               mgr.GetEventRecorderFor("pod-injector"),
+		InjectionEventInterval: defaultInjectionEventInterval,
+	}
+	if matchWorkflowOwnerLabels {
+		podInjector.WorkflowOwnerReader = mgr.GetAPIReader()
 	}
 
 	mgr.GetWebhookServer().Register("/mutate-v1-pod", &admission.Webhook{
@@ -69,18 +97,46 @@ func SetupPodInjectorWebhookWithManager(mgr ctrl.Manager) error {
 // NOTE: The +kubebuilder:object:generate=false marker prevents controller-gen from generating DeepCopy methods,
 // as it is used only for temporary operations and does not need to be deeply copied.
 type LLMAccessCustomDefaulter struct {
-	// TODO(user): Add more fields as needed for defaulting
+	// Client reads the referenced LLMProvider to default env mapping secret keys.
+	Client client.Client
 }
 
 // Default implements webhook.CustomDefaulter so a webhook will be registered for the Kind LLMAccess.
-func (d *LLMAccessCustomDefaulter) Default(_ context.Context, obj *llmwardenv1alpha1.LLMAccess) error {
+func (d *LLMAccessCustomDefaulter) Default(ctx context.Context, obj *llmwardenv1alpha1.LLMAccess) error {
 	llmaccesslog.Info("Defaulting for LLMAccess", "name", obj.GetName())
 
-	// TODO(user): fill in your defaulting logic.
+	d.defaultSecretKeys(ctx, obj)
+	normalizeDurations(accessDurationFields(&obj.Spec))
 
 	return nil
 }
 
+// defaultSecretKeys sets env mappings without a secretKey to the provider's credential key,
+// falling back to provisioner.DefaultCredentialKey when the provider can't be read.
+func (d *LLMAccessCustomDefaulter) defaultSecretKeys(ctx context.Context, obj *llmwardenv1alpha1.LLMAccess) {
+	env := obj.Spec.Injection.Env
+	if !slices.ContainsFunc(env, func(mapping llmwardenv1alpha1.EnvVarMapping) bool { return mapping.SecretKey == "" }) {
+		return
+	}
+
+	credentialKey := provisioner.DefaultCredentialKey
+	if d.Client != nil {
+		provider, err := provisioner.ResolveProvider(ctx, d.Client, obj)
+		switch {
+		case err == nil:
+			credentialKey = provisioner.CredentialKey(provider)
+		case !apierrors.IsNotFound(err) && !errors.Is(err, provisioner.ErrProviderOutOfNamespace):
+			llmaccesslog.Error(err, "Failed to get LLMProvider, defaulting secretKey", "provider", obj.Spec.ProviderRef.Name)
+		}
+	}
+
+	for idx := range env {
+		if env[idx].SecretKey == "" {
+			env[idx].SecretKey = credentialKey
+		}
+	}
+}
+
 // TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
 // NOTE: If you want to customise the 'path', use the flags '--defaulting-path' or '--validation-path'.
 // +kubebuilder:webhook:path=/validate-llmwarden-io-v1alpha1-llmaccess,mutating=false,failurePolicy=fail,sideEffects=None,groups=llmwarden.io,resources=llmaccesses,verbs=create;update,versions=v1alpha1,name=vllmaccess-v1alpha1.kb.io,admissionReviewVersions=v1
@@ -92,12 +148,57 @@ func (d *LLMAccessCustomDefaulter) Default(_ context.Context, obj *llmwardenv1al
 // as this struct is used only for temporary operations and does not need to be deeply copied.
 type LLMAccessCustomValidator struct {
 	Client client.Client
+
+	// WatchNamespaces restricts validation to these namespaces; empty means all.
+	// LLMAccess resources elsewhere belong to another llmwarden instance and are admitted as-is.
+	WatchNamespaces []string
+}
+
+// ValidationBypassLabel exempts a namespace from LLMAccess validation when set to "true".
+// The validating webhook configuration's namespaceSelector excludes such namespaces so a
+// webhook outage can't block LLMAccess changes there; the validator checks it as well in
+// case the webhook is still called, e.g. with a manifest that predates the selector.
+func ValidationBypassLabel() string { return labelkeys.Key("skip-validation") }
+
+// ReasonNamespaceNotAllowed is the status reason of an LLMAccess rejected because the
+// provider's namespaceSelector excludes its namespace. It matches the Ready condition
+// reason the reconciler sets for the same case.
+const ReasonNamespaceNotAllowed metav1.StatusReason = "NamespaceNotAllowed"
+
+// validationBypassed reports whether the namespace carries ValidationBypassLabel. A
+// namespace that can't be read is validated.
+func (v *LLMAccessCustomValidator) validationBypassed(ctx context.Context, namespace string) bool {
+	if v.Client == nil || namespace == "" {
+		return false
+	}
+	ns := &corev1.Namespace{}
+	if err := v.Client.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+		if !apierrors.IsNotFound(err) {
+			llmaccesslog.Error(err, "Failed to get namespace, validating LLMAccess", "namespace", namespace)
+		}
+		return false
+	}
+	return ns.Labels[ValidationBypassLabel()] == "true"
+}
+
+// namespaceWatched reports whether namespace is in watchNamespaces, treating an empty
+// list as "all namespaces".
+func namespaceWatched(watchNamespaces []string, namespace string) bool {
+	return len(watchNamespaces) == 0 || slices.Contains(watchNamespaces, namespace)
 }
 
 // ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type LLMAccess.
 func (v *LLMAccessCustomValidator) ValidateCreate(ctx context.Context, obj *llmwardenv1alpha1.LLMAccess) (admission.Warnings, error) {
 	llmaccesslog.Info("Validation for LLMAccess upon creation", "name", obj.GetName())
 
+	if !namespaceWatched(v.WatchNamespaces, obj.Namespace) {
+		return nil, nil
+	}
+	if v.validationBypassed(ctx, obj.Namespace) {
+		llmaccesslog.Info("Skipping validation in bypassed namespace", "name", obj.GetName(), "namespace", obj.Namespace)
+		return nil, nil
+	}
+
 	var warnings admission.Warnings
 
 	// Validate provider reference is not empty
@@ -110,6 +211,12 @@ func (v *LLMAccessCustomValidator) ValidateCreate(ctx context.Context, obj *llmw
 		return nil, fmt.Errorf("spec.secretName cannot be empty")
 	}
 
+	modelWarnings, err := validateModelList(obj.Spec.Models)
+	warnings = append(warnings, modelWarnings...)
+	if err != nil {
+		return warnings, err
+	}
+
 	// Validate injection configuration - must have at least env or volume
 	if len(obj.Spec.Injection.Env) == 0 && obj.Spec.Injection.Volume == nil {
 		return nil, fmt.Errorf("spec.injection must define at least one of: env or volume")
@@ -132,6 +239,9 @@ func (v *LLMAccessCustomValidator) ValidateCreate(ctx context.Context, obj *llmw
 			return warnings, fmt.Errorf("invalid env var name: %s (must match [A-Z_][A-Z0-9_]*)", envMapping.Name)
 		}
 	}
+	if err := validateEnvConflicts(obj.Spec.Injection); err != nil {
+		return warnings, err
+	}
 
 	// Validate volume mount path is absolute
 	if obj.Spec.Injection.Volume != nil {
@@ -142,6 +252,32 @@ func (v *LLMAccessCustomValidator) ValidateCreate(ctx context.Context, obj *llmw
 			return warnings, fmt.Errorf("spec.injection.volume.mountPath must be an absolute path")
 		}
 	}
+	if err := validateEnvFile(obj.Spec.Injection); err != nil {
+		return warnings, err
+	}
+	if err := validateDurations(accessDurationFields(&obj.Spec), nil); err != nil {
+		return warnings, err
+	}
+
+	if err := v.validateSecretNameUnique(ctx, obj); err != nil {
+		return warnings, err
+	}
+
+	if err := v.validateRotationOverride(ctx, obj); err != nil {
+		return warnings, err
+	}
+
+	if err := v.validateSecretKeys(ctx, obj); err != nil {
+		return warnings, err
+	}
+
+	if err := v.validateNamespaceAllowed(ctx, obj); err != nil {
+		return warnings, err
+	}
+
+	if err := provisioner.ValidateSecretReclaimPolicy(obj); err != nil {
+		return warnings, err
+	}
 
 	// Reject if a secret with spec.secretName already exists in the namespace but is
 	// not managed by llmwarden. Allowing CreateOrUpdate to overwrite an unmanaged secret
@@ -154,7 +290,7 @@ func (v *LLMAccessCustomValidator) ValidateCreate(ctx context.Context, obj *llmw
 		}, existing)
 		if err == nil {
 			// Secret exists — check for the managed-by label
-			if existing.Labels["llmwarden.io/managed-by"] != "llmwarden" {
+			if existing.Labels[labelkeys.ManagedBy()] != labelkeys.ManagedByValue {
 				return warnings, fmt.Errorf(
 					"secret %q already exists in namespace %q and is not managed by llmwarden; "+
 						"choose a different spec.secretName or remove the existing secret first",
@@ -169,6 +305,244 @@ func (v *LLMAccessCustomValidator) ValidateCreate(ctx context.Context, obj *llmw
 	return warnings, nil
 }
 
+// validateEnvConflicts rejects injection configs whose env vars would resolve
+// ambiguously in the pod: two env mappings with the same name inject two values for one
+// variable, and which one a container sees depends on their order.
+func validateEnvConflicts(injection llmwardenv1alpha1.InjectionConfig) error {
+	seen := make(map[string]int, len(injection.Env))
+	for idx, envMapping := range injection.Env {
+		if first, ok := seen[envMapping.Name]; ok {
+			return fmt.Errorf("spec.injection.env[%d].name %q duplicates spec.injection.env[%d]; each env var can only be injected once",
+				idx, envMapping.Name, first)
+		}
+		seen[envMapping.Name] = idx
+	}
+	return nil
+}
+
+// validateEnvFile rejects an env file that would be empty, since it only holds the env
+// mappings, or mounted at a relative path.
+func validateEnvFile(injection llmwardenv1alpha1.InjectionConfig) error {
+	if injection.EnvFile == nil {
+		return nil
+	}
+	if len(injection.Env) == 0 {
+		return fmt.Errorf("spec.injection.envFile requires spec.injection.env mappings to write to the file")
+	}
+	if mountPath := injection.EnvFile.MountPath; mountPath != "" && mountPath[0] != '/' {
+		return fmt.Errorf("spec.injection.envFile.mountPath must be an absolute path")
+	}
+	return nil
+}
+
+// durationField is a duration string of a spec, such as a rotation interval, with its
+// field path.
+type durationField struct {
+	path  string
+	value *string
+}
+
+// accessDurationFields returns the duration fields spec sets.
+func accessDurationFields(spec *llmwardenv1alpha1.LLMAccessSpec) []durationField {
+	var fields []durationField
+	add := func(path string, value *string) {
+		if *value != "" {
+			fields = append(fields, durationField{path: path, value: value})
+		}
+	}
+	add("spec.ttl", &spec.TTL)
+	if lazy := spec.Injection.LazyProvisioning; lazy != nil {
+		add("spec.injection.lazyProvisioning.idleGracePeriod", &lazy.IdleGracePeriod)
+	}
+	if rotation := spec.Rotation; rotation != nil {
+		add("spec.rotation.interval", &rotation.Interval)
+		if window := rotation.MaintenanceWindow; window != nil {
+			add("spec.rotation.maintenanceWindow.duration", &window.Duration)
+		}
+	}
+	return fields
+}
+
+// normalizeDurations rewrites each valid duration in fields to its canonical form, e.g.
+// "07d" to "7d". Invalid durations are left for the validator to reject.
+func normalizeDurations(fields []durationField) {
+	for _, field := range fields {
+		if normalized, err := duration.Normalize(*field.value); err == nil {
+			*field.value = normalized
+		}
+	}
+}
+
+// validateDurations rejects durations the controller can't parse, which the CRD pattern
+// admits but the controller would otherwise ignore or report only at reconcile time:
+// zero, overflowing and out-of-range values. A field with the same value in oldFields is
+// not checked, so an object admitted before this validation existed can still be updated.
+func validateDurations(fields, oldFields []durationField) error {
+	admitted := make(map[string]string, len(oldFields))
+	for _, field := range oldFields {
+		admitted[field.path] = *field.value
+	}
+	for _, field := range fields {
+		if old, ok := admitted[field.path]; ok && old == *field.value {
+			continue
+		}
+		if _, err := duration.Parse(*field.value); err != nil {
+			return fmt.Errorf("%s: %w", field.path, err)
+		}
+	}
+	return nil
+}
+
+// validateModelList enforces the spec.models policy: an empty list is allowed and grants
+// every model the provider allows, while listed models must be non-empty and unique.
+func validateModelList(models []string) (admission.Warnings, error) {
+	if len(models) == 0 {
+		return admission.Warnings{"spec.models is empty: the access is granted every model allowed by the provider"}, nil
+	}
+	seen := make(map[string]bool, len(models))
+	for i, model := range models {
+		if model == "" {
+			return nil, fmt.Errorf("spec.models[%d] cannot be empty", i)
+		}
+		if seen[model] {
+			return nil, fmt.Errorf("spec.models contains duplicate model %q", model)
+		}
+		seen[model] = true
+	}
+	return nil, nil
+}
+
+// validateRotationOverride rejects obj if it sets spec.rotation.interval while its provider
+// has rotation.allowOverride=false. A missing provider is left to the reconciler to report.
+func (v *LLMAccessCustomValidator) validateRotationOverride(ctx context.Context, obj *llmwardenv1alpha1.LLMAccess) error {
+	if v.Client == nil || obj.Spec.Rotation == nil || obj.Spec.Rotation.Interval == "" {
+		return nil
+	}
+
+	provider, err := provisioner.ResolveProvider(ctx, v.Client, obj)
+	if err != nil {
+		if apierrors.IsNotFound(err) || errors.Is(err, provisioner.ErrProviderOutOfNamespace) {
+			return nil
+		}
+		return err
+	}
+	if provider.Spec.Auth.APIKey == nil || provider.Spec.Auth.APIKey.Rotation == nil {
+		return nil
+	}
+	if allow := provider.Spec.Auth.APIKey.Rotation.AllowOverride; allow != nil && !*allow {
+		return fmt.Errorf("spec.rotation.interval cannot be set: LLMProvider %q pins rotation to its own interval (rotation.allowOverride=false)",
+			provider.Name)
+	}
+	return nil
+}
+
+// validateSecretKeys rejects env mappings whose secretKey the provider's access secrets
+// never carry (see provisioner.ProducedSecretKeys), which would leave pods failing to
+// start on a missing secret key. Providers without a known key set are not checked.
+func (v *LLMAccessCustomValidator) validateSecretKeys(ctx context.Context, obj *llmwardenv1alpha1.LLMAccess) error {
+	if v.Client == nil || len(obj.Spec.Injection.Env) == 0 {
+		return nil
+	}
+
+	provider, err := provisioner.ResolveProvider(ctx, v.Client, obj)
+	if err != nil {
+		if apierrors.IsNotFound(err) || errors.Is(err, provisioner.ErrProviderOutOfNamespace) {
+			return nil
+		}
+		return err
+	}
+	produced := provisioner.ProducedSecretKeys(provider)
+	if len(produced) == 0 {
+		return nil
+	}
+	for idx, envMapping := range obj.Spec.Injection.Env {
+		// An empty secretKey is defaulted to the credential key.
+		if envMapping.SecretKey == "" || slices.Contains(produced, envMapping.SecretKey) {
+			continue
+		}
+		return fmt.Errorf("spec.injection.env[%d].secretKey %q is not produced by %s %q (produces: %s); "+
+			"declare extra keys in the provider's spec.producedSecretKeys",
+			idx, envMapping.SecretKey, providerKind(obj.Spec.ProviderRef), provider.Name, strings.Join(produced, ", "))
+	}
+	return nil
+}
+
+// validateNamespaceAllowed rejects obj if its namespace doesn't match the provider's
+// spec.namespaceSelector, which the reconciler would otherwise only report afterwards as
+// a NamespaceNotAllowed Ready condition. A missing provider or namespace is left to the
+// reconciler.
+func (v *LLMAccessCustomValidator) validateNamespaceAllowed(ctx context.Context, obj *llmwardenv1alpha1.LLMAccess) error {
+	if v.Client == nil || obj.Namespace == "" {
+		return nil
+	}
+
+	provider, err := provisioner.ResolveProvider(ctx, v.Client, obj)
+	if err != nil {
+		if apierrors.IsNotFound(err) || errors.Is(err, provisioner.ErrProviderOutOfNamespace) {
+			return nil
+		}
+		return err
+	}
+	if provider.Spec.NamespaceSelector == nil {
+		return nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(provider.Spec.NamespaceSelector)
+	if err != nil {
+		return fmt.Errorf("%s %q has an invalid spec.namespaceSelector: %w", providerKind(obj.Spec.ProviderRef), provider.Name, err)
+	}
+
+	ns := &corev1.Namespace{}
+	if err := v.Client.Get(ctx, types.NamespacedName{Name: obj.Namespace}, ns); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("getting namespace %q: %w", obj.Namespace, err)
+	}
+	if selector.Matches(labels.Set(ns.Labels)) {
+		return nil
+	}
+	return &apierrors.StatusError{ErrStatus: metav1.Status{
+		Status: metav1.StatusFailure,
+		Code:   http.StatusForbidden,
+		Reason: ReasonNamespaceNotAllowed,
+		Message: fmt.Sprintf("namespace %q is not allowed by the spec.namespaceSelector of %s %q",
+			obj.Namespace, providerKind(obj.Spec.ProviderRef), provider.Name),
+	}}
+}
+
+// validateSecretNameUnique rejects obj if another LLMAccess in the same namespace already
+// writes to spec.secretName. Two accesses sharing a target secret would overwrite each
+// other on every reconcile and fight over the secret's controller owner reference.
+func (v *LLMAccessCustomValidator) validateSecretNameUnique(ctx context.Context, obj *llmwardenv1alpha1.LLMAccess) error {
+	if v.Client == nil || obj.Namespace == "" {
+		return nil
+	}
+
+	accessList := &llmwardenv1alpha1.LLMAccessList{}
+	if err := v.Client.List(ctx, accessList, client.InNamespace(obj.Namespace)); err != nil {
+		return fmt.Errorf("listing LLMAccess in namespace %q: %w", obj.Namespace, err)
+	}
+	for _, other := range accessList.Items {
+		if other.Name == obj.Name {
+			continue
+		}
+		if other.Spec.SecretName == obj.Spec.SecretName {
+			return fmt.Errorf("spec.secretName %q is already used by LLMAccess %q in namespace %q",
+				obj.Spec.SecretName, other.Name, obj.Namespace)
+		}
+	}
+	return nil
+}
+
+// providerKind returns the kind of provider ref points at, treating an unset kind as the
+// LLMProvider default.
+func providerKind(ref llmwardenv1alpha1.ProviderReference) llmwardenv1alpha1.ProviderKind {
+	if ref.Kind == "" {
+		return llmwardenv1alpha1.ProviderKindLLMProvider
+	}
+	return ref.Kind
+}
+
 // isValidEnvVarName validates environment variable names according to POSIX standard
 func isValidEnvVarName(name string) bool {
 	if len(name) == 0 {
@@ -189,17 +563,77 @@ func isValidEnvVarName(name string) bool {
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type LLMAccess.
-func (v *LLMAccessCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj *llmwardenv1alpha1.LLMAccess) (admission.Warnings, error) {
+func (v *LLMAccessCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj *llmwardenv1alpha1.LLMAccess) (admission.Warnings, error) {
 	llmaccesslog.Info("Validation for LLMAccess upon update", "name", newObj.GetName())
 
+	if !namespaceWatched(v.WatchNamespaces, newObj.Namespace) {
+		return nil, nil
+	}
+	if v.validationBypassed(ctx, newObj.Namespace) {
+		llmaccesslog.Info("Skipping validation in bypassed namespace", "name", newObj.GetName(), "namespace", newObj.Namespace)
+		return nil, nil
+	}
+
 	// providerRef is immutable: changing the provider would leave orphaned secrets and is
 	// semantically equivalent to deleting and recreating the LLMAccess. Require delete/recreate.
 	if oldObj.Spec.ProviderRef.Name != newObj.Spec.ProviderRef.Name {
 		return nil, fmt.Errorf("spec.providerRef.name is immutable: cannot change from %q to %q; delete and recreate the LLMAccess instead",
 			oldObj.Spec.ProviderRef.Name, newObj.Spec.ProviderRef.Name)
 	}
+	if oldKind, newKind := providerKind(oldObj.Spec.ProviderRef), providerKind(newObj.Spec.ProviderRef); oldKind != newKind {
+		return nil, fmt.Errorf("spec.providerRef.kind is immutable: cannot change from %q to %q; delete and recreate the LLMAccess instead",
+			oldKind, newKind)
+	}
 
-	return nil, nil
+	// Only changed fields are checked, so accesses admitted before a check existed can still
+	// be updated, e.g. by the controller removing its finalizer.
+	var warnings admission.Warnings
+	if !slices.Equal(oldObj.Spec.Models, newObj.Spec.Models) {
+		var err error
+		if warnings, err = validateModelList(newObj.Spec.Models); err != nil {
+			return warnings, err
+		}
+	}
+
+	if !equality.Semantic.DeepEqual(oldObj.Spec.Injection.Env, newObj.Spec.Injection.Env) {
+		if err := validateEnvConflicts(newObj.Spec.Injection); err != nil {
+			return warnings, err
+		}
+	}
+	if err := validateEnvFile(newObj.Spec.Injection); err != nil {
+		return warnings, err
+	}
+	if err := validateDurations(accessDurationFields(&newObj.Spec), accessDurationFields(&oldObj.Spec)); err != nil {
+		return warnings, err
+	}
+
+	if oldObj.Spec.SecretName != newObj.Spec.SecretName {
+		if err := v.validateSecretNameUnique(ctx, newObj); err != nil {
+			return warnings, err
+		}
+	}
+
+	// A provider pinning rotation later must not block updates of existing overrides, nor
+	// the finalizer removal of one being deleted.
+	if newObj.DeletionTimestamp.IsZero() && !equality.Semantic.DeepEqual(oldObj.Spec.Rotation, newObj.Spec.Rotation) {
+		if err := v.validateRotationOverride(ctx, newObj); err != nil {
+			return warnings, err
+		}
+	}
+
+	// Only changed mappings are checked, so a provider change can't block unrelated
+	// updates such as the controller removing its finalizer.
+	if !equality.Semantic.DeepEqual(oldObj.Spec.Injection.Env, newObj.Spec.Injection.Env) {
+		if err := v.validateSecretKeys(ctx, newObj); err != nil {
+			return warnings, err
+		}
+	}
+
+	if err := provisioner.ValidateSecretReclaimPolicy(newObj); err != nil {
+		return warnings, err
+	}
+
+	return warnings, nil
 }
 
 // ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type LLMAccess.
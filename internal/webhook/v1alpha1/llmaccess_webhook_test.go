@@ -17,11 +17,16 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
-	// TODO (user): Add any additional imports if needed
+	"github.com/llmwarden/llmwarden/internal/provisioner"
 )
 
 var _ = Describe("LLMAccess Webhook", func() {
@@ -37,7 +42,7 @@ var _ = Describe("LLMAccess Webhook", func() {
 		oldObj = &llmwardenv1alpha1.LLMAccess{}
 		validator = LLMAccessCustomValidator{Client: k8sClient}
 		Expect(validator).NotTo(BeNil(), "Expected validator to be initialized")
-		defaulter = LLMAccessCustomDefaulter{}
+		defaulter = LLMAccessCustomDefaulter{Client: k8sClient}
 		Expect(defaulter).NotTo(BeNil(), "Expected defaulter to be initialized")
 		Expect(oldObj).NotTo(BeNil(), "Expected oldObj to be initialized")
 		Expect(obj).NotTo(BeNil(), "Expected obj to be initialized")
@@ -48,16 +53,65 @@ var _ = Describe("LLMAccess Webhook", func() {
 	})
 
 	Context("When creating LLMAccess under Defaulting Webhook", func() {
-		// TODO (user): Add logic for defaulting webhooks
-		// Example:
-		// It("Should apply defaults when a required field is empty", func() {
-		//     By("simulating a scenario where defaults should be applied")
-		//     obj.SomeFieldWithDefault = ""
-		//     By("calling the Default method to apply defaults")
-		//     defaulter.Default(ctx, obj)
-		//     By("checking that the default values are set")
-		//     Expect(obj.SomeFieldWithDefault).To(Equal("default_value"))
-		// })
+		It("Should default empty env secretKeys to the standard credential key", func() {
+			obj.Spec.ProviderRef.Name = "missing-provider"
+			obj.Spec.Injection.Env = []llmwardenv1alpha1.EnvVarMapping{
+				{Name: "OPENAI_API_KEY"},
+				{Name: "OPENAI_BASE_URL", SecretKey: "baseUrl"},
+			}
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.Injection.Env[0].SecretKey).To(Equal(provisioner.DefaultCredentialKey))
+			Expect(obj.Spec.Injection.Env[1].SecretKey).To(Equal("baseUrl"))
+		})
+
+		It("Should default empty env secretKeys to the provider's target key", func() {
+			provider := &llmwardenv1alpha1.LLMProvider{
+				ObjectMeta: metav1.ObjectMeta{Name: "target-key-provider"},
+				Spec: llmwardenv1alpha1.LLMProviderSpec{
+					Provider: llmwardenv1alpha1.ProviderOpenAI,
+					Auth: llmwardenv1alpha1.AuthConfig{
+						Type:      llmwardenv1alpha1.AuthTypeAPIKey,
+						TargetKey: "OPENAI_API_KEY",
+						APIKey: &llmwardenv1alpha1.APIKeyAuth{
+							SecretRef: llmwardenv1alpha1.SecretReference{
+								Name:      "openai-key",
+								Namespace: "default",
+								Key:       "api-key",
+							},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, provider)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, ctx, provider)
+
+			obj.Spec.ProviderRef.Name = provider.Name
+			obj.Spec.Injection.Env = []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY"}}
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.Injection.Env[0].SecretKey).To(Equal("OPENAI_API_KEY"))
+		})
+
+		It("Should strip leading zeros from durations", func() {
+			obj.Spec.ProviderRef.Name = "missing-provider"
+			obj.Spec.TTL = "07d"
+			obj.Spec.Injection.LazyProvisioning = &llmwardenv1alpha1.LazyProvisioningConfig{IdleGracePeriod: "030m"}
+			obj.Spec.Rotation = &llmwardenv1alpha1.AccessRotationConfig{
+				Interval:          "7d",
+				MaintenanceWindow: &llmwardenv1alpha1.MaintenanceWindow{Start: "02:00", Duration: "02h"},
+			}
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.TTL).To(Equal("7d"))
+			Expect(obj.Spec.Injection.LazyProvisioning.IdleGracePeriod).To(Equal("30m"))
+			Expect(obj.Spec.Rotation.Interval).To(Equal("7d"))
+			Expect(obj.Spec.Rotation.MaintenanceWindow.Duration).To(Equal("2h"))
+		})
+
+		It("Should leave invalid durations for the validator", func() {
+			obj.Spec.ProviderRef.Name = "missing-provider"
+			obj.Spec.TTL = "0d"
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.TTL).To(Equal("0d"))
+		})
 	})
 
 	Context("When creating or updating LLMAccess under Validating Webhook", func() {
@@ -82,6 +136,37 @@ var _ = Describe("LLMAccess Webhook", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("Should deny durations the controller can't parse", func() {
+			obj.Spec.ProviderRef.Name = "openai-prod"
+			obj.Spec.SecretName = "my-secret"
+			obj.Spec.Injection.Env = []llmwardenv1alpha1.EnvVarMapping{
+				{Name: "OPENAI_API_KEY", SecretKey: "apiKey"},
+			}
+			for _, interval := range []string{"0d", "000h", "99999999999999999999d", "366d"} {
+				obj.Spec.Rotation = &llmwardenv1alpha1.AccessRotationConfig{Interval: interval}
+				_, err := validator.ValidateCreate(ctx, obj)
+				Expect(err).To(MatchError(ContainSubstring("spec.rotation.interval")), interval)
+			}
+
+			obj.Spec.Rotation = nil
+			obj.Spec.TTL = "0m"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.ttl")))
+		})
+
+		It("Should only check changed durations on update", func() {
+			oldObj.Spec.ProviderRef.Name = "openai-prod"
+			oldObj.Spec.TTL = "0d"
+			obj = oldObj.DeepCopy()
+			obj.Spec.SecretName = "new-secret-name"
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			obj.Spec.TTL = "400d"
+			_, err = validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.ttl")))
+		})
+
 		It("Should deny update when providerRef.name changes", func() {
 			oldObj.Spec.ProviderRef.Name = "openai-prod"
 			obj.Spec.ProviderRef.Name = "anthropic-prod"
@@ -98,6 +183,474 @@ var _ = Describe("LLMAccess Webhook", func() {
 			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
 			Expect(err).NotTo(HaveOccurred())
 		})
+
+		It("Should admit creation with an empty model list and warn that all models are granted", func() {
+			obj.Spec.ProviderRef.Name = "openai-prod"
+			obj.Spec.SecretName = "my-secret"
+			obj.Spec.Injection.Env = []llmwardenv1alpha1.EnvVarMapping{
+				{Name: "OPENAI_API_KEY", SecretKey: "apiKey"},
+			}
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElement(ContainSubstring("spec.models is empty")))
+		})
+
+		It("Should admit creation with single and multiple models", func() {
+			obj.Spec.ProviderRef.Name = "openai-prod"
+			obj.Spec.SecretName = "my-secret"
+			obj.Spec.Injection.Env = []llmwardenv1alpha1.EnvVarMapping{
+				{Name: "OPENAI_API_KEY", SecretKey: "apiKey"},
+			}
+			for _, models := range [][]string{{"gpt-4o"}, {"gpt-4o", "gpt-4o-mini"}} {
+				obj.Spec.Models = models
+				warnings, err := validator.ValidateCreate(ctx, obj)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(BeEmpty())
+			}
+		})
+
+		It("Should deny creation with empty or duplicate model names", func() {
+			obj.Spec.ProviderRef.Name = "openai-prod"
+			obj.Spec.SecretName = "my-secret"
+			obj.Spec.Injection.Env = []llmwardenv1alpha1.EnvVarMapping{
+				{Name: "OPENAI_API_KEY", SecretKey: "apiKey"},
+			}
+			obj.Spec.Models = []string{"gpt-4o", ""}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.models[1]"))
+
+			obj.Spec.Models = []string{"gpt-4o", "gpt-4o"}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("duplicate"))
+		})
+
+		It("Should admit finalizer removal from an access with a legacy invalid model list", func() {
+			oldObj.Spec.ProviderRef.Name = "openai-prod"
+			oldObj.Spec.SecretName = "my-secret"
+			oldObj.Spec.Models = []string{"gpt-4o", "gpt-4o", ""}
+			oldObj.Finalizers = []string{"llmwarden.io/finalizer"}
+			now := metav1.Now()
+			oldObj.DeletionTimestamp = &now
+			obj = oldObj.DeepCopy()
+			obj.Finalizers = nil
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			obj.Spec.Models = []string{"gpt-4o", "gpt-4o-mini", "gpt-4o-mini"}
+			_, err = validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("duplicate")))
+		})
+
+		It("Should deny creation and update with duplicate env var names", func() {
+			obj.Spec.ProviderRef.Name = "openai-prod"
+			obj.Spec.SecretName = "my-secret"
+			obj.Spec.Injection.Env = []llmwardenv1alpha1.EnvVarMapping{
+				{Name: "OPENAI_API_KEY", SecretKey: "apiKey"},
+				{Name: "OPENAI_BASE_URL", SecretKey: "baseUrl"},
+				{Name: "OPENAI_API_KEY", SecretKey: "orgId"},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`spec.injection.env[2].name "OPENAI_API_KEY" duplicates spec.injection.env[0]`))
+
+			oldObj.Spec.ProviderRef.Name = "openai-prod"
+			_, err = validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("duplicates"))
+
+			// An access admitted with duplicates before the check existed can still be updated.
+			oldObj = obj.DeepCopy()
+			obj.Finalizers = nil
+			_, err = validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should admit distinct env var names combined with a volume", func() {
+			obj.Spec.ProviderRef.Name = "openai-prod"
+			obj.Spec.SecretName = "my-secret"
+			obj.Spec.Injection.Env = []llmwardenv1alpha1.EnvVarMapping{
+				{Name: "OPENAI_API_KEY", SecretKey: "apiKey"},
+				{Name: "OPENAI_BASE_URL", SecretKey: "baseUrl"},
+			}
+			obj.Spec.Injection.Volume = &llmwardenv1alpha1.VolumeInjection{MountPath: "/etc/llmwarden/openai"}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny an env file without env mappings or with a relative mount path", func() {
+			obj.Spec.ProviderRef.Name = "openai-prod"
+			obj.Spec.SecretName = "my-secret"
+			obj.Spec.Injection.Volume = &llmwardenv1alpha1.VolumeInjection{MountPath: "/etc/llmwarden/openai"}
+			obj.Spec.Injection.EnvFile = &llmwardenv1alpha1.EnvFileInjection{}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.injection.envFile requires spec.injection.env"))
+
+			obj.Spec.Injection.Env = []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}}
+			obj.Spec.Injection.EnvFile.MountPath = "etc/env"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.injection.envFile.mountPath must be an absolute path"))
+
+			obj.Spec.Injection.EnvFile.MountPath = "/etc/env"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("with a provider that configures rotation", func() {
+			var provider *llmwardenv1alpha1.LLMProvider
+
+			newProvider := func(name string, allowOverride *bool) *llmwardenv1alpha1.LLMProvider {
+				return &llmwardenv1alpha1.LLMProvider{
+					ObjectMeta: metav1.ObjectMeta{Name: name},
+					Spec: llmwardenv1alpha1.LLMProviderSpec{
+						Provider: llmwardenv1alpha1.ProviderOpenAI,
+						Auth: llmwardenv1alpha1.AuthConfig{
+							Type: llmwardenv1alpha1.AuthTypeAPIKey,
+							APIKey: &llmwardenv1alpha1.APIKeyAuth{
+								SecretRef: llmwardenv1alpha1.SecretReference{
+									Name:      "openai-key",
+									Namespace: "default",
+									Key:       "api-key",
+								},
+								Rotation: &llmwardenv1alpha1.RotationConfig{
+									Enabled:       true,
+									Interval:      "30d",
+									AllowOverride: allowOverride,
+								},
+							},
+						},
+					},
+				}
+			}
+
+			accessWithRotation := func(providerName, interval string) *llmwardenv1alpha1.LLMAccess {
+				access := &llmwardenv1alpha1.LLMAccess{
+					ObjectMeta: metav1.ObjectMeta{Name: "rotation-access", Namespace: "default"},
+					Spec: llmwardenv1alpha1.LLMAccessSpec{
+						ProviderRef: llmwardenv1alpha1.ProviderReference{Name: providerName},
+						SecretName:  "rotation-secret",
+						Injection: llmwardenv1alpha1.InjectionConfig{
+							Env: []llmwardenv1alpha1.EnvVarMapping{
+								{Name: "OPENAI_API_KEY", SecretKey: "apiKey"},
+							},
+						},
+					},
+				}
+				if interval != "" {
+					access.Spec.Rotation = &llmwardenv1alpha1.AccessRotationConfig{Interval: interval}
+				}
+				return access
+			}
+
+			AfterEach(func() {
+				Expect(k8sClient.Delete(ctx, provider)).To(Succeed())
+			})
+
+			It("Should admit a rotation override when the provider allows it", func() {
+				provider = newProvider("rotation-default", nil)
+				Expect(k8sClient.Create(ctx, provider)).To(Succeed())
+
+				_, err := validator.ValidateCreate(ctx, accessWithRotation(provider.Name, "7d"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("Should deny a rotation override when the provider forbids it", func() {
+				allow := false
+				provider = newProvider("rotation-pinned", &allow)
+				Expect(k8sClient.Create(ctx, provider)).To(Succeed())
+
+				_, err := validator.ValidateCreate(ctx, accessWithRotation(provider.Name, "7d"))
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("allowOverride=false"))
+
+				oldObj = accessWithRotation(provider.Name, "")
+				_, err = validator.ValidateUpdate(ctx, oldObj, accessWithRotation(provider.Name, "7d"))
+				Expect(err).To(HaveOccurred())
+
+				By("admitting updates of an override admitted before the provider pinned rotation")
+				oldObj = accessWithRotation(provider.Name, "7d")
+				obj = oldObj.DeepCopy()
+				obj.Spec.Models = []string{"gpt-4o"}
+				_, err = validator.ValidateUpdate(ctx, oldObj, obj)
+				Expect(err).NotTo(HaveOccurred())
+
+				obj.Spec.Rotation.Interval = "14d"
+				_, err = validator.ValidateUpdate(ctx, oldObj, obj)
+				Expect(err).To(MatchError(ContainSubstring("allowOverride=false")))
+
+				now := metav1.Now()
+				oldObj.DeletionTimestamp = &now
+				oldObj.Finalizers = []string{"llmwarden.io/finalizer"}
+				obj = oldObj.DeepCopy()
+				obj.Finalizers = nil
+				obj.Spec.Rotation.Interval = "14d"
+				_, err = validator.ValidateUpdate(ctx, oldObj, obj)
+				Expect(err).NotTo(HaveOccurred())
+
+				By("admitting accesses that leave rotation to the provider")
+				_, err = validator.ValidateCreate(ctx, accessWithRotation(provider.Name, ""))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("with a provider that declares produced secret keys", func() {
+			var provider *llmwardenv1alpha1.LLMProvider
+
+			BeforeEach(func() {
+				provider = &llmwardenv1alpha1.LLMProvider{
+					ObjectMeta: metav1.ObjectMeta{Name: "openai-contract"},
+					Spec: llmwardenv1alpha1.LLMProviderSpec{
+						Provider: llmwardenv1alpha1.ProviderOpenAI,
+						Auth: llmwardenv1alpha1.AuthConfig{
+							Type:      llmwardenv1alpha1.AuthTypeAPIKey,
+							TargetKey: "token",
+							APIKey: &llmwardenv1alpha1.APIKeyAuth{
+								SecretRef: llmwardenv1alpha1.SecretReference{Name: "openai-key", Namespace: "default", Key: "api-key"},
+							},
+						},
+						ProducedSecretKeys: []string{"orgId"},
+					},
+				}
+				Expect(k8sClient.Create(ctx, provider)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(k8sClient.Delete(ctx, provider)).To(Succeed())
+			})
+
+			accessWithKeys := func(keys ...string) *llmwardenv1alpha1.LLMAccess {
+				access := &llmwardenv1alpha1.LLMAccess{
+					ObjectMeta: metav1.ObjectMeta{Name: "contract-access", Namespace: "default"},
+					Spec: llmwardenv1alpha1.LLMAccessSpec{
+						ProviderRef: llmwardenv1alpha1.ProviderReference{Name: provider.Name},
+						SecretName:  "contract-secret",
+					},
+				}
+				for idx, key := range keys {
+					access.Spec.Injection.Env = append(access.Spec.Injection.Env,
+						llmwardenv1alpha1.EnvVarMapping{Name: fmt.Sprintf("VAR_%d", idx), SecretKey: key})
+				}
+				return access
+			}
+
+			It("Should admit provisioned and declared keys", func() {
+				_, err := validator.ValidateCreate(ctx, accessWithKeys("token", "provider", "orgId"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("Should deny keys the provider never produces", func() {
+				_, err := validator.ValidateCreate(ctx, accessWithKeys("token", "apiKey"))
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(`env[1].secretKey "apiKey"`))
+
+				By("checking updates that change the env mappings")
+				_, err = validator.ValidateUpdate(ctx, accessWithKeys("token"), accessWithKeys("token", "region"))
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(`"region"`))
+			})
+
+			It("Should admit updates that leave existing env mappings unchanged", func() {
+				oldObj = accessWithKeys("region")
+				obj = accessWithKeys("region")
+				obj.Finalizers = []string{"llmwarden.io/finalizer"}
+				_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("with a provider that restricts namespaces", func() {
+			var (
+				provider          *llmwardenv1alpha1.LLMProvider
+				allowed, rejected *corev1.Namespace
+			)
+
+			BeforeEach(func() {
+				allowed = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "selector-allowed-",
+					Labels:       map[string]string{"llmwarden.io/tier": "ai"},
+				}}
+				Expect(k8sClient.Create(ctx, allowed)).To(Succeed())
+				rejected = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{GenerateName: "selector-rejected-"}}
+				Expect(k8sClient.Create(ctx, rejected)).To(Succeed())
+
+				provider = &llmwardenv1alpha1.LLMProvider{
+					ObjectMeta: metav1.ObjectMeta{Name: "openai-restricted"},
+					Spec: llmwardenv1alpha1.LLMProviderSpec{
+						Provider: llmwardenv1alpha1.ProviderOpenAI,
+						Auth: llmwardenv1alpha1.AuthConfig{
+							Type: llmwardenv1alpha1.AuthTypeAPIKey,
+							APIKey: &llmwardenv1alpha1.APIKeyAuth{
+								SecretRef: llmwardenv1alpha1.SecretReference{Name: "openai-key", Namespace: "default", Key: "api-key"},
+							},
+						},
+						NamespaceSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"llmwarden.io/tier": "ai"},
+						},
+					},
+				}
+				Expect(k8sClient.Create(ctx, provider)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(k8sClient.Delete(ctx, provider)).To(Succeed())
+				_ = k8sClient.Delete(ctx, allowed)
+				_ = k8sClient.Delete(ctx, rejected)
+			})
+
+			accessIn := func(namespace string) *llmwardenv1alpha1.LLMAccess {
+				return &llmwardenv1alpha1.LLMAccess{
+					ObjectMeta: metav1.ObjectMeta{Name: "restricted-access", Namespace: namespace},
+					Spec: llmwardenv1alpha1.LLMAccessSpec{
+						ProviderRef: llmwardenv1alpha1.ProviderReference{Name: provider.Name},
+						SecretName:  "restricted-secret",
+						Injection: llmwardenv1alpha1.InjectionConfig{
+							Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY"}},
+						},
+					},
+				}
+			}
+
+			It("Should admit creation in a namespace the selector matches", func() {
+				_, err := validator.ValidateCreate(ctx, accessIn(allowed.Name))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("Should deny creation in a namespace the selector excludes", func() {
+				_, err := validator.ValidateCreate(ctx, accessIn(rejected.Name))
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.ReasonForError(err)).To(Equal(ReasonNamespaceNotAllowed))
+				Expect(err.Error()).To(ContainSubstring(rejected.Name))
+			})
+		})
+
+		Context("with an existing LLMAccess in the namespace", func() {
+			var existing *llmwardenv1alpha1.LLMAccess
+
+			BeforeEach(func() {
+				existing = &llmwardenv1alpha1.LLMAccess{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "existing-access",
+						Namespace: "default",
+					},
+					Spec: llmwardenv1alpha1.LLMAccessSpec{
+						ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai-prod"},
+						SecretName:  "shared-secret",
+						Injection: llmwardenv1alpha1.InjectionConfig{
+							Env: []llmwardenv1alpha1.EnvVarMapping{
+								{Name: "OPENAI_API_KEY", SecretKey: "apiKey"},
+							},
+						},
+					},
+				}
+				Expect(k8sClient.Create(ctx, existing)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(k8sClient.Delete(ctx, existing)).To(Succeed())
+			})
+
+			It("Should deny creation when another LLMAccess uses the same secretName", func() {
+				obj.Name = "conflicting-access"
+				obj.Namespace = "default"
+				obj.Spec = *existing.Spec.DeepCopy()
+				_, err := validator.ValidateCreate(ctx, obj)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("existing-access"))
+			})
+
+			It("Should admit creation with a different secretName", func() {
+				obj.Name = "other-access"
+				obj.Namespace = "default"
+				obj.Spec = *existing.Spec.DeepCopy()
+				obj.Spec.SecretName = "other-secret"
+				_, err := validator.ValidateCreate(ctx, obj)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("Should admit an update that keeps the access's own secretName", func() {
+				oldObj = existing.DeepCopy()
+				obj = existing.DeepCopy()
+				obj.Spec.Models = []string{"gpt-4o"}
+				_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("Should admit updates of an access that already shares the secretName until it is renamed", func() {
+				// Admitted before the check existed; it must stay fixable and deletable.
+				oldObj = existing.DeepCopy()
+				oldObj.Name = "legacy-duplicate"
+				oldObj.Finalizers = []string{"llmwarden.io/finalizer"}
+				obj = oldObj.DeepCopy()
+				obj.Finalizers = nil
+				_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+				Expect(err).NotTo(HaveOccurred())
+
+				obj.Spec.SecretName = "legacy-secret"
+				_, err = validator.ValidateUpdate(ctx, oldObj, obj)
+				Expect(err).NotTo(HaveOccurred())
+
+				oldObj.Spec.SecretName = "legacy-secret"
+				obj.Spec.SecretName = existing.Spec.SecretName
+				_, err = validator.ValidateUpdate(ctx, oldObj, obj)
+				Expect(err).To(MatchError(ContainSubstring("existing-access")))
+			})
+
+			It("Should ignore an LLMAccess outside the watched namespaces", func() {
+				validator.WatchNamespaces = []string{"team-a"}
+				obj.Name = "conflicting-access"
+				obj.Namespace = "default"
+				obj.Spec = *existing.Spec.DeepCopy()
+				_, err := validator.ValidateCreate(ctx, obj)
+				Expect(err).NotTo(HaveOccurred())
+
+				validator.WatchNamespaces = []string{"default"}
+				_, err = validator.ValidateCreate(ctx, obj)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("in a namespace labeled to skip validation", func() {
+			var bypassed *corev1.Namespace
+
+			BeforeEach(func() {
+				bypassed = &corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						GenerateName: "validation-bypass-",
+						Labels:       map[string]string{ValidationBypassLabel(): "true"},
+					},
+				}
+				Expect(k8sClient.Create(ctx, bypassed)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				_ = k8sClient.Delete(ctx, bypassed)
+			})
+
+			It("Should admit an invalid LLMAccess without validating it", func() {
+				obj.Name = "invalid-access"
+				obj.Namespace = bypassed.Name
+				_, err := validator.ValidateCreate(ctx, obj)
+				Expect(err).NotTo(HaveOccurred())
+
+				oldObj = obj.DeepCopy()
+				oldObj.Spec.ProviderRef.Name = "openai-prod"
+				_, err = validator.ValidateUpdate(ctx, oldObj, obj)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("Should still validate when the label is not \"true\"", func() {
+				bypassed.Labels[ValidationBypassLabel()] = "false"
+				Expect(k8sClient.Update(ctx, bypassed)).To(Succeed())
+
+				obj.Name = "invalid-access"
+				obj.Namespace = bypassed.Name
+				_, err := validator.ValidateCreate(ctx, obj)
+				Expect(err).To(HaveOccurred())
+			})
+		})
 	})
 
 })
@@ -0,0 +1,215 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/duration"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var llmproviderlog = logf.Log.WithName("llmprovider-resource")
+
+// SetupLLMProviderWebhookWithManager registers the validating webhook for LLMProvider in the manager.
+// allowedSecretStoreKinds restricts which ESO store kinds externalSecret providers may
+// reference; empty allows every kind.
+func SetupLLMProviderWebhookWithManager(mgr ctrl.Manager, allowedSecretStoreKinds []llmwardenv1alpha1.SecretStoreKind) error {
+	return ctrl.NewWebhookManagedBy(mgr, &llmwardenv1alpha1.LLMProvider{}).
+		WithValidator(&LLMProviderCustomValidator{AllowedSecretStoreKinds: allowedSecretStoreKinds}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-llmwarden-io-v1alpha1-llmprovider,mutating=false,failurePolicy=fail,sideEffects=None,groups=llmwarden.io,resources=llmproviders,verbs=create;update,versions=v1alpha1,name=vllmprovider-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// LLMProviderCustomValidator struct is responsible for validating the LLMProvider resource
+// when it is created or updated.
+type LLMProviderCustomValidator struct {
+	// AllowedSecretStoreKinds, when non-empty, lists the only store kinds externalSecret
+	// providers may reference, e.g. just ClusterSecretStore on clusters that mandate
+	// centrally managed stores.
+	AllowedSecretStoreKinds []llmwardenv1alpha1.SecretStoreKind
+}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type LLMProvider.
+func (v *LLMProviderCustomValidator) ValidateCreate(_ context.Context, obj *llmwardenv1alpha1.LLMProvider) (admission.Warnings, error) {
+	llmproviderlog.Info("Validation for LLMProvider upon creation", "name", obj.GetName())
+
+	fields := providerDurationFields(&obj.Spec)
+	warnings := append(NamespacedStoreWarnings(obj), durationWarnings(fields)...)
+	if err := validateDurations(fields, nil); err != nil {
+		return warnings, err
+	}
+	return warnings, v.validateProviderSpec(obj)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type LLMProvider.
+func (v *LLMProviderCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj *llmwardenv1alpha1.LLMProvider) (admission.Warnings, error) {
+	llmproviderlog.Info("Validation for LLMProvider upon update", "name", newObj.GetName())
+
+	fields := providerDurationFields(&newObj.Spec)
+	warnings := append(NamespacedStoreWarnings(newObj), durationWarnings(fields)...)
+	if err := validateDurations(fields, providerDurationFields(&oldObj.Spec)); err != nil {
+		return warnings, err
+	}
+	return warnings, v.validateProviderSpec(newObj)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type LLMProvider.
+func (v *LLMProviderCustomValidator) ValidateDelete(_ context.Context, obj *llmwardenv1alpha1.LLMProvider) (admission.Warnings, error) {
+	llmproviderlog.Info("Validation for LLMProvider upon deletion", "name", obj.GetName())
+
+	return nil, nil
+}
+
+// validateProviderSpec checks the parts of an LLMProvider spec the CRD schema can't express.
+func (v *LLMProviderCustomValidator) validateProviderSpec(obj *llmwardenv1alpha1.LLMProvider) error {
+	if obj.Spec.Endpoint != nil {
+		if err := validateBaseURL(obj.Spec.Endpoint.BaseURL); err != nil {
+			return fmt.Errorf("spec.endpoint.baseURL: %w", err)
+		}
+	}
+	if err := v.validateStoreKind("spec.auth.externalSecret", obj.Spec.Auth.ExternalSecret); err != nil {
+		return err
+	}
+
+	// Each fallback must be a different strategy from spec.auth and the earlier fallbacks,
+	// with the configuration its type needs.
+	used := map[llmwardenv1alpha1.AuthType]bool{obj.Spec.Auth.Type: true}
+	for i, fallback := range obj.Spec.Auth.Fallbacks {
+		path := fmt.Sprintf("spec.auth.fallbacks[%d]", i)
+		if used[fallback.Type] {
+			return fmt.Errorf("%s.type: %s is already used by spec.auth or an earlier fallback", path, fallback.Type)
+		}
+		used[fallback.Type] = true
+		configured := map[llmwardenv1alpha1.AuthType]bool{
+			llmwardenv1alpha1.AuthTypeAPIKey:           fallback.APIKey != nil,
+			llmwardenv1alpha1.AuthTypeExternalSecret:   fallback.ExternalSecret != nil,
+			llmwardenv1alpha1.AuthTypeWorkloadIdentity: fallback.WorkloadIdentity != nil,
+		}
+		if !configured[fallback.Type] {
+			return fmt.Errorf("%s: %s configuration is required for type %s", path, fallback.Type, fallback.Type)
+		}
+		if err := v.validateStoreKind(path+".externalSecret", fallback.ExternalSecret); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NamespacedStoreWarnings returns a warning for each externalSecret configuration of the
+// provider, in spec.auth or its fallbacks, that references a namespaced SecretStore. The
+// provider is cluster-scoped, so such a store must exist in every access namespace, and
+// accesses in namespaces without it never sync.
+func NamespacedStoreWarnings(provider *llmwardenv1alpha1.LLMProvider) []string {
+	var warnings []string
+	warn := func(path string, es *llmwardenv1alpha1.ExternalSecretAuth) {
+		if es == nil || es.Store.Kind != llmwardenv1alpha1.SecretStoreKindSecretStore {
+			return
+		}
+		warnings = append(warnings, fmt.Sprintf(
+			"%s.store references SecretStore %q, which must exist in every namespace with an LLMAccess for this provider; "+
+				"consider a ClusterSecretStore", path, es.Store.Name))
+	}
+	warn("spec.auth.externalSecret", provider.Spec.Auth.ExternalSecret)
+	for i, fallback := range provider.Spec.Auth.Fallbacks {
+		warn(fmt.Sprintf("spec.auth.fallbacks[%d].externalSecret", i), fallback.ExternalSecret)
+	}
+	return warnings
+}
+
+// providerDurationFields returns the duration fields the API key configurations of spec,
+// in spec.auth or its fallbacks, set.
+func providerDurationFields(spec *llmwardenv1alpha1.LLMProviderSpec) []durationField {
+	var fields []durationField
+	add := func(path string, value *string) {
+		if *value != "" {
+			fields = append(fields, durationField{path: path, value: value})
+		}
+	}
+	addAPIKey := func(path string, apiKey *llmwardenv1alpha1.APIKeyAuth) {
+		if apiKey == nil {
+			return
+		}
+		add(path+".rotationWindow", &apiKey.RotationWindow)
+		if rotation := apiKey.Rotation; rotation != nil {
+			add(path+".rotation.interval", &rotation.Interval)
+			if window := rotation.MaintenanceWindow; window != nil {
+				add(path+".rotation.maintenanceWindow.duration", &window.Duration)
+			}
+		}
+	}
+	addAPIKey("spec.auth.apiKey", spec.Auth.APIKey)
+	for i := range spec.Auth.Fallbacks {
+		addAPIKey(fmt.Sprintf("spec.auth.fallbacks[%d].apiKey", i), spec.Auth.Fallbacks[i].APIKey)
+	}
+	return fields
+}
+
+// durationWarnings returns a warning for each valid duration in fields that isn't in
+// canonical form. LLMProvider has no defaulting webhook to normalize it, as LLMAccess does.
+func durationWarnings(fields []durationField) []string {
+	var warnings []string
+	for _, field := range fields {
+		if normalized, err := duration.Normalize(*field.value); err == nil && normalized != *field.value {
+			warnings = append(warnings, fmt.Sprintf("%s %q has leading zeros; use %q", field.path, *field.value, normalized))
+		}
+	}
+	return warnings
+}
+
+// validateStoreKind rejects an ExternalSecret configuration whose store kind isn't allowed
+// on this cluster.
+func (v *LLMProviderCustomValidator) validateStoreKind(path string, es *llmwardenv1alpha1.ExternalSecretAuth) error {
+	if es != nil && len(v.AllowedSecretStoreKinds) > 0 && !slices.Contains(v.AllowedSecretStoreKinds, es.Store.Kind) {
+		return fmt.Errorf("%s.store.kind: %s is not allowed on this cluster; allowed kinds: %v",
+			path, es.Store.Kind, v.AllowedSecretStoreKinds)
+	}
+	return nil
+}
+
+// validateBaseURL rejects endpoint URLs that would produce a broken baseUrl in every access
+// secret. An empty URL is valid and means the provider default.
+func validateBaseURL(baseURL string) error {
+	if baseURL == "" {
+		return nil
+	}
+	if strings.TrimSpace(baseURL) != baseURL {
+		return fmt.Errorf("%q must not have leading or trailing whitespace", baseURL)
+	}
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return fmt.Errorf("%q is not a valid URL: %w", baseURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("%q must use the http or https scheme", baseURL)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("%q must include a host", baseURL)
+	}
+	return nil
+}
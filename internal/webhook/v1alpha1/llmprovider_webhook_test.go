@@ -0,0 +1,265 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+)
+
+var _ = Describe("LLMProvider Webhook", func() {
+	var (
+		obj       *llmwardenv1alpha1.LLMProvider
+		validator LLMProviderCustomValidator
+	)
+
+	BeforeEach(func() {
+		obj = &llmwardenv1alpha1.LLMProvider{
+			Spec: llmwardenv1alpha1.LLMProviderSpec{Provider: llmwardenv1alpha1.ProviderOpenAI},
+		}
+		validator = LLMProviderCustomValidator{}
+	})
+
+	Context("When validating the endpoint base URL", func() {
+		It("Should admit https URLs", func() {
+			for _, baseURL := range []string{"https://api.openai.com/v1", "http://llm-proxy.ai-gateway.svc:8080"} {
+				obj.Spec.Endpoint = &llmwardenv1alpha1.EndpointConfig{BaseURL: baseURL}
+				_, err := validator.ValidateCreate(ctx, obj)
+				Expect(err).NotTo(HaveOccurred(), baseURL)
+			}
+		})
+
+		It("Should admit an empty URL so the provider default is used", func() {
+			obj.Spec.Endpoint = &llmwardenv1alpha1.EndpointConfig{}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			obj.Spec.Endpoint = nil
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should reject a scheme-less URL", func() {
+			obj.Spec.Endpoint = &llmwardenv1alpha1.EndpointConfig{BaseURL: "api.openai.com/v1"}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("http or https scheme")))
+		})
+
+		It("Should reject a mistyped scheme, a missing host and surrounding whitespace", func() {
+			for _, baseURL := range []string{"htp://api.openai.com", "https:///v1", "https://api.openai.com/v1 "} {
+				obj.Spec.Endpoint = &llmwardenv1alpha1.EndpointConfig{BaseURL: baseURL}
+				_, err := validator.ValidateCreate(ctx, obj)
+				Expect(err).To(HaveOccurred(), baseURL)
+				Expect(err.Error()).To(ContainSubstring("spec.endpoint.baseURL"))
+			}
+		})
+
+		It("Should validate the URL on update", func() {
+			oldObj := obj.DeepCopy()
+			obj.Spec.Endpoint = &llmwardenv1alpha1.EndpointConfig{BaseURL: "htp://api.openai.com"}
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("When the allowed secret store kinds are restricted", func() {
+		BeforeEach(func() {
+			obj.Spec.Auth = llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeExternalSecret,
+				ExternalSecret: &llmwardenv1alpha1.ExternalSecretAuth{
+					Store:     llmwardenv1alpha1.StoreReference{Name: "vault"},
+					RemoteRef: llmwardenv1alpha1.RemoteReference{Key: "secret/openai"},
+				},
+			}
+			validator.AllowedSecretStoreKinds = []llmwardenv1alpha1.SecretStoreKind{
+				llmwardenv1alpha1.SecretStoreKindClusterSecretStore,
+			}
+		})
+
+		It("Should admit a permitted store kind", func() {
+			obj.Spec.Auth.ExternalSecret.Store.Kind = llmwardenv1alpha1.SecretStoreKindClusterSecretStore
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should reject a forbidden store kind on create and update", func() {
+			obj.Spec.Auth.ExternalSecret.Store.Kind = llmwardenv1alpha1.SecretStoreKindSecretStore
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.auth.externalSecret.store.kind")))
+
+			_, err = validator.ValidateUpdate(ctx, obj.DeepCopy(), obj)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("Should admit every store kind when unrestricted", func() {
+			validator.AllowedSecretStoreKinds = nil
+			obj.Spec.Auth.ExternalSecret.Store.Kind = llmwardenv1alpha1.SecretStoreKindSecretStore
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should reject a forbidden store kind in a fallback", func() {
+			obj.Spec.Auth = llmwardenv1alpha1.AuthConfig{
+				Type:             llmwardenv1alpha1.AuthTypeWorkloadIdentity,
+				WorkloadIdentity: &llmwardenv1alpha1.WorkloadIdentityAuth{},
+				Fallbacks: []llmwardenv1alpha1.AuthFallback{{
+					Type: llmwardenv1alpha1.AuthTypeExternalSecret,
+					ExternalSecret: &llmwardenv1alpha1.ExternalSecretAuth{
+						Store:     llmwardenv1alpha1.StoreReference{Name: "vault", Kind: llmwardenv1alpha1.SecretStoreKindSecretStore},
+						RemoteRef: llmwardenv1alpha1.RemoteReference{Key: "secret/openai"},
+					},
+				}},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.auth.fallbacks[0].externalSecret.store.kind")))
+		})
+	})
+
+	Context("When the provider references a namespaced SecretStore", func() {
+		BeforeEach(func() {
+			obj.Spec.Auth = llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeExternalSecret,
+				ExternalSecret: &llmwardenv1alpha1.ExternalSecretAuth{
+					Store:     llmwardenv1alpha1.StoreReference{Name: "vault"},
+					RemoteRef: llmwardenv1alpha1.RemoteReference{Key: "secret/openai"},
+				},
+			}
+		})
+
+		It("Should warn and recommend a ClusterSecretStore on create and update", func() {
+			obj.Spec.Auth.ExternalSecret.Store.Kind = llmwardenv1alpha1.SecretStoreKindSecretStore
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(SatisfyAll(
+				ContainSubstring("spec.auth.externalSecret.store"),
+				ContainSubstring("ClusterSecretStore"),
+			)))
+
+			warnings, err = validator.ValidateUpdate(ctx, obj.DeepCopy(), obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(HaveLen(1))
+		})
+
+		It("Should warn about a namespaced store in a fallback", func() {
+			obj.Spec.Auth.ExternalSecret.Store.Kind = llmwardenv1alpha1.SecretStoreKindClusterSecretStore
+			obj.Spec.Auth.Type = llmwardenv1alpha1.AuthTypeAPIKey
+			obj.Spec.Auth.APIKey = &llmwardenv1alpha1.APIKeyAuth{
+				SecretRef: llmwardenv1alpha1.SecretReference{Name: "openai-master", Namespace: "vault-sync", Key: "api-key"},
+			}
+			obj.Spec.Auth.Fallbacks = []llmwardenv1alpha1.AuthFallback{{
+				Type: llmwardenv1alpha1.AuthTypeExternalSecret,
+				ExternalSecret: &llmwardenv1alpha1.ExternalSecretAuth{
+					Store:     llmwardenv1alpha1.StoreReference{Name: "team-vault", Kind: llmwardenv1alpha1.SecretStoreKindSecretStore},
+					RemoteRef: llmwardenv1alpha1.RemoteReference{Key: "secret/openai"},
+				},
+			}}
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(ContainSubstring("spec.auth.fallbacks[0].externalSecret.store")))
+		})
+
+		It("Should not warn about a ClusterSecretStore", func() {
+			obj.Spec.Auth.ExternalSecret.Store.Kind = llmwardenv1alpha1.SecretStoreKindClusterSecretStore
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+	})
+
+	Context("When validating auth fallbacks", func() {
+		BeforeEach(func() {
+			obj.Spec.Auth = llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeWorkloadIdentity,
+				WorkloadIdentity: &llmwardenv1alpha1.WorkloadIdentityAuth{
+					AWS: &llmwardenv1alpha1.AWSWorkloadIdentity{RoleArn: "arn:aws:iam::123456789012:role/llm", Region: "us-east-1"},
+				},
+			}
+		})
+
+		It("Should admit a fallback with its configuration", func() {
+			obj.Spec.Auth.Fallbacks = []llmwardenv1alpha1.AuthFallback{{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef: llmwardenv1alpha1.SecretReference{Name: "openai-master", Namespace: "vault-sync", Key: "api-key"},
+				},
+			}}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should reject a fallback without the configuration for its type", func() {
+			obj.Spec.Auth.Fallbacks = []llmwardenv1alpha1.AuthFallback{{Type: llmwardenv1alpha1.AuthTypeAPIKey}}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.auth.fallbacks[0]: apiKey configuration is required")))
+		})
+
+		It("Should reject a fallback repeating an earlier strategy", func() {
+			obj.Spec.Auth.Fallbacks = []llmwardenv1alpha1.AuthFallback{{
+				Type:             llmwardenv1alpha1.AuthTypeWorkloadIdentity,
+				WorkloadIdentity: obj.Spec.Auth.WorkloadIdentity,
+			}}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.auth.fallbacks[0].type")))
+		})
+	})
+
+	Context("When validating durations", func() {
+		BeforeEach(func() {
+			obj.Spec.Auth = llmwardenv1alpha1.AuthConfig{
+				Type: llmwardenv1alpha1.AuthTypeAPIKey,
+				APIKey: &llmwardenv1alpha1.APIKeyAuth{
+					SecretRef: llmwardenv1alpha1.SecretReference{Name: "openai-master", Namespace: "vault-sync", Key: "api-key"},
+					Rotation:  &llmwardenv1alpha1.RotationConfig{Enabled: true, Interval: "7d"},
+				},
+			}
+		})
+
+		It("Should admit a normal interval without warnings", func() {
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("Should warn about leading zeros", func() {
+			obj.Spec.Auth.APIKey.Rotation.Interval = "07d"
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(ContainSubstring(`spec.auth.apiKey.rotation.interval "07d" has leading zeros; use "7d"`)))
+		})
+
+		It("Should reject zero and overflowing intervals", func() {
+			for _, interval := range []string{"0d", "99999999999999999999d"} {
+				obj.Spec.Auth.APIKey.Rotation.Interval = interval
+				_, err := validator.ValidateCreate(ctx, obj)
+				Expect(err).To(MatchError(ContainSubstring("spec.auth.apiKey.rotation.interval")), interval)
+			}
+		})
+
+		It("Should only check changed durations on update", func() {
+			obj.Spec.Auth.APIKey.RotationWindow = "0h"
+			oldObj := obj.DeepCopy()
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			obj.Spec.Auth.APIKey.Rotation.Interval = "0d"
+			_, err = validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.auth.apiKey.rotation.interval")))
+		})
+	})
+})
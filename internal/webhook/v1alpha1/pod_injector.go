@@ -17,44 +17,231 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"cmp"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"net/http"
+	"path"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/credcopy"
+	"github.com/llmwarden/llmwarden/internal/labelkeys"
 	"github.com/llmwarden/llmwarden/internal/metrics"
+	"github.com/llmwarden/llmwarden/internal/provisioner"
+	"github.com/llmwarden/llmwarden/internal/tracing"
 )
 
+// The annotations the injector records on pods are under the configurable label prefix
+// (llmwarden.io by default), so they are functions rather than constants.
+
+// InjectedProvidersAnnotation is the annotation key for tracking injected providers
+func InjectedProvidersAnnotation() string { return labelkeys.Key("injected-providers") }
+
+// InjectionStatusAnnotation indicates injection status
+func InjectionStatusAnnotation() string { return labelkeys.Key("injection-status") }
+
+// InjectionDetailAnnotation records, as a JSON list of InjectionDetail sorted by access
+// name, how many containers and init containers each access injected into.
+func InjectionDetailAnnotation() string { return labelkeys.Key("injection-detail") }
+
+// InjectionRequestAnnotation records the UID of the admission request that injected
+// the pod, matching the admissionUID field of the pod injector's log lines. Warning
+// events recorded during that request carry it as an event annotation.
+func InjectionRequestAnnotation() string { return labelkeys.Key("injection-request") }
+
+// InjectionRequiredLabel opts a namespace into fail-closed injection: when set to "true",
+// pods are denied if the injector cannot determine which credentials they need.
+func InjectionRequiredLabel() string { return labelkeys.Key("injection-required") }
+
+// AccessAnnotation binds a pod to LLMAccess resources by name, as a comma-separated
+// list. Named accesses inject in addition to those whose workload selector matches.
+func AccessAnnotation() string { return labelkeys.Key("access") }
+
 const (
-	// InjectedProvidersAnnotation is the annotation key for tracking injected providers
-	InjectedProvidersAnnotation = "llmwarden.io/injected-providers"
+	// AccessIDEnvVar is the attribution env var holding the injecting LLMAccess's UID.
+	AccessIDEnvVar = "LLMWARDEN_ACCESS_ID"
 
-	// InjectionStatusAnnotation indicates injection status
-	InjectionStatusAnnotation = "llmwarden.io/injection-status"
+	// PodNameEnvVar is the attribution env var holding the pod's name.
+	PodNameEnvVar = "LLMWARDEN_POD_NAME"
+)
+
+// InjectionDetail is one entry of InjectionDetailAnnotation. It only carries names and
+// counts, never credential values.
+type InjectionDetail struct {
+	Access         string `json:"access"`
+	Provider       string `json:"provider"`
+	Containers     int    `json:"containers"`
+	InitContainers int    `json:"initContainers"`
+}
+
+// ReasonInjectionLimitExceeded is the reason of the warning event recorded on an LLMAccess
+// that was skipped because injecting it would exceed the PodInjector's InjectionLimits.
+const ReasonInjectionLimitExceeded = "InjectionLimitExceeded"
+
+// ReasonInjected is the reason of the event recorded on an LLMAccess when the pod injector
+// injects its credentials into a pod, so the access shows recent injection activity.
+const ReasonInjected = "Injected"
+
+// ReasonCredentialsLikelyUnreadable is the reason of the warning event recorded on an
+// LLMAccess whose credential files a non-root container probably can't read, because the
+// pod's fsGroup and the files' mode don't grant it access.
+const ReasonCredentialsLikelyUnreadable = "CredentialsLikelyUnreadable"
+
+// InjectionLimits caps how much the pod injector adds to a single pod across all matching
+// accesses. Zero means unlimited.
+type InjectionLimits struct {
+	// EnvVars is the maximum number of distinct env vars injected into a pod.
+	EnvVars int
+	// Volumes is the maximum number of volumes injected into a pod.
+	Volumes int
+}
+
+// credentialCopyBinary is the manager binary in the llmwarden image, which the credential
+// copy and env file init containers run.
+const credentialCopyBinary = "/manager"
+
+// caCertFileName is the file name of the endpoint CA bundle inside its mount path.
+const caCertFileName = "ca.crt"
+
+const (
+	// envFileName is the file name of the merged dotenv file inside its mount path.
+	envFileName = ".env"
+
+	// envFileVolumeName is the memory-backed volume holding the merged dotenv file. The
+	// projected volume of the accesses' secrets it is written from is envFileVolumeName-src.
+	envFileVolumeName = "llmwarden-envfile"
+
+	// envFileVolumes is how many volumes the merged dotenv file adds to a pod.
+	envFileVolumes = 2
+
+	// defaultEnvFileMountPath is the directory the merged dotenv file is mounted in when
+	// the access doesn't set one.
+	defaultEnvFileMountPath = "/etc/llmwarden/env"
+)
+
+// EnvFileContainerName is the name of the init container that writes the merged dotenv
+// file of the accesses with injection.envFile.
+const EnvFileContainerName = "llmwarden-envfile"
+
+const (
+	// credentialCopyContainerPrefix starts the names of the credential copy containers.
+	credentialCopyContainerPrefix = "llmwarden-copy-"
+
+	// usageSidecarContainerPrefix starts the names of the usage sidecars.
+	usageSidecarContainerPrefix = "llmwarden-usage-"
 )
 
 // log is for logging in this package.
 var podinjectorlog = logf.Log.WithName("pod-injector")
 
+// withRequestLog returns ctx carrying podinjectorlog tagged with the admission request
+// UID, so every log line of one pod creation can be grepped together.
+func withRequestLog(ctx context.Context, uid types.UID) context.Context {
+	return logr.NewContext(ctx, podinjectorlog.WithValues("admissionUID", string(uid)))
+}
+
+// requestLog returns the logger withRequestLog put in ctx, or podinjectorlog outside of
+// an admission request.
+func requestLog(ctx context.Context) logr.Logger {
+	if log, err := logr.FromContext(ctx); err == nil {
+		return log
+	}
+	return podinjectorlog
+}
+
 // +kubebuilder:webhook:path=/mutate-v1-pod,mutating=true,failurePolicy=ignore,sideEffects=None,groups="",resources=pods,verbs=create,versions=v1,name=mpod.llmwarden.io,admissionReviewVersions=v1
 
+// defaultListFailureCooldown is how long the pod injector skips listing LLMAccess
+// resources after a failed list.
+const defaultListFailureCooldown = 10 * time.Second
+
+// defaultInjectionEventInterval is the minimum time between two Injected events on the
+// same LLMAccess.
+const defaultInjectionEventInterval = time.Minute
+
 // PodInjector injects LLM credentials into pods based on LLMAccess workload selectors.
 type PodInjector struct {
 	Client  client.Client
 	decoder admission.Decoder
+
+	// ListFailureCooldown is how long to admit pods without listing LLMAccess resources
+	// after a list failure, so a cold cache or throttled apiserver is not hit on every
+	// admission. Zero disables the cooldown.
+	ListFailureCooldown time.Duration
+
+	// WatchNamespaces restricts injection to these namespaces; empty means all.
+	// The manager's cache only holds LLMAccess resources from these namespaces.
+	WatchNamespaces []string
+
+	// CredentialCopyImage is the llmwarden image, whose manager binary the init containers
+	// that fill memory-medium credential volumes and write the merged env file run. Empty
+	// falls back to the secret volume and env vars.
+	CredentialCopyImage string
+
+	// Limits caps the env vars and volumes injected into one pod. Accesses that would
+	// exceed them are skipped whole, with an admission warning and a warning event.
+	Limits InjectionLimits
+
+	// Recorder records events on injected LLMAccess resources and on those skipped by
+	// Limits. Optional.
+	Recorder record.EventRecorder
+
+	// InjectionEventInterval is the minimum time between two Injected events on the same
+	// LLMAccess, so a scaling deployment doesn't flood it with events. Injections in
+	// between are counted in the next event. Zero records an event for every injection.
+	InjectionEventInterval time.Duration
+
+	// WorkflowOwnerReader, when set, reads the metadata of the workflow object that owns a
+	// pod (see workflowOwnerKinds), so workload selectors written against the workflow's
+	// labels also select its pods. It should bypass the cache, which does not watch
+	// workflow types. Nil matches pod labels only.
+	WorkflowOwnerReader client.Reader
+
+	// mu guards cooldownUntil and injectionEvents.
+	mu            sync.Mutex
+	cooldownUntil time.Time
+	// injectionEvents tracks the last Injected event of each access.
+	injectionEvents map[types.NamespacedName]*injectionEvents
+	// now returns the current time; overridden in tests.
+	now func() time.Time
 }
 
 // Handle processes incoming pod creation requests and injects credentials.
 func (i *PodInjector) Handle(ctx context.Context, req admission.Request) admission.Response {
+	ctx, span := tracing.Tracer().Start(ctx, tracing.SpanPodInjectorHandle, trace.WithAttributes(
+		attribute.String("k8s.namespace.name", req.Namespace), attribute.String("admission.uid", string(req.UID))))
+	resp := i.handle(ctx, req)
+	span.SetAttributes(attribute.Bool("admission.allowed", resp.Allowed), attribute.Int("admission.patches", len(resp.Patches)))
+	tracing.End(span, nil)
+	return resp
+}
+
+// handle is Handle without the tracing span.
+func (i *PodInjector) handle(ctx context.Context, req admission.Request) admission.Response {
+	ctx = withRequestLog(ctx, req.UID)
 	pod := &corev1.Pod{}
 
 	err := i.decoder.Decode(req, pod)
@@ -62,34 +249,83 @@ func (i *PodInjector) Handle(ctx context.Context, req admission.Request) admissi
 		return admission.Errored(http.StatusBadRequest, fmt.Errorf("failed to decode pod: %w", err))
 	}
 
-	podinjectorlog.Info("Processing pod", "name", pod.Name, "namespace", pod.Namespace)
+	if !namespaceWatched(i.WatchNamespaces, req.Namespace) {
+		return admission.Allowed("namespace is not watched by this llmwarden instance")
+	}
+
+	requestLog(ctx).Info("Processing pod", "name", pod.Name, "namespace", pod.Namespace)
+
+	if i.inListCooldown() {
+		return i.injectionFailed(ctx, req.Namespace, "LLMAccess listing is cooling down after a failure")
+	}
 
 	// List all LLMAccess resources in the pod's namespace
 	llmAccessList := &llmwardenv1alpha1.LLMAccessList{}
 	if err := i.Client.List(ctx, llmAccessList, client.InNamespace(req.Namespace)); err != nil {
-		podinjectorlog.Error(err, "Failed to list LLMAccess resources", "namespace", req.Namespace)
-		// Use failurePolicy=ignore so we don't block pod creation if there's an error
-		return admission.Allowed("failed to list LLMAccess resources, allowing pod creation")
+		requestLog(ctx).Error(err, "Failed to list LLMAccess resources", "namespace", req.Namespace)
+		i.startListCooldown(ctx)
+		return i.injectionFailed(ctx, req.Namespace, "failed to list LLMAccess resources")
 	}
 
+	warnings := missingAccessWarnings(pod, llmAccessList.Items)
+
 	if len(llmAccessList.Items) == 0 {
 		// No LLMAccess resources in this namespace, nothing to inject
-		return admission.Allowed("no LLMAccess resources in namespace")
+		return admission.Allowed("no LLMAccess resources in namespace").WithWarnings(warnings...)
 	}
 
+	// Inject by descending priority, then in name order, so the result, which access wins
+	// an env var collision and which accesses the limits skip don't depend on the order
+	// the cache lists them in.
+	slices.SortFunc(llmAccessList.Items, func(a, b llmwardenv1alpha1.LLMAccess) int {
+		if c := cmp.Compare(b.Spec.Priority, a.Spec.Priority); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+
 	// Track which providers we inject
 	var injectedProviders []string
+	var injected, usageSidecars, envFiles []*llmwardenv1alpha1.LLMAccess
+	var details []InjectionDetail
+	var injectedEnvVars, injectedVolumes int
+	claims := envClaims{}
 	modified := false
+	ownerLabels := i.workflowOwnerLabels(ctx, req.Namespace, pod)
 
 	// Check each LLMAccess to see if it matches this pod
 	for _, llmAccess := range llmAccessList.Items {
-		if i.shouldInject(pod, &llmAccess) {
-			podinjectorlog.Info("Injecting credentials",
+		if i.shouldInject(pod, &llmAccess, ownerLabels) {
+			requestLog(ctx).Info("Injecting credentials",
 				"pod", pod.Name,
 				"llmaccess", llmAccess.Name,
 				"provider", llmAccess.Spec.ProviderRef.Name)
 
-			i.injectCredentials(pod, &llmAccess)
+			before, claimsBefore := pod.DeepCopy(), maps.Clone(claims)
+			injectCtx, effects := withInjectionEffects(ctx)
+			accessWarnings := i.injectCredentials(injectCtx, pod, &llmAccess, claims)
+			envVars, volumes := injectedCounts(before, pod)
+			if i.writesEnvFile(&llmAccess) && len(envFiles) == 0 {
+				// The merged file's volumes are added once all accesses are injected.
+				volumes += envFileVolumes
+			}
+			if i.limitExceeded(injectedEnvVars+envVars, injectedVolumes+volumes) {
+				*pod, claims = *before, claimsBefore
+				warnings = append(warnings, i.injectionLimitExceeded(ctx, req.UID, pod, &llmAccess, envVars, volumes))
+				continue
+			}
+			effects.apply()
+			injectedEnvVars += envVars
+			injectedVolumes += volumes
+			warnings = append(warnings, accessWarnings...)
+			details = append(details, injectionDetail(before, pod, &llmAccess))
+			if llmAccess.Spec.Injection.UsageSidecar != nil {
+				usageSidecars = append(usageSidecars, &llmAccess)
+			}
+			if i.writesEnvFile(&llmAccess) {
+				envFiles = append(envFiles, &llmAccess)
+			}
+			injected = append(injected, &llmAccess)
 			injectedProviders = append(injectedProviders, llmAccess.Spec.ProviderRef.Name)
 			// Track successful injection in metrics
 			metrics.WebhookInjectionsTotal.WithLabelValues(req.Namespace, llmAccess.Spec.ProviderRef.Name).Inc()
@@ -99,15 +335,31 @@ func (i *PodInjector) Handle(ctx context.Context, req admission.Request) admissi
 
 	if !modified {
 		// No matching LLMAccess resources for this pod
-		return admission.Allowed("no matching LLMAccess resources")
+		return admission.Allowed("no matching LLMAccess resources").WithWarnings(warnings...)
+	}
+
+	if len(envFiles) > 0 {
+		warnings = append(warnings, i.injectEnvFile(ctx, pod, envFiles)...)
+	}
+
+	// Sidecars are added last so credentials from other accesses are never injected into them.
+	for _, llmAccess := range usageSidecars {
+		i.injectUsageSidecar(ctx, pod, llmAccess)
 	}
 
 	// Add annotations to track injection
 	if pod.Annotations == nil {
 		pod.Annotations = make(map[string]string)
 	}
-	pod.Annotations[InjectedProvidersAnnotation] = strings.Join(injectedProviders, ",")
-	pod.Annotations[InjectionStatusAnnotation] = "injected"
+	pod.Annotations[InjectedProvidersAnnotation()] = strings.Join(injectedProviders, ",")
+	pod.Annotations[InjectionStatusAnnotation()] = "injected"
+	pod.Annotations[InjectionRequestAnnotation()] = string(req.UID)
+	slices.SortFunc(details, func(a, b InjectionDetail) int { return strings.Compare(a.Access, b.Access) })
+	detailJSON, err := json.Marshal(details)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("failed to marshal injection detail: %w", err))
+	}
+	pod.Annotations[InjectionDetailAnnotation()] = string(detailJSON)
 
 	// Marshal the modified pod
 	marshaledPod, err := json.Marshal(pod)
@@ -115,52 +367,511 @@ func (i *PodInjector) Handle(ctx context.Context, req admission.Request) admissi
 		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("failed to marshal pod: %w", err))
 	}
 
-	podinjectorlog.Info("Successfully injected credentials",
+	requestLog(ctx).Info("Successfully injected credentials",
 		"pod", pod.Name,
 		"providers", strings.Join(injectedProviders, ","))
 
-	return admission.PatchResponseFromRaw(req.Object.Raw, marshaledPod)
+	// A dry-run admission creates no pod, and the webhook declares no side effects for it.
+	if req.DryRun == nil || !*req.DryRun {
+		for _, llmAccess := range injected {
+			i.recordInjected(req.UID, req.Namespace, pod, llmAccess)
+		}
+	}
+
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaledPod).WithWarnings(warnings...)
+}
+
+// injectedCounts returns how many distinct env vars and how many volumes an injection
+// added or changed going from before to after.
+func injectedCounts(before, after *corev1.Pod) (envVars, volumes int) {
+	changed := make(map[string]bool)
+	collect := func(before, after []corev1.Container) {
+		for _, container := range after {
+			var previous []corev1.EnvVar
+			if idx := slices.IndexFunc(before, func(c corev1.Container) bool { return c.Name == container.Name }); idx >= 0 {
+				previous = before[idx].Env
+			}
+			for _, envVar := range container.Env {
+				idx := slices.IndexFunc(previous, func(e corev1.EnvVar) bool { return e.Name == envVar.Name })
+				if idx < 0 || !equality.Semantic.DeepEqual(previous[idx], envVar) {
+					changed[envVar.Name] = true
+				}
+			}
+		}
+	}
+	collect(before.Spec.Containers, after.Spec.Containers)
+	collect(before.Spec.InitContainers, after.Spec.InitContainers)
+	return len(changed), len(after.Spec.Volumes) - len(before.Spec.Volumes)
+}
+
+// limitExceeded reports whether injecting envVars env vars and volumes volumes into one
+// pod exceeds the injector's limits.
+func (i *PodInjector) limitExceeded(envVars, volumes int) bool {
+	return (i.Limits.EnvVars > 0 && envVars > i.Limits.EnvVars) ||
+		(i.Limits.Volumes > 0 && volumes > i.Limits.Volumes)
+}
+
+// injectionLimitExceeded reports an access skipped during admission request uid because
+// injecting its envVars env vars and volumes volumes would exceed the injector's limits,
+// and returns the admission warning.
+func (i *PodInjector) injectionLimitExceeded(
+	ctx context.Context, uid types.UID, pod *corev1.Pod, llmAccess *llmwardenv1alpha1.LLMAccess, envVars, volumes int,
+) string {
+	podName := pod.Name
+	if podName == "" {
+		podName = pod.GenerateName
+	}
+	message := fmt.Sprintf("LLMAccess %s was not injected into pod %s: its %d env vars and %d volumes would exceed the per-pod limit of %d env vars and %d volumes (0 is unlimited)",
+		llmAccess.Name, podName, envVars, volumes, i.Limits.EnvVars, i.Limits.Volumes)
+	requestLog(ctx).Info("Skipping injection over the per-pod limit",
+		"pod", podName, "namespace", llmAccess.Namespace, "llmaccess", llmAccess.Name, "envVars", envVars, "volumes", volumes)
+	metrics.InjectionLimitExceeded.WithLabelValues(llmAccess.Namespace, llmAccess.Name, llmAccess.Spec.ProviderRef.Name).Inc()
+	// The skipped access injected nothing, which the gauge reports as a silent failure.
+	metrics.WebhookInjectedEnvVars.WithLabelValues(llmAccess.Namespace, llmAccess.Name, llmAccess.Spec.ProviderRef.Name).Set(0)
+	if i.Recorder != nil {
+		i.Recorder.AnnotatedEventf(llmAccess, map[string]string{InjectionRequestAnnotation(): string(uid)},
+			corev1.EventTypeWarning, ReasonInjectionLimitExceeded, "%s", message)
+	}
+	return message
+}
+
+// injectionDetail counts the containers and init containers of before that injecting
+// llmAccess changed in after. Containers the injection added, such as the credential copy
+// init container, are not counted.
+func injectionDetail(before, after *corev1.Pod, llmAccess *llmwardenv1alpha1.LLMAccess) InjectionDetail {
+	changed := func(before, after []corev1.Container) int {
+		count := 0
+		for _, container := range after {
+			idx := slices.IndexFunc(before, func(c corev1.Container) bool { return c.Name == container.Name })
+			if idx >= 0 && !equality.Semantic.DeepEqual(before[idx], container) {
+				count++
+			}
+		}
+		return count
+	}
+	return InjectionDetail{
+		Access:         llmAccess.Name,
+		Provider:       llmAccess.Spec.ProviderRef.Name,
+		Containers:     changed(before.Spec.Containers, after.Spec.Containers),
+		InitContainers: changed(before.Spec.InitContainers, after.Spec.InitContainers),
+	}
+}
+
+// injectionEvents is the Injected event state of one access.
+type injectionEvents struct {
+	// last is when the last Injected event was recorded.
+	last time.Time
+	// suppressed counts the injections since then that recorded no event.
+	suppressed int
+}
+
+// recordInjected records an Injected event on llmAccess naming the pod, unless the access
+// got one less than InjectionEventInterval ago, in which case the injection is counted
+// in the next event instead.
+func (i *PodInjector) recordInjected(uid types.UID, namespace string, pod *corev1.Pod, llmAccess *llmwardenv1alpha1.LLMAccess) {
+	if i.Recorder == nil {
+		return
+	}
+	key := types.NamespacedName{Namespace: llmAccess.Namespace, Name: llmAccess.Name}
+
+	i.mu.Lock()
+	now := i.clock()
+	state := i.injectionEvents[key]
+	if state != nil && now.Before(state.last.Add(i.InjectionEventInterval)) {
+		state.suppressed++
+		i.mu.Unlock()
+		return
+	}
+	suppressed := 0
+	if state != nil {
+		suppressed = state.suppressed
+	}
+	if i.injectionEvents == nil {
+		i.injectionEvents = make(map[types.NamespacedName]*injectionEvents)
+	}
+	// Forget accesses whose interval is over and that have nothing left to report, so
+	// deleted accesses don't accumulate.
+	for other, otherState := range i.injectionEvents {
+		if otherState.suppressed == 0 && !now.Before(otherState.last.Add(i.InjectionEventInterval)) {
+			delete(i.injectionEvents, other)
+		}
+	}
+	i.injectionEvents[key] = &injectionEvents{last: now}
+	i.mu.Unlock()
+
+	podName := pod.Name
+	if podName == "" {
+		podName = pod.GenerateName
+	}
+	message := fmt.Sprintf("Injected credentials into pod %s/%s", namespace, podName)
+	if suppressed > 0 {
+		message += fmt.Sprintf(" and %d more pods since the last event", suppressed)
+	}
+	i.Recorder.AnnotatedEventf(llmAccess, map[string]string{InjectionRequestAnnotation(): string(uid)},
+		corev1.EventTypeNormal, ReasonInjected, "%s", message)
+}
+
+// injectionFailed responds to a pod whose credentials could not be determined. Pods are
+// admitted without injection (fail-open) unless their namespace sets InjectionRequiredLabel,
+// in which case they are denied. This only covers errors inside Handle; when the webhook
+// itself is unreachable the configuration's failurePolicy=ignore still applies.
+func (i *PodInjector) injectionFailed(ctx context.Context, namespace, reason string) admission.Response {
+	if i.injectionRequired(ctx, namespace) {
+		return admission.Denied(fmt.Sprintf("%s and namespace %s requires llmwarden injection (%s=true)",
+			reason, namespace, InjectionRequiredLabel()))
+	}
+	return admission.Allowed(reason + ", allowing pod creation")
 }
 
-// shouldInject determines if credentials should be injected into the pod based on the workload selector.
-func (i *PodInjector) shouldInject(pod *corev1.Pod, llmAccess *llmwardenv1alpha1.LLMAccess) bool {
-	// If no workload selector is defined, don't inject
-	if llmAccess.Spec.WorkloadSelector == nil {
+// injectionRequired reports whether the namespace opted into fail-closed injection. A
+// namespace that cannot be read is treated as optional.
+func (i *PodInjector) injectionRequired(ctx context.Context, namespace string) bool {
+	ns := &corev1.Namespace{}
+	if err := i.Client.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+		requestLog(ctx).Error(err, "Failed to get namespace, treating injection as optional", "namespace", namespace)
 		return false
 	}
+	return ns.Labels[InjectionRequiredLabel()] == "true"
+}
 
-	// Convert label selector to labels.Selector
-	selector, err := metav1.LabelSelectorAsSelector(llmAccess.Spec.WorkloadSelector)
-	if err != nil {
-		podinjectorlog.Error(err, "Failed to parse workload selector",
-			"llmaccess", llmAccess.Name)
+// inListCooldown reports whether a recent list failure means listing should be skipped.
+func (i *PodInjector) inListCooldown() bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.clock().Before(i.cooldownUntil)
+}
+
+// startListCooldown starts a cooldown window after a list failure.
+func (i *PodInjector) startListCooldown(ctx context.Context) {
+	if i.ListFailureCooldown <= 0 {
+		return
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.cooldownUntil = i.clock().Add(i.ListFailureCooldown)
+	requestLog(ctx).Info("Skipping LLMAccess listing after failure", "cooldown", i.ListFailureCooldown.String())
+}
+
+func (i *PodInjector) clock() time.Time {
+	if i.now != nil {
+		return i.now()
+	}
+	return time.Now()
+}
+
+// shouldInject determines if credentials should be injected into the pod. ownerLabels are
+// the labels of the pod's workflow owner, if any; the workload selector may match them
+// instead of the pod's own labels.
+func (i *PodInjector) shouldInject(
+	pod *corev1.Pod, llmAccess *llmwardenv1alpha1.LLMAccess, ownerLabels map[string]string,
+) bool {
+	if PodMatchesAccess(pod, llmAccess) {
+		return true
+	}
+	if len(ownerLabels) == 0 {
+		return false
+	}
+	// Pod labels win over owner labels with the same key, as they describe the pod itself.
+	withOwnerLabels := pod.DeepCopy()
+	withOwnerLabels.Labels = labels.Merge(ownerLabels, pod.Labels)
+	return WorkloadSelected(withOwnerLabels, llmAccess)
+}
+
+// workflowOwnerKinds are the workflow engine kinds whose pods carry the engine's own labels
+// rather than those on the workflow object users write selectors against.
+var workflowOwnerKinds = []schema.GroupKind{
+	{Group: "argoproj.io", Kind: "Workflow"},
+	{Group: "tekton.dev", Kind: "TaskRun"},
+}
+
+// +kubebuilder:rbac:groups=argoproj.io,resources=workflows,verbs=get
+// +kubebuilder:rbac:groups=tekton.dev,resources=taskruns,verbs=get
+
+// workflowOwnerLabels returns the labels of the workflow object controlling the pod, or nil
+// if WorkflowOwnerReader is unset, the pod is not owned by a known workflow kind, or the
+// owner cannot be read. namespace is the admission request's, as the pod's own may not be
+// set yet. A failed read only narrows matching to the pod's labels, so it is logged rather
+// than failing admission.
+func (i *PodInjector) workflowOwnerLabels(ctx context.Context, namespace string, pod *corev1.Pod) map[string]string {
+	if i.WorkflowOwnerReader == nil {
+		return nil
+	}
+	owner := metav1.GetControllerOfNoCopy(pod)
+	if owner == nil {
+		return nil
+	}
+	gv, err := schema.ParseGroupVersion(owner.APIVersion)
+	if err != nil || !slices.Contains(workflowOwnerKinds, schema.GroupKind{Group: gv.Group, Kind: owner.Kind}) {
+		return nil
+	}
+
+	meta := &metav1.PartialObjectMetadata{}
+	meta.SetGroupVersionKind(gv.WithKind(owner.Kind))
+	if err := i.WorkflowOwnerReader.Get(ctx, types.NamespacedName{Name: owner.Name, Namespace: namespace}, meta); err != nil {
+		requestLog(ctx).Error(err, "Failed to read workflow owner, matching pod labels only",
+			"kind", owner.Kind, "name", owner.Name)
+		return nil
+	}
+	return meta.Labels
+}
+
+// PodMatchesAccess reports whether an LLMAccess applies to the pod, either because the pod
+// names the LLMAccess in its access annotation or because its selectors match.
+func PodMatchesAccess(pod *corev1.Pod, llmAccess *llmwardenv1alpha1.LLMAccess) bool {
+	if slices.Contains(requestedAccesses(pod), llmAccess.Name) {
+		return true
+	}
+	return WorkloadSelected(pod, llmAccess)
+}
+
+// HasWorkloadSelectors reports whether the access sets any of workloadSelector,
+// serviceAccountSelector and annotationSelector. Without them it selects no pods.
+func HasWorkloadSelectors(llmAccess *llmwardenv1alpha1.LLMAccess) bool {
+	return llmAccess.Spec.WorkloadSelector != nil || llmAccess.Spec.ServiceAccountSelector != nil ||
+		len(llmAccess.Spec.AnnotationSelector) > 0
+}
+
+// WorkloadSelected reports whether the access's workloadSelector, serviceAccountSelector
+// and annotationSelector select the pod. Each selector alone is sufficient; when several
+// are set the pod must match all of them. An access without selectors selects nothing.
+func WorkloadSelected(pod *corev1.Pod, llmAccess *llmwardenv1alpha1.LLMAccess) bool {
+	if !HasWorkloadSelectors(llmAccess) {
 		return false
 	}
 
-	// Check if pod labels match the selector
-	return selector.Matches(labels.Set(pod.Labels))
+	for key, value := range llmAccess.Spec.AnnotationSelector {
+		if annotation, ok := pod.Annotations[key]; !ok || annotation != value {
+			return false
+		}
+	}
+
+	if llmAccess.Spec.WorkloadSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(llmAccess.Spec.WorkloadSelector)
+		if err != nil {
+			podinjectorlog.Error(err, "Failed to parse workload selector",
+				"llmaccess", llmAccess.Name)
+			return false
+		}
+		if !selector.Matches(labels.Set(pod.Labels)) {
+			return false
+		}
+	}
+
+	if sa := llmAccess.Spec.ServiceAccountSelector; sa != nil {
+		return slices.Contains(sa.Names, podServiceAccount(pod))
+	}
+	return true
+}
+
+// podServiceAccount returns the ServiceAccount the pod runs as. The ServiceAccount
+// admission plugin fills in "default" before webhooks run, but pods evaluated outside
+// admission may still have it empty.
+func podServiceAccount(pod *corev1.Pod) string {
+	if pod.Spec.ServiceAccountName == "" {
+		return "default"
+	}
+	return pod.Spec.ServiceAccountName
 }
 
-// injectCredentials injects environment variables and/or volumes into the pod.
-func (i *PodInjector) injectCredentials(pod *corev1.Pod, llmAccess *llmwardenv1alpha1.LLMAccess) {
+// requestedAccesses returns the LLMAccess names listed in the pod's access annotation.
+func requestedAccesses(pod *corev1.Pod) []string {
+	var names []string
+	for name := range strings.SplitSeq(pod.Annotations[AccessAnnotation()], ",") {
+		name = strings.TrimSpace(name)
+		if name != "" && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// missingAccessWarnings returns an admission warning for each access named in the pod's
+// access annotation that does not exist in the pod's namespace.
+func missingAccessWarnings(pod *corev1.Pod, accesses []llmwardenv1alpha1.LLMAccess) []string {
+	var warnings []string
+	for _, name := range requestedAccesses(pod) {
+		found := slices.ContainsFunc(accesses, func(access llmwardenv1alpha1.LLMAccess) bool {
+			return access.Name == name
+		})
+		if !found {
+			warnings = append(warnings, fmt.Sprintf(
+				"%s annotation names LLMAccess %q, which does not exist in this namespace; no credentials were injected for it",
+				AccessAnnotation(), name))
+		}
+	}
+	return warnings
+}
+
+// injectionEffectsKey is the context key of the injectionEffects deferEffect queues on.
+type injectionEffectsKey struct{}
+
+// injectionEffects holds the events and metric updates of injecting one access, which
+// Handle applies only once the access fits within the injection limits.
+type injectionEffects struct {
+	effects []func()
+}
+
+// withInjectionEffects returns a context in which deferEffect queues effects on the
+// returned injectionEffects instead of running them.
+func withInjectionEffects(ctx context.Context) (context.Context, *injectionEffects) {
+	effects := &injectionEffects{}
+	return context.WithValue(ctx, injectionEffectsKey{}, effects), effects
+}
+
+// deferEffect runs effect, an event or metric update of an injection, or queues it when
+// ctx comes from withInjectionEffects.
+func deferEffect(ctx context.Context, effect func()) {
+	if effects, ok := ctx.Value(injectionEffectsKey{}).(*injectionEffects); ok {
+		effects.effects = append(effects.effects, effect)
+		return
+	}
+	effect()
+}
+
+// apply runs the queued effects in the order they were deferred.
+func (e *injectionEffects) apply() {
+	for _, effect := range e.effects {
+		effect()
+	}
+}
+
+// injectCredentials injects environment variables and/or volumes into the pod. It returns
+// admission warnings for containers that were deliberately left without credentials.
+func (i *PodInjector) injectCredentials(
+	ctx context.Context, pod *corev1.Pod, llmAccess *llmwardenv1alpha1.LLMAccess, claims envClaims,
+) []string {
+	if llmAccess.Spec.Injection.EnvFile != nil && !i.writesEnvFile(llmAccess) {
+		requestLog(ctx).Info("No credential copy image configured, injecting env vars instead of an env file",
+			"llmaccess", llmAccess.Name)
+	}
+
 	// Inject environment variables if configured
-	if len(llmAccess.Spec.Injection.Env) > 0 {
-		i.injectEnvVars(pod, llmAccess)
+	var warnings []string
+	if len(llmAccess.Spec.Injection.Env) > 0 || llmAccess.Spec.Injection.Attribution != nil {
+		warnings = i.injectEnvVars(ctx, pod, llmAccess, claims)
 	}
 
 	// Inject volume if configured
 	if llmAccess.Spec.Injection.Volume != nil {
-		i.injectVolume(pod, llmAccess)
+		warnings = append(warnings, i.injectVolume(ctx, pod, llmAccess)...)
+	}
+
+	// Inject the endpoint CA bundle if requested and the provider has one
+	if llmAccess.Spec.Injection.CACert != nil {
+		provider, err := provisioner.ResolveProvider(ctx, i.Client, llmAccess)
+		switch {
+		case err != nil:
+			requestLog(ctx).Error(err, "Failed to get LLMProvider, skipping CA certificate injection",
+				"llmaccess", llmAccess.Name, "provider", llmAccess.Spec.ProviderRef.Name)
+		case !provisioner.ProvisionsCACert(provider):
+			requestLog(ctx).Info("Provider has no endpoint CA bundle, skipping CA certificate injection",
+				"llmaccess", llmAccess.Name, "provider", provider.Name)
+		default:
+			i.injectCACert(ctx, pod, llmAccess)
+		}
+	}
+	return warnings
+}
+
+// injectCACert mounts the endpoint CA bundle from the access secret into the access's
+// target containers and points the configured env var at it.
+func (i *PodInjector) injectCACert(ctx context.Context, pod *corev1.Pod, llmAccess *llmwardenv1alpha1.LLMAccess) {
+	caConfig := llmAccess.Spec.Injection.CACert
+	mountPath := caConfig.MountPath
+	if mountPath == "" {
+		mountPath = "/etc/llmwarden/ca"
+	}
+	envVarName := caConfig.EnvVar
+	if envVarName == "" {
+		envVarName = "SSL_CERT_FILE"
+	}
+
+	// Only the CA key is projected, so the credential never lands in this world-readable volume.
+	volumeName := accessVolumeName("llmwarden-ca-", llmAccess, "")
+	defaultMode := int32(0444)
+	pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName:  llmAccess.Spec.SecretName,
+				Items:       []corev1.KeyToPath{{Key: provisioner.CACertKey, Path: caCertFileName}},
+				DefaultMode: &defaultMode,
+			},
+		},
+	})
+
+	volumeMount := corev1.VolumeMount{Name: volumeName, MountPath: mountPath, ReadOnly: true}
+	envVars := []corev1.EnvVar{{Name: envVarName, Value: path.Join(mountPath, caCertFileName)}}
+	position := llmAccess.Spec.Injection.EnvPosition
+	inject := func(container *corev1.Container) {
+		if !i.hasVolumeMountConflict(ctx, container, mountPath) {
+			container.VolumeMounts = append(container.VolumeMounts, volumeMount)
+		}
+		container.Env = mergeEnv(container.Env, envVars, position)
+	}
+
+	for _, container := range targetContainers(pod, llmAccess) {
+		inject(container)
 	}
 }
 
-// injectEnvVars injects environment variables into all containers in the pod.
-func (i *PodInjector) injectEnvVars(pod *corev1.Pod, llmAccess *llmwardenv1alpha1.LLMAccess) {
+// targetContainers returns the containers an access injects into: every container and
+// init container, or with injection.initContainers only the named init containers. The
+// containers llmwarden adds itself, such as the credential copy containers of accesses
+// injected earlier, are never targets.
+func targetContainers(pod *corev1.Pod, llmAccess *llmwardenv1alpha1.LLMAccess) []*corev1.Container {
+	var targets []*corev1.Container
+	if names := llmAccess.Spec.Injection.InitContainers; len(names) > 0 {
+		for idx := range pod.Spec.InitContainers {
+			if slices.Contains(names, pod.Spec.InitContainers[idx].Name) && !addedContainer(pod.Spec.InitContainers[idx].Name) {
+				targets = append(targets, &pod.Spec.InitContainers[idx])
+			}
+		}
+		return targets
+	}
+
+	for idx := range pod.Spec.Containers {
+		targets = append(targets, &pod.Spec.Containers[idx])
+	}
+	for idx := range pod.Spec.InitContainers {
+		if !addedContainer(pod.Spec.InitContainers[idx].Name) {
+			targets = append(targets, &pod.Spec.InitContainers[idx])
+		}
+	}
+	return targets
+}
+
+// addedContainer reports whether name is that of a container the injector adds: a
+// credential copy container, the env file writer or a usage sidecar.
+func addedContainer(name string) bool {
+	return name == EnvFileContainerName ||
+		strings.HasPrefix(name, credentialCopyContainerPrefix) || strings.HasPrefix(name, usageSidecarContainerPrefix)
+}
+
+// injectEnvVars injects environment variables into the access's target containers.
+// envClaimKey identifies an env var of a container.
+type envClaimKey struct {
+	container, env string
+}
+
+// envClaims records which access injected each env var into each container during one
+// admission request, so that an access injected later, i.e. with a lower priority, can't
+// override it.
+type envClaims map[envClaimKey]string
+
+// injectEnvVars injects the access's env mappings into its target containers, except env
+// vars claims shows an earlier access injected into a container, for which it returns a
+// warning instead. A nil claims injects every mapping.
+func (i *PodInjector) injectEnvVars(ctx context.Context, pod *corev1.Pod, llmAccess *llmwardenv1alpha1.LLMAccess, claims envClaims) []string {
 	secretName := llmAccess.Spec.SecretName
 
-	// Create env vars from the mapping
-	envVars := make([]corev1.EnvVar, 0, len(llmAccess.Spec.Injection.Env))
-	for _, mapping := range llmAccess.Spec.Injection.Env {
+	// Create env vars from the mapping, unless they go to the merged env file
+	mappings := llmAccess.Spec.Injection.Env
+	if i.writesEnvFile(llmAccess) {
+		mappings = nil
+	}
+	envVars := make([]corev1.EnvVar, 0, len(mappings))
+	for _, mapping := range mappings {
 		envVar := corev1.EnvVar{
 			Name: mapping.Name,
 			ValueFrom: &corev1.EnvVarSource{
@@ -168,44 +879,157 @@ func (i *PodInjector) injectEnvVars(pod *corev1.Pod, llmAccess *llmwardenv1alpha
 					LocalObjectReference: corev1.LocalObjectReference{
 						Name: secretName,
 					},
-					Key: mapping.SecretKey,
+					Key: mappingSecretKey(mapping),
 				},
 			},
 		}
 		envVars = append(envVars, envVar)
 	}
+	envVars = append(envVars, attributionEnvVars(llmAccess)...)
+
+	position := llmAccess.Spec.Injection.EnvPosition
+
+	var warnings []string
+	injected := 0
+	targets := targetContainers(pod, llmAccess)
+	for _, container := range targets {
+		containerVars := envVars
+		if claims != nil {
+			containerVars = make([]corev1.EnvVar, 0, len(envVars))
+			for _, envVar := range envVars {
+				key := envClaimKey{container: container.Name, env: envVar.Name}
+				if owner, ok := claims[key]; ok && owner != llmAccess.Name {
+					requestLog(ctx).Info("Skipping env var injected by an LLMAccess with precedence",
+						"llmaccess", llmAccess.Name, "envVar", envVar.Name, "container", container.Name, "injectedBy", owner)
+					warnings = append(warnings, fmt.Sprintf(
+						"env var %s of LLMAccess %s was not injected into container %s: LLMAccess %s injected it with a higher priority or earlier name",
+						envVar.Name, llmAccess.Name, container.Name, owner))
+					continue
+				}
+				claims[key] = llmAccess.Name
+				containerVars = append(containerVars, envVar)
+			}
+		}
+		container.Env = mergeEnv(container.Env, containerVars, position)
+		injected = max(injected, len(containerVars))
+	}
 
-	// Inject into all containers
-	for i := range pod.Spec.Containers {
-		pod.Spec.Containers[i].Env = append(pod.Spec.Containers[i].Env, envVars...)
+	requestLog(ctx).V(1).Info("Injected env vars",
+		"llmaccess", llmAccess.Name, "envVars", injected, "containers", len(targets))
+	deferEffect(ctx, func() {
+		metrics.WebhookInjectedEnvVars.WithLabelValues(llmAccess.Namespace, llmAccess.Name, llmAccess.Spec.ProviderRef.Name).
+			Set(float64(injected))
+	})
+	return warnings
+}
+
+// mappingSecretKey returns the secret key an env mapping reads. The defaulting webhook
+// fills in the provider's key; accesses admitted without it get the default key rather
+// than an invalid, empty secretKeyRef.
+func mappingSecretKey(mapping llmwardenv1alpha1.EnvVarMapping) string {
+	if mapping.SecretKey == "" {
+		return provisioner.DefaultCredentialKey
+	}
+	return mapping.SecretKey
+}
+
+// attributionEnvVars returns the env vars that identify the access, and with podName the
+// pod, to the workload, or nil when the access doesn't configure attribution. They carry no
+// credentials, so they are plain values rather than secret references.
+func attributionEnvVars(llmAccess *llmwardenv1alpha1.LLMAccess) []corev1.EnvVar {
+	attribution := llmAccess.Spec.Injection.Attribution
+	if attribution == nil {
+		return nil
+	}
+	envVars := []corev1.EnvVar{{Name: AccessIDEnvVar, Value: string(llmAccess.UID)}}
+	if attribution.PodName {
+		envVars = append(envVars, corev1.EnvVar{
+			Name: PodNameEnvVar,
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"},
+			},
+		})
+	}
+	return envVars
+}
+
+// mergeEnv merges injected into a container's env at the given position. A container var
+// with the same name as an injected one is dropped, or with Replace overwritten in place,
+// so that every name appears exactly once and the injected value is the one used.
+func mergeEnv(env, injected []corev1.EnvVar, position llmwardenv1alpha1.EnvPosition) []corev1.EnvVar {
+	byName := make(map[string]corev1.EnvVar, len(injected))
+	for _, envVar := range injected {
+		byName[envVar.Name] = envVar
 	}
 
-	// Inject into all init containers
-	for i := range pod.Spec.InitContainers {
-		pod.Spec.InitContainers[i].Env = append(pod.Spec.InitContainers[i].Env, envVars...)
+	merged := make([]corev1.EnvVar, 0, len(env)+len(injected))
+	if position == llmwardenv1alpha1.EnvPositionPrepend {
+		merged = append(merged, injected...)
+	}
+	replaced := make(map[string]bool, len(injected))
+	for _, envVar := range env {
+		injectedVar, ok := byName[envVar.Name]
+		if !ok {
+			merged = append(merged, envVar)
+			continue
+		}
+		if position == llmwardenv1alpha1.EnvPositionReplace && !replaced[envVar.Name] {
+			merged = append(merged, injectedVar)
+			replaced[envVar.Name] = true
+		}
+	}
+	if position != llmwardenv1alpha1.EnvPositionPrepend {
+		for _, envVar := range injected {
+			if !replaced[envVar.Name] {
+				merged = append(merged, envVar)
+			}
+		}
 	}
+	return merged
 }
 
-// injectVolume injects a volume mount into all containers in the pod.
-func (i *PodInjector) injectVolume(pod *corev1.Pod, llmAccess *llmwardenv1alpha1.LLMAccess) {
+// injectVolume injects a volume mount into the access's target containers, except privileged
+// containers and containers with bidirectional mount propagation, for which it returns a
+// warning instead.
+func (i *PodInjector) injectVolume(ctx context.Context, pod *corev1.Pod, llmAccess *llmwardenv1alpha1.LLMAccess) []string {
 	volumeConfig := llmAccess.Spec.Injection.Volume
 	secretName := llmAccess.Spec.SecretName
 
 	// Create a unique volume name
-	volumeName := fmt.Sprintf("llmwarden-%s", llmAccess.Name)
+	volumeName := accessVolumeName("llmwarden-", llmAccess, "")
 
-	// Add volume to pod spec with DefaultMode set to 0400 (read-only for owner only)
-	defaultMode := int32(0400)
-	volume := corev1.Volume{
-		Name: volumeName,
-		VolumeSource: corev1.VolumeSource{
-			Secret: &corev1.SecretVolumeSource{
-				SecretName:  secretName,
-				DefaultMode: &defaultMode,
-			},
+	// Credential files are read-only, for root and the pod's fsGroup unless overridden
+	defaultMode := llmwardenv1alpha1.DefaultCredentialFileMode
+	if volumeConfig.DefaultMode != nil {
+		defaultMode = *volumeConfig.DefaultMode
+	}
+	secretSource := corev1.VolumeSource{
+		Secret: &corev1.SecretVolumeSource{
+			SecretName:  secretName,
+			DefaultMode: &defaultMode,
 		},
 	}
-	pod.Spec.Volumes = append(pod.Spec.Volumes, volume)
+
+	memoryBacked := volumeConfig.Medium == llmwardenv1alpha1.VolumeMediumMemory
+	if memoryBacked && i.CredentialCopyImage == "" {
+		requestLog(ctx).Info("No credential copy image configured, mounting the secret volume instead of a memory-backed copy",
+			"llmaccess", llmAccess.Name)
+		memoryBacked = false
+	}
+
+	if memoryBacked {
+		// The containers mount a RAM-backed emptyDir that an init container fills from
+		// the secret volume, which only that init container mounts.
+		pod.Spec.Volumes = append(pod.Spec.Volumes,
+			corev1.Volume{
+				Name:         volumeName,
+				VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{Medium: corev1.StorageMediumMemory}},
+			},
+			corev1.Volume{Name: accessVolumeName("llmwarden-", llmAccess, "-src"), VolumeSource: secretSource},
+		)
+	} else {
+		pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{Name: volumeName, VolumeSource: secretSource})
+	}
 
 	// Create volume mount - force ReadOnly to true for security
 	volumeMount := corev1.VolumeMount{
@@ -214,27 +1038,397 @@ func (i *PodInjector) injectVolume(pod *corev1.Pod, llmAccess *llmwardenv1alpha1
 		ReadOnly:  true, // Always enforce read-only for credential volumes
 	}
 
-	// Add volume mount to all containers
-	for idx := range pod.Spec.Containers {
+	var warnings, unreadable []string
+	mount := func(container *corev1.Container) {
+		if reason := credentialVolumeRisk(container); reason != "" {
+			requestLog(ctx).Info("Skipping volume injection into over-privileged container",
+				"container", container.Name, "llmaccess", llmAccess.Name, "reason", reason)
+			warnings = append(warnings, fmt.Sprintf(
+				"container %q is %s; credentials of LLMAccess %q were not mounted into it", container.Name, reason, llmAccess.Name))
+			return
+		}
 		// Check for mount path conflicts
-		if !i.hasVolumeMountConflict(&pod.Spec.Containers[idx], volumeMount.MountPath) {
-			pod.Spec.Containers[idx].VolumeMounts = append(pod.Spec.Containers[idx].VolumeMounts, volumeMount)
+		if !i.hasVolumeMountConflict(ctx, container, volumeMount.MountPath) {
+			container.VolumeMounts = append(container.VolumeMounts, volumeMount)
+			if credentialFilesUnreadable(pod, container, defaultMode) {
+				unreadable = append(unreadable, container.Name)
+			}
 		}
 	}
 
-	// Add volume mount to all init containers
-	for idx := range pod.Spec.InitContainers {
-		if !i.hasVolumeMountConflict(&pod.Spec.InitContainers[idx], volumeMount.MountPath) {
-			pod.Spec.InitContainers[idx].VolumeMounts = append(pod.Spec.InitContainers[idx].VolumeMounts, volumeMount)
+	for _, container := range targetContainers(pod, llmAccess) {
+		mount(container)
+	}
+	if len(unreadable) > 0 {
+		warnings = append(warnings, i.credentialsLikelyUnreadable(ctx, pod, llmAccess, unreadable, defaultMode))
+	}
+
+	// The copy must run before every other init container so they see the credentials too.
+	if memoryBacked {
+		pod.Spec.InitContainers = append([]corev1.Container{i.credentialCopyContainer(llmAccess, volumeName)},
+			pod.Spec.InitContainers...)
+	}
+	return warnings
+}
+
+// writesEnvFile reports whether the access's env mappings go to the merged dotenv file
+// instead of env vars. The file is written by an init container, so without a credential
+// copy image the mappings are injected as env vars.
+func (i *PodInjector) writesEnvFile(llmAccess *llmwardenv1alpha1.LLMAccess) bool {
+	return llmAccess.Spec.Injection.EnvFile != nil && i.CredentialCopyImage != ""
+}
+
+// envFileEntry is one NAME=value line of the merged dotenv file.
+type envFileEntry struct {
+	access    *llmwardenv1alpha1.LLMAccess
+	name      string
+	secretKey string
+}
+
+// envFileEntries returns the lines of the merged dotenv file of accesses, in injection
+// order. A name mapped by more than one access is prefixed with each access's provider
+// name; a name that still collides, because two accesses of the same provider map it, is
+// kept for the earlier access and returned as a warning for the later one.
+func envFileEntries(accesses []*llmwardenv1alpha1.LLMAccess) ([]envFileEntry, []string) {
+	mappedBy := make(map[string]int)
+	for _, llmAccess := range accesses {
+		for _, mapping := range llmAccess.Spec.Injection.Env {
+			mappedBy[mapping.Name]++
+		}
+	}
+
+	var entries []envFileEntry
+	var warnings []string
+	writtenBy := make(map[string]string)
+	for _, llmAccess := range accesses {
+		for _, mapping := range llmAccess.Spec.Injection.Env {
+			name := mapping.Name
+			if mappedBy[name] > 1 {
+				name = envFilePrefix(llmAccess.Spec.ProviderRef.Name) + "_" + name
+			}
+			if owner, ok := writtenBy[name]; ok {
+				warnings = append(warnings, fmt.Sprintf(
+					"%s of LLMAccess %s was not written to the env file: LLMAccess %s wrote it with a higher priority or earlier name",
+					name, llmAccess.Name, owner))
+				continue
+			}
+			writtenBy[name] = llmAccess.Name
+			entries = append(entries, envFileEntry{access: llmAccess, name: name, secretKey: mappingSecretKey(mapping)})
+		}
+	}
+	return entries, warnings
+}
+
+// envFilePrefix turns a provider name into an env var name prefix, e.g. openai-production
+// into OPENAI_PRODUCTION.
+func envFilePrefix(providerName string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		default:
+			return '_'
+		}
+	}, providerName)
+}
+
+// injectEnvFile mounts one dotenv file holding the env mappings of accesses, in injection
+// order, into their target containers. An init container writes it into a memory-backed
+// emptyDir from a projected volume of the accesses' secrets, in which each line's value is
+// a file named after the line's env var. Like the memory medium, the file is not refreshed
+// on rotation.
+func (i *PodInjector) injectEnvFile(ctx context.Context, pod *corev1.Pod, accesses []*llmwardenv1alpha1.LLMAccess) []string {
+	entries, warnings := envFileEntries(accesses)
+
+	var sources []corev1.VolumeProjection
+	for _, llmAccess := range accesses {
+		var items []corev1.KeyToPath
+		for _, entry := range entries {
+			if entry.access == llmAccess {
+				items = append(items, corev1.KeyToPath{Key: entry.secretKey, Path: entry.name})
+			}
+		}
+		if len(items) > 0 {
+			sources = append(sources, corev1.VolumeProjection{
+				Secret: &corev1.SecretProjection{
+					LocalObjectReference: corev1.LocalObjectReference{Name: llmAccess.Spec.SecretName},
+					Items:                items,
+				},
+			})
+		}
+	}
+	defaultMode := llmwardenv1alpha1.DefaultCredentialFileMode
+	pod.Spec.Volumes = append(pod.Spec.Volumes,
+		corev1.Volume{
+			Name:         envFileVolumeName,
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{Medium: corev1.StorageMediumMemory}},
+		},
+		corev1.Volume{
+			Name: envFileVolumeName + "-src",
+			VolumeSource: corev1.VolumeSource{
+				Projected: &corev1.ProjectedVolumeSource{Sources: sources, DefaultMode: &defaultMode},
+			},
+		},
+	)
+
+	mountPath := accesses[0].Spec.Injection.EnvFile.MountPath
+	if mountPath == "" {
+		mountPath = defaultEnvFileMountPath
+	}
+	volumeMount := corev1.VolumeMount{Name: envFileVolumeName, MountPath: mountPath, ReadOnly: true}
+	mounted := make(map[*corev1.Container]bool)
+	for _, llmAccess := range accesses {
+		if p := llmAccess.Spec.Injection.EnvFile.MountPath; p != "" && p != mountPath {
+			requestLog(ctx).Info("Mounting the env file at the mount path of the access injected first",
+				"llmaccess", llmAccess.Name, "mountPath", p, "usedMountPath", mountPath)
+		}
+		for _, container := range targetContainers(pod, llmAccess) {
+			if mounted[container] {
+				continue
+			}
+			mounted[container] = true
+			if reason := credentialVolumeRisk(container); reason != "" {
+				requestLog(ctx).Info("Skipping env file injection into over-privileged container",
+					"container", container.Name, "reason", reason)
+				warnings = append(warnings, fmt.Sprintf(
+					"container %q is %s; the env file was not mounted into it", container.Name, reason))
+				continue
+			}
+			if !i.hasVolumeMountConflict(ctx, container, mountPath) {
+				container.VolumeMounts = append(container.VolumeMounts, volumeMount)
+			}
+		}
+	}
+
+	// The file must be written before every other init container so they can read it too.
+	pod.Spec.InitContainers = append([]corev1.Container{i.envFileContainer()}, pod.Spec.InitContainers...)
+	requestLog(ctx).V(1).Info("Injected env file", "accesses", len(accesses), "entries", len(entries), "mountPath", mountPath)
+	return warnings
+}
+
+// envFileContainer builds the init container that writes each file of the projected
+// secrets volume as a NAME=value line of the merged dotenv file. Like the credential copy
+// container, it runs with the pod's identity.
+func (i *PodInjector) envFileContainer() corev1.Container {
+	allowPrivilegeEscalation := false
+	readOnlyRootFilesystem := true
+	return corev1.Container{
+		Name:  EnvFileContainerName,
+		Image: i.CredentialCopyImage,
+		Command: []string{credentialCopyBinary, credcopy.Command,
+			"--src=/llmwarden/src", "--dst=/llmwarden/dst", "--env-file=" + envFileName},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: envFileVolumeName + "-src", MountPath: "/llmwarden/src", ReadOnly: true},
+			{Name: envFileVolumeName, MountPath: "/llmwarden/dst"},
+		},
+		SecurityContext: &corev1.SecurityContext{
+			AllowPrivilegeEscalation: &allowPrivilegeEscalation,
+			ReadOnlyRootFilesystem:   &readOnlyRootFilesystem,
+		},
+	}
+}
+
+// credentialVolumeRisk returns why a container must not get a credential volume, or "" if
+// it may. A privileged container, or one whose mounts propagate bidirectionally, can expose
+// its mounts to the host and other pods.
+func credentialVolumeRisk(container *corev1.Container) string {
+	if sc := container.SecurityContext; sc != nil && sc.Privileged != nil && *sc.Privileged {
+		return "privileged"
+	}
+	for _, mount := range container.VolumeMounts {
+		if mount.MountPropagation != nil && *mount.MountPropagation == corev1.MountPropagationBidirectional {
+			return "using bidirectional mount propagation"
+		}
+	}
+	return ""
+}
+
+// credentialFilesUnreadable reports whether a container probably can't read credential
+// files with the given mode. Secret volume files are owned by root and the pod's fsGroup,
+// so a container running as non-root needs the files to be world-readable, or the pod to
+// set an fsGroup and the files to be group-readable. With medium Memory the copy init
+// container reads them with the pod's identity, so the same applies.
+func credentialFilesUnreadable(pod *corev1.Pod, container *corev1.Container, mode int32) bool {
+	var runAsUser *int64
+	var runAsNonRoot *bool
+	var fsGroup *int64
+	if sc := pod.Spec.SecurityContext; sc != nil {
+		runAsUser, runAsNonRoot, fsGroup = sc.RunAsUser, sc.RunAsNonRoot, sc.FSGroup
+	}
+	if sc := container.SecurityContext; sc != nil {
+		if sc.RunAsUser != nil {
+			runAsUser = sc.RunAsUser
 		}
+		if sc.RunAsNonRoot != nil {
+			runAsNonRoot = sc.RunAsNonRoot
+		}
+	}
+	nonRoot := (runAsUser != nil && *runAsUser != 0) || (runAsUser == nil && runAsNonRoot != nil && *runAsNonRoot)
+	if !nonRoot || mode&0o004 != 0 {
+		return false
+	}
+	return fsGroup == nil || mode&0o040 == 0
+}
+
+// credentialsLikelyUnreadable records a warning event on an access whose credential files
+// the named containers probably can't read, and returns the admission warning.
+func (i *PodInjector) credentialsLikelyUnreadable(
+	ctx context.Context, pod *corev1.Pod, llmAccess *llmwardenv1alpha1.LLMAccess, containers []string, mode int32,
+) string {
+	podName := pod.Name
+	if podName == "" {
+		podName = pod.GenerateName
+	}
+	fix := "set spec.securityContext.fsGroup on the pod"
+	if pod.Spec.SecurityContext != nil && pod.Spec.SecurityContext.FSGroup != nil {
+		fix = "make spec.injection.volume.defaultMode group-readable (e.g. 0440)"
+	}
+	message := fmt.Sprintf("credential files of LLMAccess %s (mode %#o) are likely unreadable by non-root containers %s of pod %s; %s",
+		llmAccess.Name, mode, strings.Join(containers, ", "), podName, fix)
+	deferEffect(ctx, func() {
+		requestLog(ctx).Info("Credential files likely unreadable",
+			"pod", podName, "llmaccess", llmAccess.Name, "containers", containers, "mode", fmt.Sprintf("%#o", mode))
+		if i.Recorder != nil {
+			var eventAnnotations map[string]string
+			if req, err := admission.RequestFromContext(ctx); err == nil {
+				eventAnnotations = map[string]string{InjectionRequestAnnotation(): string(req.UID)}
+			}
+			i.Recorder.AnnotatedEventf(llmAccess, eventAnnotations, corev1.EventTypeWarning, ReasonCredentialsLikelyUnreadable, "%s", message)
+		}
+	})
+	return message
+}
+
+// accessVolumeName returns the name of a volume injected for llmAccess: prefix, the access
+// name and suffix. Volume names are DNS labels, so dots are replaced, and a name over 63
+// characters is truncated and ends in a hash of the access name to stay unique.
+func accessVolumeName(prefix string, llmAccess *llmwardenv1alpha1.LLMAccess, suffix string) string {
+	name := prefix + strings.ReplaceAll(llmAccess.Name, ".", "-") + suffix
+	if len(name) <= validation.DNS1123LabelMaxLength {
+		return name
+	}
+	sum := sha256.Sum256([]byte(llmAccess.Name))
+	hash := hex.EncodeToString(sum[:4])
+	keep := validation.DNS1123LabelMaxLength - len(hash) - 1 - len(suffix)
+	return strings.TrimRight(name[:keep], "-") + "-" + hash + suffix
+}
+
+// CredentialCopyContainerName returns the name of the init container that copies an
+// LLMAccess's credentials into its memory-backed volume, truncated to the 63 character
+// limit on container names.
+func CredentialCopyContainerName(llmAccess *llmwardenv1alpha1.LLMAccess) string {
+	name := credentialCopyContainerPrefix + llmAccess.Name
+	if len(name) > 63 {
+		name = strings.TrimRight(name[:63], "-.")
+	}
+	return name
+}
+
+// credentialCopyContainer builds the init container that copies the secret volume's files
+// into the memory-backed emptyDir. It runs with the pod's identity so the copies are
+// readable by the same user as the secret volume would have been.
+func (i *PodInjector) credentialCopyContainer(llmAccess *llmwardenv1alpha1.LLMAccess, volumeName string) corev1.Container {
+	allowPrivilegeEscalation := false
+	readOnlyRootFilesystem := true
+	return corev1.Container{
+		Name:    CredentialCopyContainerName(llmAccess),
+		Image:   i.CredentialCopyImage,
+		Command: []string{credentialCopyBinary, credcopy.Command, "--src=/llmwarden/src", "--dst=/llmwarden/dst"},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: accessVolumeName("llmwarden-", llmAccess, "-src"), MountPath: "/llmwarden/src", ReadOnly: true},
+			{Name: volumeName, MountPath: "/llmwarden/dst"},
+		},
+		SecurityContext: &corev1.SecurityContext{
+			AllowPrivilegeEscalation: &allowPrivilegeEscalation,
+			ReadOnlyRootFilesystem:   &readOnlyRootFilesystem,
+		},
+	}
+}
+
+// UsageSidecarContainerName returns the name of the usage sidecar container for an LLMAccess,
+// truncated to the 63 character limit on container names.
+func UsageSidecarContainerName(llmAccess *llmwardenv1alpha1.LLMAccess) string {
+	name := usageSidecarContainerPrefix + llmAccess.Name
+	if len(name) > 63 {
+		name = strings.TrimRight(name[:63], "-.")
+	}
+	return name
+}
+
+// injectUsageSidecar adds the usage-reporting proxy sidecar configured on the LLMAccess.
+// The sidecar forwards requests to the provider endpoint from the access's secret and never
+// receives the API key itself; workloads keep sending their own credentials.
+//
+// It is a native sidecar, an init container with restartPolicy Always, so it is stopped
+// once the pod's containers exit and doesn't keep Job and CronJob pods from completing.
+func (i *PodInjector) injectUsageSidecar(ctx context.Context, pod *corev1.Pod, llmAccess *llmwardenv1alpha1.LLMAccess) {
+	config := llmAccess.Spec.Injection.UsageSidecar
+	name := UsageSidecarContainerName(llmAccess)
+
+	for _, container := range slices.Concat(pod.Spec.InitContainers, pod.Spec.Containers) {
+		if container.Name == name {
+			requestLog(ctx).Info("Skipping usage sidecar injection, container already present",
+				"container", name)
+			return
+		}
+	}
+
+	proxyPort := config.ProxyPort
+	if proxyPort == 0 {
+		proxyPort = llmwardenv1alpha1.DefaultUsageSidecarProxyPort
+	}
+	metricsPort := config.MetricsPort
+	if metricsPort == 0 {
+		metricsPort = llmwardenv1alpha1.DefaultUsageSidecarMetricsPort
+	}
+
+	optional := true
+	runAsNonRoot := true
+	allowPrivilegeEscalation := false
+	readOnlyRootFilesystem := true
+	restartAlways := corev1.ContainerRestartPolicyAlways
+	sidecar := corev1.Container{
+		Name:          name,
+		Image:         config.Image,
+		RestartPolicy: &restartAlways,
+		Ports: []corev1.ContainerPort{
+			{ContainerPort: proxyPort, Protocol: corev1.ProtocolTCP},
+			{ContainerPort: metricsPort, Protocol: corev1.ProtocolTCP},
+		},
+		Env: []corev1.EnvVar{
+			{Name: "LLMWARDEN_PROXY_PORT", Value: fmt.Sprintf("%d", proxyPort)},
+			{Name: "LLMWARDEN_METRICS_PORT", Value: fmt.Sprintf("%d", metricsPort)},
+			{Name: "LLMWARDEN_ACCESS", Value: llmAccess.Name},
+			{Name: "LLMWARDEN_PROVIDER", Value: llmAccess.Spec.ProviderRef.Name},
+			{
+				Name: "LLMWARDEN_UPSTREAM_BASE_URL",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: llmAccess.Spec.SecretName,
+						},
+						Key:      "baseUrl",
+						Optional: &optional,
+					},
+				},
+			},
+		},
+		SecurityContext: &corev1.SecurityContext{
+			RunAsNonRoot:             &runAsNonRoot,
+			AllowPrivilegeEscalation: &allowPrivilegeEscalation,
+			ReadOnlyRootFilesystem:   &readOnlyRootFilesystem,
+		},
 	}
+	pod.Spec.InitContainers = append(pod.Spec.InitContainers, sidecar)
 }
 
 // hasVolumeMountConflict checks if a mount path conflicts with existing mounts
-func (i *PodInjector) hasVolumeMountConflict(container *corev1.Container, mountPath string) bool {
+func (i *PodInjector) hasVolumeMountConflict(ctx context.Context, container *corev1.Container, mountPath string) bool {
 	for _, existingMount := range container.VolumeMounts {
 		if existingMount.MountPath == mountPath {
-			podinjectorlog.Info("Skipping volume injection due to mount path conflict",
+			requestLog(ctx).Info("Skipping volume injection due to mount path conflict",
 				"container", container.Name,
 				"mountPath", mountPath)
 			return true
@@ -19,15 +19,31 @@ package v1alpha1
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/go-logr/logr/funcr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	llmwardenv1alpha1 "github.com/llmwarden/llmwarden/api/v1alpha1"
+	"github.com/llmwarden/llmwarden/internal/metrics"
+	"github.com/llmwarden/llmwarden/internal/provisioner"
 )
 
 func TestPodInjector_Handle(t *testing.T) {
@@ -534,12 +550,215 @@ func TestPodInjector_shouldInject(t *testing.T) {
 			},
 			wantInject: false,
 		},
+		{
+			name: "should inject when the pod names the access in its annotation",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{AccessAnnotation(): "anthropic-access, openai-access"},
+				},
+			},
+			llmAccess: &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{Name: "openai-access"},
+			},
+			wantInject: true,
+		},
+		{
+			name: "should inject when the annotation names the access even if the selector doesn't match",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      map[string]string{"app": "different-app"},
+					Annotations: map[string]string{AccessAnnotation(): "openai-access"},
+				},
+			},
+			llmAccess: &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{Name: "openai-access"},
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					WorkloadSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"app": "chatbot"},
+					},
+				},
+			},
+			wantInject: true,
+		},
+		{
+			name: "should not inject when the annotation names a different access",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{AccessAnnotation(): "anthropic-access"},
+				},
+			},
+			llmAccess: &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{Name: "openai-access"},
+			},
+			wantInject: false,
+		},
+		{
+			name: "should inject when the service account is selected",
+			pod: &corev1.Pod{
+				Spec: corev1.PodSpec{ServiceAccountName: "chatbot"},
+			},
+			llmAccess: &llmwardenv1alpha1.LLMAccess{
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					ServiceAccountSelector: &llmwardenv1alpha1.ServiceAccountSelector{Names: []string{"batch", "chatbot"}},
+				},
+			},
+			wantInject: true,
+		},
+		{
+			name: "should not inject when the service account is not selected",
+			pod: &corev1.Pod{
+				Spec: corev1.PodSpec{ServiceAccountName: "other"},
+			},
+			llmAccess: &llmwardenv1alpha1.LLMAccess{
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					ServiceAccountSelector: &llmwardenv1alpha1.ServiceAccountSelector{Names: []string{"chatbot"}},
+				},
+			},
+			wantInject: false,
+		},
+		{
+			name: "should treat an empty service account name as default",
+			pod:  &corev1.Pod{},
+			llmAccess: &llmwardenv1alpha1.LLMAccess{
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					ServiceAccountSelector: &llmwardenv1alpha1.ServiceAccountSelector{Names: []string{"default"}},
+				},
+			},
+			wantInject: true,
+		},
+		{
+			name: "should inject when both label and service account selectors match",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "chatbot"}},
+				Spec:       corev1.PodSpec{ServiceAccountName: "chatbot"},
+			},
+			llmAccess: &llmwardenv1alpha1.LLMAccess{
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					WorkloadSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "chatbot"}},
+					ServiceAccountSelector: &llmwardenv1alpha1.ServiceAccountSelector{Names: []string{"chatbot"}},
+				},
+			},
+			wantInject: true,
+		},
+		{
+			name: "should not inject when labels match but the service account doesn't",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "chatbot"}},
+				Spec:       corev1.PodSpec{ServiceAccountName: "other"},
+			},
+			llmAccess: &llmwardenv1alpha1.LLMAccess{
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					WorkloadSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "chatbot"}},
+					ServiceAccountSelector: &llmwardenv1alpha1.ServiceAccountSelector{Names: []string{"chatbot"}},
+				},
+			},
+			wantInject: false,
+		},
+		{
+			name: "should not inject when the service account matches but labels don't",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "other"}},
+				Spec:       corev1.PodSpec{ServiceAccountName: "chatbot"},
+			},
+			llmAccess: &llmwardenv1alpha1.LLMAccess{
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					WorkloadSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "chatbot"}},
+					ServiceAccountSelector: &llmwardenv1alpha1.ServiceAccountSelector{Names: []string{"chatbot"}},
+				},
+			},
+			wantInject: false,
+		},
+		{
+			name: "should inject when all selected annotations match",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+					"platform.example.com/llm": "enabled", "platform.example.com/tier": "prod", "other": "x",
+				}},
+			},
+			llmAccess: &llmwardenv1alpha1.LLMAccess{
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					AnnotationSelector: map[string]string{"platform.example.com/llm": "enabled", "platform.example.com/tier": "prod"},
+				},
+			},
+			wantInject: true,
+		},
+		{
+			name: "should not inject when a selected annotation has another value",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+					"platform.example.com/llm": "enabled", "platform.example.com/tier": "dev",
+				}},
+			},
+			llmAccess: &llmwardenv1alpha1.LLMAccess{
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					AnnotationSelector: map[string]string{"platform.example.com/llm": "enabled", "platform.example.com/tier": "prod"},
+				},
+			},
+			wantInject: false,
+		},
+		{
+			name: "should not inject when a selected annotation is missing",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"platform.example.com/llm": "enabled"}},
+			},
+			llmAccess: &llmwardenv1alpha1.LLMAccess{
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					AnnotationSelector: map[string]string{"platform.example.com/llm": "enabled", "platform.example.com/tier": "prod"},
+				},
+			},
+			wantInject: false,
+		},
+		{
+			name: "should inject when both labels and annotations match",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      map[string]string{"app": "chatbot"},
+					Annotations: map[string]string{"platform.example.com/llm": "enabled"},
+				},
+			},
+			llmAccess: &llmwardenv1alpha1.LLMAccess{
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					WorkloadSelector:   &metav1.LabelSelector{MatchLabels: map[string]string{"app": "chatbot"}},
+					AnnotationSelector: map[string]string{"platform.example.com/llm": "enabled"},
+				},
+			},
+			wantInject: true,
+		},
+		{
+			name: "should not inject when labels match but annotations don't",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "chatbot"}},
+			},
+			llmAccess: &llmwardenv1alpha1.LLMAccess{
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					WorkloadSelector:   &metav1.LabelSelector{MatchLabels: map[string]string{"app": "chatbot"}},
+					AnnotationSelector: map[string]string{"platform.example.com/llm": "enabled"},
+				},
+			},
+			wantInject: false,
+		},
+		{
+			name: "should not inject when annotations match but labels don't",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      map[string]string{"app": "other"},
+					Annotations: map[string]string{"platform.example.com/llm": "enabled"},
+				},
+			},
+			llmAccess: &llmwardenv1alpha1.LLMAccess{
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					WorkloadSelector:   &metav1.LabelSelector{MatchLabels: map[string]string{"app": "chatbot"}},
+					AnnotationSelector: map[string]string{"platform.example.com/llm": "enabled"},
+				},
+			},
+			wantInject: false,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			injector := &PodInjector{}
-			got := injector.shouldInject(tt.pod, tt.llmAccess)
+			got := injector.shouldInject(tt.pod, tt.llmAccess, nil)
 			if got != tt.wantInject {
 				t.Errorf("shouldInject() = %v, want %v", got, tt.wantInject)
 			}
@@ -568,8 +787,10 @@ func TestPodInjector_injectEnvVars(t *testing.T) {
 	}
 
 	llmAccess := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: "env-access", Namespace: "test-ns"},
 		Spec: llmwardenv1alpha1.LLMAccessSpec{
-			SecretName: "test-secret",
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai-prod"},
+			SecretName:  "test-secret",
 			Injection: llmwardenv1alpha1.InjectionConfig{
 				Env: []llmwardenv1alpha1.EnvVarMapping{
 					{Name: "API_KEY", SecretKey: "apiKey"},
@@ -580,7 +801,7 @@ func TestPodInjector_injectEnvVars(t *testing.T) {
 	}
 
 	injector := &PodInjector{}
-	injector.injectEnvVars(pod, llmAccess)
+	injector.injectEnvVars(context.Background(), pod, llmAccess, nil)
 
 	// Verify containers have env vars
 	if len(pod.Spec.Containers[0].Env) != 2 {
@@ -606,6 +827,141 @@ func TestPodInjector_injectEnvVars(t *testing.T) {
 	if envVar.ValueFrom.SecretKeyRef.Key != "apiKey" {
 		t.Errorf("Expected secret key apiKey, got %s", envVar.ValueFrom.SecretKeyRef.Key)
 	}
+
+	// Verify the injected count matches the configured mappings
+	injected := metrics.WebhookInjectedEnvVars.WithLabelValues(llmAccess.Namespace, llmAccess.Name, llmAccess.Spec.ProviderRef.Name)
+	if got := testutil.ToFloat64(injected); got != 2 {
+		t.Errorf("Expected injected env vars metric 2, got %v", got)
+	}
+}
+
+func TestPodInjector_injectCredentials_Attribution(t *testing.T) {
+	access := func(name, uid string, attribution *llmwardenv1alpha1.AttributionInjection) *llmwardenv1alpha1.LLMAccess {
+		return &llmwardenv1alpha1.LLMAccess{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-ns", UID: types.UID(uid)},
+			Spec: llmwardenv1alpha1.LLMAccessSpec{
+				ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai-prod"},
+				SecretName:  name + "-secret",
+				Injection: llmwardenv1alpha1.InjectionConfig{
+					Env:         []llmwardenv1alpha1.EnvVarMapping{{Name: "API_KEY", SecretKey: "apiKey"}},
+					Attribution: attribution,
+				},
+			},
+		}
+	}
+	podNameRef := &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"}}
+
+	tests := []struct {
+		name   string
+		access *llmwardenv1alpha1.LLMAccess
+		want   []corev1.EnvVar
+	}{
+		{
+			name:   "no attribution",
+			access: access("plain", "uid-plain", nil),
+			want:   nil,
+		},
+		{
+			name:   "access ID only",
+			access: access("attributed", "uid-attributed", &llmwardenv1alpha1.AttributionInjection{}),
+			want:   []corev1.EnvVar{{Name: AccessIDEnvVar, Value: "uid-attributed"}},
+		},
+		{
+			name:   "access ID and pod name",
+			access: access("attributed", "uid-attributed", &llmwardenv1alpha1.AttributionInjection{PodName: true}),
+			want: []corev1.EnvVar{
+				{Name: AccessIDEnvVar, Value: "uid-attributed"},
+				{Name: PodNameEnvVar, ValueFrom: podNameRef},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &corev1.Pod{
+				Spec: corev1.PodSpec{
+					Containers:     []corev1.Container{{Name: "main", Image: "nginx"}},
+					InitContainers: []corev1.Container{{Name: "init", Image: "busybox"}},
+				},
+			}
+			injector := &PodInjector{}
+			injector.injectCredentials(context.Background(), pod, tt.access, envClaims{})
+
+			for _, container := range append(pod.Spec.Containers, pod.Spec.InitContainers...) {
+				var got []corev1.EnvVar
+				for _, envVar := range container.Env {
+					if envVar.Name == AccessIDEnvVar || envVar.Name == PodNameEnvVar {
+						got = append(got, envVar)
+					}
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("container %s attribution env = %+v, want %+v", container.Name, got, tt.want)
+				}
+				if len(container.Env) != 1+len(tt.want) {
+					t.Errorf("container %s env = %+v, want API_KEY plus the attribution vars", container.Name, container.Env)
+				}
+			}
+		})
+	}
+}
+
+func TestMergeEnv(t *testing.T) {
+	value := func(name, v string) corev1.EnvVar { return corev1.EnvVar{Name: name, Value: v} }
+	injected := []corev1.EnvVar{value("OPENAI_API_KEY", "injected"), value("OPENAI_BASE_URL", "injected")}
+
+	tests := []struct {
+		name     string
+		env      []corev1.EnvVar
+		position llmwardenv1alpha1.EnvPosition
+		want     []corev1.EnvVar
+	}{
+		{
+			name:     "default appends after the container's vars",
+			env:      []corev1.EnvVar{value("LOG_LEVEL", "debug")},
+			position: "",
+			want:     []corev1.EnvVar{value("LOG_LEVEL", "debug"), value("OPENAI_API_KEY", "injected"), value("OPENAI_BASE_URL", "injected")},
+		},
+		{
+			name:     "prepend puts injected vars first",
+			env:      []corev1.EnvVar{value("LOG_LEVEL", "debug"), value("AUTH", "Bearer $(OPENAI_API_KEY)")},
+			position: llmwardenv1alpha1.EnvPositionPrepend,
+			want: []corev1.EnvVar{value("OPENAI_API_KEY", "injected"), value("OPENAI_BASE_URL", "injected"),
+				value("LOG_LEVEL", "debug"), value("AUTH", "Bearer $(OPENAI_API_KEY)")},
+		},
+		{
+			name:     "replace overwrites a placeholder in place",
+			env:      []corev1.EnvVar{value("OPENAI_API_KEY", "placeholder"), value("LOG_LEVEL", "debug")},
+			position: llmwardenv1alpha1.EnvPositionReplace,
+			want:     []corev1.EnvVar{value("OPENAI_API_KEY", "injected"), value("LOG_LEVEL", "debug"), value("OPENAI_BASE_URL", "injected")},
+		},
+		{
+			name:     "append drops a conflicting container var",
+			env:      []corev1.EnvVar{value("OPENAI_API_KEY", "placeholder"), value("LOG_LEVEL", "debug")},
+			position: llmwardenv1alpha1.EnvPositionAppend,
+			want:     []corev1.EnvVar{value("LOG_LEVEL", "debug"), value("OPENAI_API_KEY", "injected"), value("OPENAI_BASE_URL", "injected")},
+		},
+		{
+			name:     "prepend drops a conflicting container var",
+			env:      []corev1.EnvVar{value("LOG_LEVEL", "debug"), value("OPENAI_BASE_URL", "placeholder")},
+			position: llmwardenv1alpha1.EnvPositionPrepend,
+			want:     []corev1.EnvVar{value("OPENAI_API_KEY", "injected"), value("OPENAI_BASE_URL", "injected"), value("LOG_LEVEL", "debug")},
+		},
+		{
+			name:     "replace keeps only the first of duplicated container vars",
+			env:      []corev1.EnvVar{value("OPENAI_API_KEY", "a"), value("LOG_LEVEL", "debug"), value("OPENAI_API_KEY", "b")},
+			position: llmwardenv1alpha1.EnvPositionReplace,
+			want:     []corev1.EnvVar{value("OPENAI_API_KEY", "injected"), value("LOG_LEVEL", "debug"), value("OPENAI_BASE_URL", "injected")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mergeEnv(tt.env, injected, tt.position)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mergeEnv() = %v, want %v", got, tt.want)
+			}
+		})
+	}
 }
 
 func TestPodInjector_injectVolume(t *testing.T) {
@@ -638,7 +994,7 @@ func TestPodInjector_injectVolume(t *testing.T) {
 	}
 
 	injector := &PodInjector{}
-	injector.injectVolume(pod, llmAccess)
+	injector.injectVolume(context.Background(), pod, llmAccess)
 
 	// Verify volume was added
 	if len(pod.Spec.Volumes) != 1 {
@@ -670,3 +1026,1770 @@ func TestPodInjector_injectVolume(t *testing.T) {
 		t.Error("Expected mount to be read-only")
 	}
 }
+
+func TestPodInjector_injectVolume_DefaultMode(t *testing.T) {
+	override := int32(0o400)
+	tests := []struct {
+		name        string
+		defaultMode *int32
+		want        int32
+	}{
+		{name: "defaults to 0440", want: 0o440},
+		{name: "honours defaultMode", defaultMode: &override, want: 0o400},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &corev1.Pod{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "main", Image: "nginx"}}}}
+			llmAccess := &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-access"},
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					SecretName: "test-secret",
+					Injection: llmwardenv1alpha1.InjectionConfig{
+						Volume: &llmwardenv1alpha1.VolumeInjection{MountPath: "/etc/credentials", DefaultMode: tt.defaultMode},
+					},
+				},
+			}
+
+			(&PodInjector{}).injectVolume(context.Background(), pod, llmAccess)
+
+			if len(pod.Spec.Volumes) != 1 || pod.Spec.Volumes[0].Secret == nil || pod.Spec.Volumes[0].Secret.DefaultMode == nil {
+				t.Fatalf("Volumes = %+v, want one secret volume with a default mode", pod.Spec.Volumes)
+			}
+			if got := *pod.Spec.Volumes[0].Secret.DefaultMode; got != tt.want {
+				t.Errorf("DefaultMode = %#o, want %#o", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPodInjector_injectVolume_UnreadableWarning(t *testing.T) {
+	uid := func(id int64) *int64 { return &id }
+	nonRoot := true
+	worldReadable := int32(0o444)
+	ownerOnly := int32(0o400)
+
+	tests := []struct {
+		name             string
+		podContext       *corev1.PodSecurityContext
+		containerContext *corev1.SecurityContext
+		defaultMode      *int32
+		wantWarning      string
+	}{
+		{name: "root container"},
+		{
+			name:        "non-root without fsGroup",
+			podContext:  &corev1.PodSecurityContext{RunAsUser: uid(1000)},
+			wantWarning: "set spec.securityContext.fsGroup",
+		},
+		{
+			name:        "runAsNonRoot without fsGroup",
+			podContext:  &corev1.PodSecurityContext{RunAsNonRoot: &nonRoot},
+			wantWarning: "set spec.securityContext.fsGroup",
+		},
+		{
+			name:       "non-root with fsGroup",
+			podContext: &corev1.PodSecurityContext{RunAsUser: uid(1000), FSGroup: uid(2000)},
+		},
+		{
+			name:        "fsGroup with an owner-only mode",
+			podContext:  &corev1.PodSecurityContext{RunAsUser: uid(1000), FSGroup: uid(2000)},
+			defaultMode: &ownerOnly,
+			wantWarning: "make spec.injection.volume.defaultMode group-readable",
+		},
+		{
+			name:        "world-readable mode",
+			podContext:  &corev1.PodSecurityContext{RunAsUser: uid(1000)},
+			defaultMode: &worldReadable,
+		},
+		{
+			name:             "container overrides a non-root pod with root",
+			podContext:       &corev1.PodSecurityContext{RunAsUser: uid(1000)},
+			containerContext: &corev1.SecurityContext{RunAsUser: uid(0)},
+		},
+		{
+			name:             "non-root container in a pod with fsGroup unset",
+			containerContext: &corev1.SecurityContext{RunAsUser: uid(1000)},
+			wantWarning:      "set spec.securityContext.fsGroup",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "app"},
+				Spec: corev1.PodSpec{
+					SecurityContext: tt.podContext,
+					Containers:      []corev1.Container{{Name: "main", Image: "nginx", SecurityContext: tt.containerContext}},
+				},
+			}
+			llmAccess := &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-access"},
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					SecretName: "test-secret",
+					Injection: llmwardenv1alpha1.InjectionConfig{
+						Volume: &llmwardenv1alpha1.VolumeInjection{MountPath: "/etc/credentials", DefaultMode: tt.defaultMode},
+					},
+				},
+			}
+			recorder := record.NewFakeRecorder(10)
+
+			warnings := (&PodInjector{Recorder: recorder}).injectVolume(context.Background(), pod, llmAccess)
+
+			if tt.wantWarning == "" {
+				if len(warnings) != 0 {
+					t.Errorf("warnings = %v, want none", warnings)
+				}
+				if len(recorder.Events) != 0 {
+					t.Errorf("recorded event %q, want none", <-recorder.Events)
+				}
+				return
+			}
+			if len(warnings) != 1 || !strings.Contains(warnings[0], tt.wantWarning) || !strings.Contains(warnings[0], "main") {
+				t.Errorf("warnings = %v, want one naming container main and suggesting %q", warnings, tt.wantWarning)
+			}
+			if len(recorder.Events) != 1 {
+				t.Fatalf("recorded %d events, want 1", len(recorder.Events))
+			}
+			if event := <-recorder.Events; !strings.Contains(event, ReasonCredentialsLikelyUnreadable) {
+				t.Errorf("event = %q, want reason %s", event, ReasonCredentialsLikelyUnreadable)
+			}
+		})
+	}
+}
+
+func TestPodInjector_injectVolume_SkipsPrivilegedContainers(t *testing.T) {
+	privileged := true
+	bidirectional := corev1.MountPropagationBidirectional
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "main", Image: "nginx"},
+				{
+					Name:            "node-agent",
+					Image:           "agent",
+					SecurityContext: &corev1.SecurityContext{Privileged: &privileged},
+				},
+				{
+					Name:  "csi-sidecar",
+					Image: "csi",
+					VolumeMounts: []corev1.VolumeMount{
+						{Name: "plugins", MountPath: "/var/lib/kubelet/plugins", MountPropagation: &bidirectional},
+					},
+				},
+			},
+		},
+	}
+	llmAccess := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: "vol-access", Namespace: "test-ns"},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			SecretName: "test-secret",
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				Volume: &llmwardenv1alpha1.VolumeInjection{MountPath: "/etc/llm"},
+			},
+		},
+	}
+
+	injector := &PodInjector{}
+	warnings := injector.injectVolume(context.Background(), pod, llmAccess)
+
+	hasCredentialMount := func(c corev1.Container) bool {
+		return slices.ContainsFunc(c.VolumeMounts, func(m corev1.VolumeMount) bool { return m.Name == "llmwarden-vol-access" })
+	}
+	if !hasCredentialMount(pod.Spec.Containers[0]) {
+		t.Error("expected the unprivileged container to get the credential mount")
+	}
+	for _, c := range pod.Spec.Containers[1:] {
+		if hasCredentialMount(c) {
+			t.Errorf("container %s got the credential mount, want it skipped", c.Name)
+		}
+	}
+
+	if len(warnings) != 2 {
+		t.Fatalf("warnings = %v, want one per skipped container", warnings)
+	}
+	if !strings.Contains(warnings[0], `"node-agent" is privileged`) {
+		t.Errorf("warnings[0] = %q, want it to name the privileged container", warnings[0])
+	}
+	if !strings.Contains(warnings[1], `"csi-sidecar" is using bidirectional mount propagation`) {
+		t.Errorf("warnings[1] = %q, want it to name the bidirectional container", warnings[1])
+	}
+}
+
+func TestPodInjector_injectCredentials_InitContainersOnly(t *testing.T) {
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{
+				{Name: "setup", Image: "busybox"},
+				{Name: "fetch-model", Image: "fetcher"},
+			},
+			Containers: []corev1.Container{
+				{Name: "main", Image: "nginx"},
+				{Name: "sidecar", Image: "envoy"},
+			},
+		},
+	}
+	llmAccess := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: "model-fetch", Namespace: "test-ns"},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			SecretName:  "test-secret",
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai"},
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				InitContainers: []string{"fetch-model", "not-in-pod"},
+				Env:            []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+				Volume:         &llmwardenv1alpha1.VolumeInjection{MountPath: "/etc/llm"},
+			},
+		},
+	}
+
+	injector := &PodInjector{}
+	injector.injectCredentials(context.Background(), pod, llmAccess, nil)
+
+	injected := func(c corev1.Container) bool {
+		hasEnv := slices.ContainsFunc(c.Env, func(e corev1.EnvVar) bool { return e.Name == "OPENAI_API_KEY" })
+		hasMount := slices.ContainsFunc(c.VolumeMounts, func(m corev1.VolumeMount) bool { return m.Name == "llmwarden-model-fetch" })
+		if hasEnv != hasMount {
+			t.Errorf("container %s: env injected = %v, volume mounted = %v, want both or neither", c.Name, hasEnv, hasMount)
+		}
+		return hasEnv
+	}
+	if !injected(pod.Spec.InitContainers[1]) {
+		t.Error("expected the named init container to get the credentials")
+	}
+	if injected(pod.Spec.InitContainers[0]) {
+		t.Error("init container setup got the credentials, want only the named init container")
+	}
+	for _, c := range pod.Spec.Containers {
+		if injected(c) || len(c.Env) != 0 || len(c.VolumeMounts) != 0 {
+			t.Errorf("container %s was modified, want regular containers untouched", c.Name)
+		}
+	}
+	if len(pod.Spec.Volumes) != 1 {
+		t.Errorf("volumes = %v, want the credential volume for the init container", pod.Spec.Volumes)
+	}
+}
+
+func TestPodInjector_injectVolume_Medium(t *testing.T) {
+	tests := []struct {
+		name       string
+		medium     llmwardenv1alpha1.VolumeMedium
+		copyImage  string
+		wantMemory bool
+	}{
+		{name: "default medium mounts the secret volume", copyImage: "ghcr.io/llmwarden/llmwarden:v0.1.0"},
+		{name: "secret medium mounts the secret volume", medium: llmwardenv1alpha1.VolumeMediumSecret, copyImage: "ghcr.io/llmwarden/llmwarden:v0.1.0"},
+		{name: "memory medium mounts a memory-backed copy", medium: llmwardenv1alpha1.VolumeMediumMemory, copyImage: "ghcr.io/llmwarden/llmwarden:v0.1.0", wantMemory: true},
+		{name: "memory medium without a copy image falls back to the secret volume", medium: llmwardenv1alpha1.VolumeMediumMemory},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &corev1.Pod{
+				Spec: corev1.PodSpec{
+					InitContainers: []corev1.Container{{Name: "migrate", Image: "migrate"}},
+					Containers:     []corev1.Container{{Name: "main", Image: "nginx"}},
+				},
+			}
+			llmAccess := &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-access"},
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					SecretName: "test-secret",
+					Injection: llmwardenv1alpha1.InjectionConfig{
+						Volume: &llmwardenv1alpha1.VolumeInjection{MountPath: "/etc/credentials", Medium: tt.medium},
+					},
+				},
+			}
+
+			injector := &PodInjector{CredentialCopyImage: tt.copyImage}
+			injector.injectVolume(context.Background(), pod, llmAccess)
+
+			mounted := pod.Spec.Volumes[0]
+			if mounted.Name != "llmwarden-test-access" {
+				t.Fatalf("first volume = %s, want llmwarden-test-access", mounted.Name)
+			}
+			if got := pod.Spec.Containers[0].VolumeMounts; len(got) != 1 || got[0].Name != mounted.Name || !got[0].ReadOnly {
+				t.Errorf("container mounts = %+v, want a single read-only mount of %s", got, mounted.Name)
+			}
+
+			if !tt.wantMemory {
+				if mounted.Secret == nil || mounted.Secret.SecretName != "test-secret" {
+					t.Errorf("volume source = %+v, want secret test-secret", mounted.VolumeSource)
+				}
+				if len(pod.Spec.Volumes) != 1 || len(pod.Spec.InitContainers) != 1 {
+					t.Errorf("got %d volumes and %d init containers, want only the secret volume added",
+						len(pod.Spec.Volumes), len(pod.Spec.InitContainers))
+				}
+				return
+			}
+
+			if mounted.EmptyDir == nil || mounted.EmptyDir.Medium != corev1.StorageMediumMemory {
+				t.Fatalf("volume source = %+v, want emptyDir with medium Memory", mounted.VolumeSource)
+			}
+			if len(pod.Spec.Volumes) != 2 || pod.Spec.Volumes[1].Secret == nil || pod.Spec.Volumes[1].Secret.SecretName != "test-secret" {
+				t.Fatalf("volumes = %+v, want the secret as a copy source", pod.Spec.Volumes)
+			}
+
+			if len(pod.Spec.InitContainers) != 2 {
+				t.Fatalf("got %d init containers, want the copy container plus the original", len(pod.Spec.InitContainers))
+			}
+			copier := pod.Spec.InitContainers[0]
+			if copier.Name != CredentialCopyContainerName(llmAccess) || copier.Image != "ghcr.io/llmwarden/llmwarden:v0.1.0" {
+				t.Errorf("first init container = %s (%s), want the credential copy container", copier.Name, copier.Image)
+			}
+			mountNames := []string{}
+			for _, m := range copier.VolumeMounts {
+				mountNames = append(mountNames, m.Name)
+			}
+			if !slices.Equal(mountNames, []string{"llmwarden-test-access-src", "llmwarden-test-access"}) {
+				t.Errorf("copy container mounts = %v, want the source secret and the memory volume", mountNames)
+			}
+			if got := pod.Spec.InitContainers[1].VolumeMounts; len(got) != 1 || got[0].Name != mounted.Name {
+				t.Errorf("original init container mounts = %+v, want the memory volume", got)
+			}
+		})
+	}
+}
+
+func TestPodInjector_injectCredentials_SkipsAddedContainers(t *testing.T) {
+	access := func(name string, injection llmwardenv1alpha1.InjectionConfig) *llmwardenv1alpha1.LLMAccess {
+		return &llmwardenv1alpha1.LLMAccess{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-ns"},
+			Spec: llmwardenv1alpha1.LLMAccessSpec{
+				ProviderRef: llmwardenv1alpha1.ProviderReference{Name: name + "-provider"},
+				SecretName:  name + "-creds",
+				Injection:   injection,
+			},
+		}
+	}
+	first := access("openai", llmwardenv1alpha1.InjectionConfig{
+		Volume: &llmwardenv1alpha1.VolumeInjection{MountPath: "/etc/openai", Medium: llmwardenv1alpha1.VolumeMediumMemory},
+	})
+	second := access("anthropic", llmwardenv1alpha1.InjectionConfig{
+		Env:    []llmwardenv1alpha1.EnvVarMapping{{Name: "ANTHROPIC_API_KEY", SecretKey: "apiKey"}},
+		Volume: &llmwardenv1alpha1.VolumeInjection{MountPath: "/etc/anthropic", Medium: llmwardenv1alpha1.VolumeMediumMemory},
+	})
+
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "migrate", Image: "migrate"}},
+			Containers:     []corev1.Container{{Name: "main", Image: "nginx"}},
+		},
+	}
+	injector := &PodInjector{CredentialCopyImage: "ghcr.io/llmwarden/llmwarden:v0.1.0"}
+	claims := envClaims{}
+	for _, llmAccess := range []*llmwardenv1alpha1.LLMAccess{first, second} {
+		injector.injectCredentials(context.Background(), pod, llmAccess, claims)
+	}
+
+	for _, container := range pod.Spec.InitContainers {
+		switch container.Name {
+		case CredentialCopyContainerName(first), CredentialCopyContainerName(second):
+			if len(container.Env) != 0 || len(container.VolumeMounts) != 2 {
+				t.Errorf("%s env = %+v, mounts = %+v, want only its own source and destination mounts",
+					container.Name, container.Env, container.VolumeMounts)
+			}
+		case "migrate":
+			if len(container.Env) != 1 || len(container.VolumeMounts) != 2 {
+				t.Errorf("migrate env = %+v, mounts = %+v, want both accesses injected", container.Env, container.VolumeMounts)
+			}
+		default:
+			t.Errorf("unexpected init container %s", container.Name)
+		}
+	}
+}
+
+func TestPodInjector_injectVolume_LongAccessName(t *testing.T) {
+	// Volume names built from this access name would exceed the 63 character limit.
+	name := "team-a.openai-production-access-with-a-rather-long-descriptive"
+	pod := &corev1.Pod{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "main", Image: "nginx"}}}}
+	llmAccess := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			SecretName: "test-secret",
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				Volume: &llmwardenv1alpha1.VolumeInjection{MountPath: "/etc/credentials", Medium: llmwardenv1alpha1.VolumeMediumMemory},
+			},
+		},
+	}
+
+	injector := &PodInjector{CredentialCopyImage: "ghcr.io/llmwarden/llmwarden:v0.1.0"}
+	injector.injectVolume(context.Background(), pod, llmAccess)
+
+	if len(pod.Spec.Volumes) != 2 {
+		t.Fatalf("volumes = %+v, want the memory volume and its source", pod.Spec.Volumes)
+	}
+	for _, volume := range pod.Spec.Volumes {
+		if errs := validation.IsDNS1123Label(volume.Name); len(errs) != 0 {
+			t.Errorf("volume name %q is invalid: %v", volume.Name, errs)
+		}
+	}
+	if pod.Spec.Volumes[0].Name == pod.Spec.Volumes[1].Name {
+		t.Errorf("volume names are both %q, want them distinct", pod.Spec.Volumes[0].Name)
+	}
+	if src := pod.Spec.Volumes[1].Name; !strings.HasSuffix(src, "-src") {
+		t.Errorf("source volume name = %q, want the -src suffix kept", src)
+	}
+	copier := pod.Spec.InitContainers[0]
+	if copier.VolumeMounts[0].Name != pod.Spec.Volumes[1].Name || copier.VolumeMounts[1].Name != pod.Spec.Volumes[0].Name {
+		t.Errorf("copy container mounts = %+v, want the injected volumes", copier.VolumeMounts)
+	}
+
+	other := llmAccess.DeepCopy()
+	other.Name = name[:len(name)-1] + "x"
+	if accessVolumeName("llmwarden-", llmAccess, "") == accessVolumeName("llmwarden-", other, "") {
+		t.Error("accesses differing past the truncation got the same volume name")
+	}
+}
+
+func TestPodInjector_injectEnvFile(t *testing.T) {
+	// Both accesses map OPENAI_API_KEY, which the merged file prefixes by provider.
+	access := func(name, provider string, env ...llmwardenv1alpha1.EnvVarMapping) *llmwardenv1alpha1.LLMAccess {
+		return &llmwardenv1alpha1.LLMAccess{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-ns"},
+			Spec: llmwardenv1alpha1.LLMAccessSpec{
+				ProviderRef: llmwardenv1alpha1.ProviderReference{Name: provider},
+				SecretName:  name + "-creds",
+				Injection: llmwardenv1alpha1.InjectionConfig{
+					Env:     env,
+					EnvFile: &llmwardenv1alpha1.EnvFileInjection{MountPath: "/app/config"},
+				},
+			},
+		}
+	}
+	primary := access("primary", "openai-production",
+		llmwardenv1alpha1.EnvVarMapping{Name: "OPENAI_API_KEY", SecretKey: "apiKey"},
+		llmwardenv1alpha1.EnvVarMapping{Name: "OPENAI_BASE_URL", SecretKey: "baseUrl"})
+	fallback := access("fallback", "azure-openai", llmwardenv1alpha1.EnvVarMapping{Name: "OPENAI_API_KEY"})
+	fallback.Spec.Injection.EnvFile.MountPath = "/ignored"
+	accesses := []*llmwardenv1alpha1.LLMAccess{primary, fallback}
+
+	newPod := func() *corev1.Pod {
+		return &corev1.Pod{
+			Spec: corev1.PodSpec{
+				InitContainers: []corev1.Container{{Name: "migrate", Image: "migrate"}},
+				Containers:     []corev1.Container{{Name: "main", Image: "nginx"}},
+			},
+		}
+	}
+
+	t.Run("two accesses share one merged env file", func(t *testing.T) {
+		injector := &PodInjector{CredentialCopyImage: "ghcr.io/llmwarden/llmwarden:v0.1.0"}
+		pod := newPod()
+		for _, llmAccess := range accesses {
+			injector.injectCredentials(context.Background(), pod, llmAccess, envClaims{})
+		}
+		if warnings := injector.injectEnvFile(context.Background(), pod, accesses); len(warnings) != 0 {
+			t.Errorf("warnings = %v, want none", warnings)
+		}
+
+		if got := pod.Spec.Containers[0].Env; len(got) != 0 {
+			t.Errorf("main env = %+v, want the mappings in the env file only", got)
+		}
+		if len(pod.Spec.Volumes) != 2 || pod.Spec.Volumes[0].Name != "llmwarden-envfile" || pod.Spec.Volumes[0].EmptyDir == nil ||
+			pod.Spec.Volumes[0].EmptyDir.Medium != corev1.StorageMediumMemory {
+			t.Fatalf("volumes = %+v, want one memory-backed env file volume and its source", pod.Spec.Volumes)
+		}
+		projected := pod.Spec.Volumes[1].Projected
+		if pod.Spec.Volumes[1].Name != "llmwarden-envfile-src" || projected == nil || len(projected.Sources) != 2 {
+			t.Fatalf("source volume = %+v, want a projection of both accesses' secrets", pod.Spec.Volumes[1])
+		}
+		type item struct{ secret, key, path string }
+		var items []item
+		for _, source := range projected.Sources {
+			for _, kp := range source.Secret.Items {
+				items = append(items, item{source.Secret.Name, kp.Key, kp.Path})
+			}
+		}
+		wantItems := []item{
+			{"primary-creds", "apiKey", "OPENAI_PRODUCTION_OPENAI_API_KEY"},
+			{"primary-creds", "baseUrl", "OPENAI_BASE_URL"},
+			{"fallback-creds", provisioner.DefaultCredentialKey, "AZURE_OPENAI_OPENAI_API_KEY"},
+		}
+		if !reflect.DeepEqual(items, wantItems) {
+			t.Errorf("env file entries = %+v, want %+v", items, wantItems)
+		}
+
+		if len(pod.Spec.InitContainers) != 2 || pod.Spec.InitContainers[0].Name != EnvFileContainerName ||
+			pod.Spec.InitContainers[0].Image != "ghcr.io/llmwarden/llmwarden:v0.1.0" {
+			t.Fatalf("init containers = %+v, want the env file writer first", pod.Spec.InitContainers)
+		}
+		// One mount per container, at the path of the access injected first.
+		wantMount := corev1.VolumeMount{Name: "llmwarden-envfile", MountPath: "/app/config", ReadOnly: true}
+		for _, container := range []corev1.Container{pod.Spec.InitContainers[1], pod.Spec.Containers[0]} {
+			if got := container.VolumeMounts; len(got) != 1 || got[0] != wantMount {
+				t.Errorf("%s mounts = %+v, want %+v", container.Name, got, wantMount)
+			}
+		}
+	})
+
+	t.Run("same provider collisions keep the earlier access", func(t *testing.T) {
+		other := access("other", "openai-production", llmwardenv1alpha1.EnvVarMapping{Name: "OPENAI_API_KEY"})
+		entries, warnings := envFileEntries([]*llmwardenv1alpha1.LLMAccess{primary, other})
+		if len(entries) != 2 || entries[0].access != primary || entries[0].name != "OPENAI_PRODUCTION_OPENAI_API_KEY" {
+			t.Errorf("entries = %+v, want primary's two entries", entries)
+		}
+		if len(warnings) != 1 || !strings.Contains(warnings[0], "OPENAI_PRODUCTION_OPENAI_API_KEY of LLMAccess other") {
+			t.Errorf("warnings = %v, want one about other losing OPENAI_PRODUCTION_OPENAI_API_KEY", warnings)
+		}
+	})
+
+	t.Run("without a copy image the mappings are injected as env vars", func(t *testing.T) {
+		injector := &PodInjector{}
+		pod := newPod()
+		injector.injectCredentials(context.Background(), pod, primary, envClaims{})
+		if injector.writesEnvFile(primary) {
+			t.Errorf("writesEnvFile() = true, want false without a copy image")
+		}
+		if got := pod.Spec.Containers[0].Env; len(got) != 2 {
+			t.Errorf("main env = %+v, want OPENAI_API_KEY and OPENAI_BASE_URL", got)
+		}
+	})
+}
+
+func TestPodInjector_injectCredentials_CACert(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	providerWithCA := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "private-llm"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderCustom,
+			Auth:     llmwardenv1alpha1.AuthConfig{Type: llmwardenv1alpha1.AuthTypeAPIKey},
+			Endpoint: &llmwardenv1alpha1.EndpointConfig{
+				BaseURL: "https://llm.internal.example.com/v1",
+				CASecretRef: &llmwardenv1alpha1.SecretReference{
+					Name: "private-llm-ca", Namespace: "llmwarden-system", Key: "ca.crt",
+				},
+			},
+		},
+	}
+	providerWithoutCA := &llmwardenv1alpha1.LLMProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai-prod"},
+		Spec: llmwardenv1alpha1.LLMProviderSpec{
+			Provider: llmwardenv1alpha1.ProviderOpenAI,
+			Auth:     llmwardenv1alpha1.AuthConfig{Type: llmwardenv1alpha1.AuthTypeAPIKey},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		provider  string
+		caCert    *llmwardenv1alpha1.CACertInjection
+		wantMount string
+		wantEnv   string
+	}{
+		{
+			name:      "defaults mount the bundle and set SSL_CERT_FILE",
+			provider:  "private-llm",
+			caCert:    &llmwardenv1alpha1.CACertInjection{},
+			wantMount: "/etc/llmwarden/ca",
+			wantEnv:   "SSL_CERT_FILE",
+		},
+		{
+			name:     "custom mount path and env var",
+			provider: "private-llm",
+			caCert: &llmwardenv1alpha1.CACertInjection{
+				MountPath: "/etc/ssl/llm", EnvVar: "REQUESTS_CA_BUNDLE",
+			},
+			wantMount: "/etc/ssl/llm",
+			wantEnv:   "REQUESTS_CA_BUNDLE",
+		},
+		{
+			name:     "provider without a CA bundle is skipped",
+			provider: "openai-prod",
+			caCert:   &llmwardenv1alpha1.CACertInjection{},
+		},
+		{
+			name:     "missing provider is skipped",
+			provider: "missing",
+			caCert:   &llmwardenv1alpha1.CACertInjection{},
+		},
+		{
+			name:     "not requested",
+			provider: "private-llm",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			injector := &PodInjector{
+				Client: fake.NewClientBuilder().WithScheme(scheme).
+					WithObjects(providerWithCA.DeepCopy(), providerWithoutCA.DeepCopy()).Build(),
+			}
+			pod := &corev1.Pod{
+				Spec: corev1.PodSpec{
+					InitContainers: []corev1.Container{{Name: "init", Image: "busybox"}},
+					Containers:     []corev1.Container{{Name: "main", Image: "nginx"}},
+				},
+			}
+			llmAccess := &llmwardenv1alpha1.LLMAccess{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-access", Namespace: "test-ns"},
+				Spec: llmwardenv1alpha1.LLMAccessSpec{
+					ProviderRef: llmwardenv1alpha1.ProviderReference{Name: tt.provider},
+					SecretName:  "test-secret",
+					Injection:   llmwardenv1alpha1.InjectionConfig{CACert: tt.caCert},
+				},
+			}
+
+			injector.injectCredentials(context.Background(), pod, llmAccess, nil)
+
+			if tt.wantMount == "" {
+				if len(pod.Spec.Volumes) != 0 {
+					t.Errorf("Expected no volumes, got %v", pod.Spec.Volumes)
+				}
+				for _, container := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+					if len(container.VolumeMounts) != 0 || len(container.Env) != 0 {
+						t.Errorf("Container %s should be unchanged, got mounts %v env %v",
+							container.Name, container.VolumeMounts, container.Env)
+					}
+				}
+				return
+			}
+
+			if len(pod.Spec.Volumes) != 1 {
+				t.Fatalf("Expected 1 volume, got %d", len(pod.Spec.Volumes))
+			}
+			volume := pod.Spec.Volumes[0]
+			if volume.Name != "llmwarden-ca-test-access" {
+				t.Errorf("Expected volume name llmwarden-ca-test-access, got %s", volume.Name)
+			}
+			wantItems := []corev1.KeyToPath{{Key: provisioner.CACertKey, Path: "ca.crt"}}
+			if volume.Secret == nil || volume.Secret.SecretName != "test-secret" ||
+				!reflect.DeepEqual(volume.Secret.Items, wantItems) {
+				t.Errorf("Volume should project only %s from test-secret, got %+v", provisioner.CACertKey, volume.Secret)
+			}
+
+			wantPath := tt.wantMount + "/ca.crt"
+			for _, container := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+				if len(container.VolumeMounts) != 1 {
+					t.Fatalf("Container %s: expected 1 volume mount, got %d", container.Name, len(container.VolumeMounts))
+				}
+				mount := container.VolumeMounts[0]
+				if mount.MountPath != tt.wantMount || !mount.ReadOnly {
+					t.Errorf("Container %s: expected read-only mount at %s, got %+v", container.Name, tt.wantMount, mount)
+				}
+				wantEnv := []corev1.EnvVar{{Name: tt.wantEnv, Value: wantPath}}
+				if !reflect.DeepEqual(container.Env, wantEnv) {
+					t.Errorf("Container %s: expected env %v, got %v", container.Name, wantEnv, container.Env)
+				}
+			}
+		})
+	}
+}
+
+func TestPodInjector_injectCACert_LongAccessName(t *testing.T) {
+	pod := &corev1.Pod{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "main", Image: "nginx"}}}}
+	llmAccess := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: strings.Repeat("a", 51), Namespace: "test-ns"},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			SecretName: "test-secret",
+			Injection:  llmwardenv1alpha1.InjectionConfig{CACert: &llmwardenv1alpha1.CACertInjection{}},
+		},
+	}
+
+	(&PodInjector{}).injectCACert(context.Background(), pod, llmAccess)
+
+	if len(pod.Spec.Volumes) != 1 {
+		t.Fatalf("Expected 1 volume, got %d", len(pod.Spec.Volumes))
+	}
+	name := pod.Spec.Volumes[0].Name
+	if errs := validation.IsDNS1123Label(name); len(errs) != 0 {
+		t.Errorf("volume name %q is invalid: %v", name, errs)
+	}
+	if mounts := pod.Spec.Containers[0].VolumeMounts; len(mounts) != 1 || mounts[0].Name != name {
+		t.Errorf("mounts = %+v, want one mount of %s", mounts, name)
+	}
+}
+
+func TestPodInjector_injectUsageSidecar(t *testing.T) {
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "main", Image: "nginx"},
+			},
+		},
+	}
+
+	llmAccess := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-access",
+		},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai-prod"},
+			SecretName:  "test-secret",
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				UsageSidecar: &llmwardenv1alpha1.UsageSidecarConfig{
+					Image:     "ghcr.io/llmwarden/usage-proxy:v0.1.0",
+					ProxyPort: 8089,
+				},
+			},
+		},
+	}
+
+	injector := &PodInjector{}
+	injector.injectUsageSidecar(context.Background(), pod, llmAccess)
+
+	// The sidecar is a native sidecar: an init container that restarts always.
+	if len(pod.Spec.Containers) != 1 || len(pod.Spec.InitContainers) != 1 {
+		t.Fatalf("Expected 1 container and 1 init container, got %d and %d",
+			len(pod.Spec.Containers), len(pod.Spec.InitContainers))
+	}
+
+	sidecar := pod.Spec.InitContainers[0]
+	if sidecar.Name != "llmwarden-usage-test-access" {
+		t.Errorf("Expected container name llmwarden-usage-test-access, got %s", sidecar.Name)
+	}
+	if sidecar.RestartPolicy == nil || *sidecar.RestartPolicy != corev1.ContainerRestartPolicyAlways {
+		t.Errorf("Expected restartPolicy Always, got %v", sidecar.RestartPolicy)
+	}
+	if sidecar.Image != "ghcr.io/llmwarden/usage-proxy:v0.1.0" {
+		t.Errorf("Expected configured image, got %s", sidecar.Image)
+	}
+
+	// Metrics port falls back to the default when unset
+	ports := map[int32]bool{}
+	for _, p := range sidecar.Ports {
+		ports[p.ContainerPort] = true
+	}
+	if !ports[8089] || !ports[llmwardenv1alpha1.DefaultUsageSidecarMetricsPort] {
+		t.Errorf("Expected proxy port 8089 and default metrics port, got %v", sidecar.Ports)
+	}
+
+	// The sidecar reads the upstream endpoint from the access secret but never the API key
+	for _, env := range sidecar.Env {
+		if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil && env.ValueFrom.SecretKeyRef.Key == "apiKey" {
+			t.Error("Usage sidecar must not receive the API key")
+		}
+	}
+	if sidecar.SecurityContext == nil || sidecar.SecurityContext.AllowPrivilegeEscalation == nil ||
+		*sidecar.SecurityContext.AllowPrivilegeEscalation {
+		t.Error("Expected usage sidecar to disallow privilege escalation")
+	}
+
+	// Injection is idempotent per access
+	injector.injectUsageSidecar(context.Background(), pod, llmAccess)
+	if len(pod.Spec.InitContainers) != 1 {
+		t.Errorf("Expected sidecar to be injected once, got %d init containers", len(pod.Spec.InitContainers))
+	}
+}
+
+// TestPodInjector_injectUsageSidecarJobPod checks that the sidecar of a Job's pod leaves
+// the Job's containers alone, so the pod completes once they exit.
+func TestPodInjector_injectUsageSidecarJobPod(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "nightly-eval-x7k2p",
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "batch/v1", Kind: "Job", Name: "nightly-eval", UID: "job-uid"},
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:  corev1.RestartPolicyNever,
+			InitContainers: []corev1.Container{{Name: "fetch-dataset", Image: "busybox"}},
+			Containers:     []corev1.Container{{Name: "eval", Image: "eval-runner"}},
+		},
+	}
+	llmAccess := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: "eval-access"},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai-prod"},
+			SecretName:  "eval-secret",
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				UsageSidecar: &llmwardenv1alpha1.UsageSidecarConfig{Image: "ghcr.io/llmwarden/usage-proxy:v0.1.0"},
+			},
+		},
+	}
+
+	(&PodInjector{}).injectUsageSidecar(context.Background(), pod, llmAccess)
+
+	if len(pod.Spec.Containers) != 1 || pod.Spec.Containers[0].Name != "eval" {
+		t.Errorf("Expected only the Job's container, got %v", pod.Spec.Containers)
+	}
+	if len(pod.Spec.InitContainers) != 2 || pod.Spec.InitContainers[0].Name != "fetch-dataset" {
+		t.Fatalf("Expected the sidecar after the Job's init container, got %v", pod.Spec.InitContainers)
+	}
+	sidecar := pod.Spec.InitContainers[1]
+	if sidecar.Name != "llmwarden-usage-eval-access" || sidecar.RestartPolicy == nil ||
+		*sidecar.RestartPolicy != corev1.ContainerRestartPolicyAlways {
+		t.Errorf("Expected a native sidecar llmwarden-usage-eval-access, got %s with restartPolicy %v",
+			sidecar.Name, sidecar.RestartPolicy)
+	}
+}
+
+func TestUsageSidecarContainerName(t *testing.T) {
+	llmAccess := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "a-very-long-llmaccess-name-that-would-overflow-the-container-name-limit",
+		},
+	}
+
+	name := UsageSidecarContainerName(llmAccess)
+	if len(name) > 63 {
+		t.Errorf("Expected container name of at most 63 characters, got %d", len(name))
+	}
+}
+
+func TestPodInjector_Handle_ListFailureCooldown(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	listCalls := 0
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithInterceptorFuncs(interceptor.Funcs{
+			List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+				listCalls++
+				return errors.New("apiserver throttled")
+			},
+		}).
+		Build()
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	injector := &PodInjector{
+		Client:              fakeClient,
+		decoder:             admission.NewDecoder(scheme),
+		ListFailureCooldown: 10 * time.Second,
+		now:                 func() time.Time { return now },
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-pod", Namespace: "test-ns"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "main", Image: "nginx"}},
+		},
+	}
+	podBytes, err := json.Marshal(pod)
+	if err != nil {
+		t.Fatalf("Failed to marshal pod: %v", err)
+	}
+	req := admission.Request{}
+	req.Namespace = pod.Namespace
+	req.Object = runtime.RawExtension{Raw: podBytes}
+
+	steps := []struct {
+		name          string
+		advance       time.Duration
+		wantListCalls int
+	}{
+		{name: "first failure lists and starts cooldown", wantListCalls: 1},
+		{name: "admission within cooldown skips list", advance: 5 * time.Second, wantListCalls: 1},
+		{name: "another admission within cooldown skips list", advance: 4 * time.Second, wantListCalls: 1},
+		{name: "admission after cooldown lists again", advance: 2 * time.Second, wantListCalls: 2},
+	}
+
+	for _, step := range steps {
+		now = now.Add(step.advance)
+		resp := injector.Handle(context.Background(), req)
+		if !resp.Allowed {
+			t.Errorf("%s: Handle() allowed = false, want true", step.name)
+		}
+		if listCalls != step.wantListCalls {
+			t.Errorf("%s: list calls = %d, want %d", step.name, listCalls, step.wantListCalls)
+		}
+	}
+}
+
+func TestPodInjector_Handle_InjectionRequired(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	namespace := func(name string, labels map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	}
+
+	tests := []struct {
+		name        string
+		namespace   string
+		wantAllowed bool
+	}{
+		{name: "injection-required namespace denies pods on list failure", namespace: "required", wantAllowed: false},
+		{name: "namespace with the label set to false stays fail-open", namespace: "explicitly-optional", wantAllowed: true},
+		{name: "unlabeled namespace stays fail-open", namespace: "optional", wantAllowed: true},
+		{name: "unreadable namespace stays fail-open", namespace: "missing", wantAllowed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(
+					namespace("required", map[string]string{InjectionRequiredLabel(): "true"}),
+					namespace("explicitly-optional", map[string]string{InjectionRequiredLabel(): "false"}),
+					namespace("optional", nil),
+				).
+				WithInterceptorFuncs(interceptor.Funcs{
+					List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+						return errors.New("apiserver throttled")
+					},
+				}).
+				Build()
+
+			injector := &PodInjector{
+				Client:  fakeClient,
+				decoder: admission.NewDecoder(scheme),
+			}
+
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "chatbot", Namespace: tt.namespace},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "main", Image: "nginx"}},
+				},
+			}
+			podBytes, err := json.Marshal(pod)
+			if err != nil {
+				t.Fatalf("Failed to marshal pod: %v", err)
+			}
+			req := admission.Request{}
+			req.Namespace = tt.namespace
+			req.Object = runtime.RawExtension{Raw: podBytes}
+
+			resp := injector.Handle(context.Background(), req)
+			if resp.Allowed != tt.wantAllowed {
+				t.Errorf("Handle() allowed = %v, want %v", resp.Allowed, tt.wantAllowed)
+			}
+			if !tt.wantAllowed && !strings.Contains(resp.Result.Message, InjectionRequiredLabel()) {
+				t.Errorf("denial message = %q, want it to mention %s", resp.Result.Message, InjectionRequiredLabel())
+			}
+		})
+	}
+}
+
+func TestPodInjector_Handle_WatchNamespaces(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	access := func(namespace string) *llmwardenv1alpha1.LLMAccess {
+		return &llmwardenv1alpha1.LLMAccess{
+			ObjectMeta: metav1.ObjectMeta{Name: "openai-access", Namespace: namespace},
+			Spec: llmwardenv1alpha1.LLMAccessSpec{
+				ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai-prod"},
+				SecretName:  "openai-creds",
+				WorkloadSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "chatbot"},
+				},
+				Injection: llmwardenv1alpha1.InjectionConfig{
+					Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		namespace   string
+		wantPatched bool
+	}{
+		{name: "pod in a watched namespace is injected", namespace: "team-a", wantPatched: true},
+		{name: "pod outside the watched namespaces is ignored", namespace: "team-b", wantPatched: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listCalls := 0
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(access("team-a"), access("team-b")).
+				WithInterceptorFuncs(interceptor.Funcs{
+					List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+						listCalls++
+						return c.List(ctx, list, opts...)
+					},
+				}).
+				Build()
+
+			injector := &PodInjector{
+				Client:          fakeClient,
+				decoder:         admission.NewDecoder(scheme),
+				WatchNamespaces: []string{"team-a"},
+			}
+
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "chatbot",
+					Namespace: tt.namespace,
+					Labels:    map[string]string{"app": "chatbot"},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "main", Image: "nginx"}},
+				},
+			}
+			podBytes, err := json.Marshal(pod)
+			if err != nil {
+				t.Fatalf("Failed to marshal pod: %v", err)
+			}
+			req := admission.Request{}
+			req.Namespace = tt.namespace
+			req.Object = runtime.RawExtension{Raw: podBytes}
+
+			resp := injector.Handle(context.Background(), req)
+			if !resp.Allowed {
+				t.Fatalf("Handle() allowed = false, want true")
+			}
+			if got := len(resp.Patches) > 0; got != tt.wantPatched {
+				t.Errorf("patched = %v, want %v", got, tt.wantPatched)
+			}
+			if !tt.wantPatched && listCalls != 0 {
+				t.Errorf("list calls = %d, want 0 for an unwatched namespace", listCalls)
+			}
+		})
+	}
+}
+
+func TestPodInjector_Handle_InjectionDetail(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "chatbot"}}
+	envAccess := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai-env", Namespace: "test-ns"},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			ProviderRef:      llmwardenv1alpha1.ProviderReference{Name: "openai-prod"},
+			SecretName:       "openai-creds",
+			WorkloadSelector: selector,
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+			},
+		},
+	}
+	volumeAccess := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: "anthropic-volume", Namespace: "test-ns"},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			ProviderRef:      llmwardenv1alpha1.ProviderReference{Name: "anthropic-prod"},
+			SecretName:       "anthropic-creds",
+			WorkloadSelector: selector,
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				Volume: &llmwardenv1alpha1.VolumeInjection{MountPath: "/etc/anthropic"},
+			},
+		},
+	}
+	injector := &PodInjector{
+		Client:  fake.NewClientBuilder().WithScheme(scheme).WithObjects(envAccess, volumeAccess).Build(),
+		decoder: admission.NewDecoder(scheme),
+	}
+
+	// The privileged container gets the env var but not the credential volume.
+	privileged := true
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "chatbot", Namespace: "test-ns", Labels: map[string]string{"app": "chatbot"}},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "setup", Image: "busybox"}},
+			Containers: []corev1.Container{
+				{Name: "main", Image: "nginx"},
+				{Name: "agent", Image: "agent", SecurityContext: &corev1.SecurityContext{Privileged: &privileged}},
+			},
+		},
+	}
+	podBytes, err := json.Marshal(pod)
+	if err != nil {
+		t.Fatalf("Failed to marshal pod: %v", err)
+	}
+	req := admission.Request{}
+	req.Namespace = pod.Namespace
+	req.Object = runtime.RawExtension{Raw: podBytes}
+
+	resp := injector.Handle(context.Background(), req)
+	if !resp.Allowed {
+		t.Fatalf("Handle() allowed = false, want true")
+	}
+
+	var raw string
+	for _, op := range resp.Patches {
+		if op.Path != "/metadata/annotations" {
+			continue
+		}
+		annotations, ok := op.Value.(map[string]any)
+		if !ok {
+			t.Fatalf("annotations patch value = %#v, want a map", op.Value)
+		}
+		raw, _ = annotations[InjectionDetailAnnotation()].(string)
+	}
+	if raw == "" {
+		t.Fatalf("patches = %v, want an %s annotation", resp.Patches, InjectionDetailAnnotation())
+	}
+
+	var got []InjectionDetail
+	if err := json.Unmarshal([]byte(raw), &got); err != nil {
+		t.Fatalf("%s = %q is not valid JSON: %v", InjectionDetailAnnotation(), raw, err)
+	}
+	want := []InjectionDetail{
+		{Access: "anthropic-volume", Provider: "anthropic-prod", Containers: 1, InitContainers: 1},
+		{Access: "openai-env", Provider: "openai-prod", Containers: 2, InitContainers: 1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("%s = %+v, want %+v", InjectionDetailAnnotation(), got, want)
+	}
+	if strings.Contains(raw, "openai-creds") || strings.Contains(raw, "anthropic-creds") {
+		t.Errorf("%s = %q names a secret, want only accesses, providers and counts", InjectionDetailAnnotation(), raw)
+	}
+}
+
+func TestPodInjector_Handle_AccessAnnotation(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	// The access has no workload selector, so only the annotation can bind it.
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai-access", Namespace: "test-ns"},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			ProviderRef: llmwardenv1alpha1.ProviderReference{Name: "openai-prod"},
+			SecretName:  "openai-creds",
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		annotation   string
+		wantPatched  bool
+		wantWarnings []string
+	}{
+		{
+			name:        "named access is injected without a selector",
+			annotation:  "openai-access",
+			wantPatched: true,
+		},
+		{
+			name:         "missing access is warned about alongside injected ones",
+			annotation:   "openai-access,missing-access",
+			wantPatched:  true,
+			wantWarnings: []string{`LLMAccess "missing-access"`},
+		},
+		{
+			name:         "only missing accesses admits the pod unchanged with a warning",
+			annotation:   "missing-access",
+			wantPatched:  false,
+			wantWarnings: []string{`LLMAccess "missing-access"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			injector := &PodInjector{
+				Client:  fake.NewClientBuilder().WithScheme(scheme).WithObjects(access.DeepCopy()).Build(),
+				decoder: admission.NewDecoder(scheme),
+			}
+
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "chatbot",
+					Namespace:   "test-ns",
+					Annotations: map[string]string{AccessAnnotation(): tt.annotation},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "main", Image: "nginx"}},
+				},
+			}
+			podBytes, err := json.Marshal(pod)
+			if err != nil {
+				t.Fatalf("Failed to marshal pod: %v", err)
+			}
+			req := admission.Request{}
+			req.Namespace = pod.Namespace
+			req.Object = runtime.RawExtension{Raw: podBytes}
+
+			resp := injector.Handle(context.Background(), req)
+			if !resp.Allowed {
+				t.Fatalf("Handle() allowed = false, want true")
+			}
+			if got := len(resp.Patches) > 0; got != tt.wantPatched {
+				t.Errorf("patched = %v, want %v", got, tt.wantPatched)
+			}
+			if len(resp.Warnings) != len(tt.wantWarnings) {
+				t.Fatalf("warnings = %v, want %d warnings", resp.Warnings, len(tt.wantWarnings))
+			}
+			for idx, want := range tt.wantWarnings {
+				if !strings.Contains(resp.Warnings[idx], want) {
+					t.Errorf("warnings[%d] = %q, want it to contain %q", idx, resp.Warnings[idx], want)
+				}
+			}
+		})
+	}
+}
+
+func TestPodInjector_Handle_InjectionLimits(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "chatbot"}}
+	access := func(name, provider string, env []string, volume bool) *llmwardenv1alpha1.LLMAccess {
+		a := &llmwardenv1alpha1.LLMAccess{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-ns"},
+			Spec: llmwardenv1alpha1.LLMAccessSpec{
+				ProviderRef:      llmwardenv1alpha1.ProviderReference{Name: provider},
+				SecretName:       name + "-creds",
+				WorkloadSelector: selector,
+			},
+		}
+		for _, envName := range env {
+			a.Spec.Injection.Env = append(a.Spec.Injection.Env, llmwardenv1alpha1.EnvVarMapping{Name: envName, SecretKey: "apiKey"})
+		}
+		if volume {
+			a.Spec.Injection.Volume = &llmwardenv1alpha1.VolumeInjection{MountPath: "/etc/" + name}
+		}
+		return a
+	}
+
+	tests := []struct {
+		name          string
+		limits        InjectionLimits
+		accesses      []*llmwardenv1alpha1.LLMAccess
+		wantProviders string
+		wantSkipped   string
+	}{
+		{
+			name:   "env var limit skips the access that would exceed it",
+			limits: InjectionLimits{EnvVars: 2},
+			accesses: []*llmwardenv1alpha1.LLMAccess{
+				access("a-openai", "openai-prod", []string{"OPENAI_API_KEY", "OPENAI_BASE_URL"}, false),
+				access("b-anthropic", "anthropic-prod", []string{"ANTHROPIC_API_KEY"}, false),
+			},
+			wantProviders: "openai-prod",
+			wantSkipped:   "b-anthropic",
+		},
+		{
+			name:   "volume limit skips the access that would exceed it",
+			limits: InjectionLimits{Volumes: 1},
+			accesses: []*llmwardenv1alpha1.LLMAccess{
+				access("a-openai", "openai-prod", nil, true),
+				access("b-anthropic", "anthropic-prod", []string{"ANTHROPIC_API_KEY"}, false),
+				access("c-mistral", "mistral-prod", nil, true),
+			},
+			wantProviders: "openai-prod,anthropic-prod",
+			wantSkipped:   "c-mistral",
+		},
+		{
+			name: "zero limits inject everything",
+			accesses: []*llmwardenv1alpha1.LLMAccess{
+				access("a-openai", "openai-prod", []string{"OPENAI_API_KEY", "OPENAI_BASE_URL"}, true),
+				access("b-anthropic", "anthropic-prod", []string{"ANTHROPIC_API_KEY"}, true),
+			},
+			wantProviders: "openai-prod,anthropic-prod",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objects := make([]client.Object, 0, len(tt.accesses))
+			for _, a := range tt.accesses {
+				objects = append(objects, a)
+			}
+			recorder := record.NewFakeRecorder(10)
+			injector := &PodInjector{
+				Client:   fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+				decoder:  admission.NewDecoder(scheme),
+				Limits:   tt.limits,
+				Recorder: recorder,
+			}
+
+			// The counter is global, so compare against its value before admission.
+			var exceeded prometheus.Counter
+			var exceededBefore float64
+			for _, a := range tt.accesses {
+				if a.Name == tt.wantSkipped {
+					exceeded = metrics.InjectionLimitExceeded.WithLabelValues(a.Namespace, a.Name, a.Spec.ProviderRef.Name)
+					exceededBefore = testutil.ToFloat64(exceeded)
+				}
+			}
+
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "chatbot", Namespace: "test-ns", Labels: map[string]string{"app": "chatbot"}},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "main", Image: "nginx"}}},
+			}
+			podBytes, err := json.Marshal(pod)
+			if err != nil {
+				t.Fatalf("Failed to marshal pod: %v", err)
+			}
+			req := admission.Request{}
+			req.Namespace = pod.Namespace
+			req.Object = runtime.RawExtension{Raw: podBytes}
+
+			resp := injector.Handle(context.Background(), req)
+			if !resp.Allowed {
+				t.Fatalf("Handle() allowed = false, want true")
+			}
+
+			var providers string
+			for _, op := range resp.Patches {
+				if op.Path != "/metadata/annotations" {
+					continue
+				}
+				annotations, ok := op.Value.(map[string]any)
+				if !ok {
+					t.Fatalf("annotations patch value = %#v, want a map", op.Value)
+				}
+				providers, _ = annotations[InjectedProvidersAnnotation()].(string)
+			}
+			if providers != tt.wantProviders {
+				t.Errorf("%s = %q, want %q", InjectedProvidersAnnotation(), providers, tt.wantProviders)
+			}
+
+			if tt.wantSkipped == "" {
+				if len(resp.Warnings) != 0 {
+					t.Errorf("warnings = %v, want none", resp.Warnings)
+				}
+				for len(recorder.Events) > 0 {
+					if event := <-recorder.Events; strings.Contains(event, ReasonInjectionLimitExceeded) {
+						t.Errorf("event = %q, want no %s event", event, ReasonInjectionLimitExceeded)
+					}
+				}
+				return
+			}
+			if len(resp.Warnings) != 1 || !strings.Contains(resp.Warnings[0], tt.wantSkipped) {
+				t.Errorf("warnings = %v, want one naming %s", resp.Warnings, tt.wantSkipped)
+			}
+			select {
+			case event := <-recorder.Events:
+				if !strings.Contains(event, ReasonInjectionLimitExceeded) || !strings.Contains(event, tt.wantSkipped) {
+					t.Errorf("event = %q, want an %s event naming %s", event, ReasonInjectionLimitExceeded, tt.wantSkipped)
+				}
+			default:
+				t.Errorf("no event recorded, want an %s event", ReasonInjectionLimitExceeded)
+			}
+			if got := testutil.ToFloat64(exceeded); got != exceededBefore+1 {
+				t.Errorf("llmwarden_webhook_injection_limit_exceeded_total = %v, want %v", got, exceededBefore+1)
+			}
+		})
+	}
+}
+
+func TestPodInjector_Handle_InjectionLimitsSkipEffects(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	// Both accesses' files are unreadable by the pod's non-root user, but only the first
+	// fits within the volume limit.
+	access := func(name, provider string) *llmwardenv1alpha1.LLMAccess {
+		return &llmwardenv1alpha1.LLMAccess{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-ns"},
+			Spec: llmwardenv1alpha1.LLMAccessSpec{
+				ProviderRef:      llmwardenv1alpha1.ProviderReference{Name: provider},
+				SecretName:       name + "-creds",
+				WorkloadSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "chatbot"}},
+				Injection: llmwardenv1alpha1.InjectionConfig{
+					Env:    []llmwardenv1alpha1.EnvVarMapping{{Name: strings.ToUpper(provider) + "_API_KEY", SecretKey: "apiKey"}},
+					Volume: &llmwardenv1alpha1.VolumeInjection{MountPath: "/etc/" + name},
+				},
+			},
+		}
+	}
+	kept, skipped := access("a-openai", "openai"), access("b-anthropic", "anthropic")
+
+	recorder := record.NewFakeRecorder(10)
+	injector := &PodInjector{
+		Client:   fake.NewClientBuilder().WithScheme(scheme).WithObjects(kept, skipped).Build(),
+		decoder:  admission.NewDecoder(scheme),
+		Limits:   InjectionLimits{Volumes: 1},
+		Recorder: recorder,
+	}
+	injectedEnvVars := metrics.WebhookInjectedEnvVars.WithLabelValues(skipped.Namespace, skipped.Name, skipped.Spec.ProviderRef.Name)
+	injectedEnvVars.Set(3)
+
+	runAsUser := int64(1000)
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "chatbot", Namespace: "test-ns", Labels: map[string]string{"app": "chatbot"}},
+		Spec: corev1.PodSpec{
+			SecurityContext: &corev1.PodSecurityContext{RunAsUser: &runAsUser},
+			Containers:      []corev1.Container{{Name: "main", Image: "nginx"}},
+		},
+	}
+	podBytes, err := json.Marshal(pod)
+	if err != nil {
+		t.Fatalf("Failed to marshal pod: %v", err)
+	}
+	req := admission.Request{}
+	req.Namespace = pod.Namespace
+	req.Object = runtime.RawExtension{Raw: podBytes}
+
+	if resp := injector.Handle(context.Background(), req); !resp.Allowed {
+		t.Fatalf("Handle() allowed = false, want true")
+	}
+
+	var unreadable []string
+	for len(recorder.Events) > 0 {
+		if event := <-recorder.Events; strings.Contains(event, ReasonCredentialsLikelyUnreadable) {
+			unreadable = append(unreadable, event)
+		}
+	}
+	if len(unreadable) != 1 || !strings.Contains(unreadable[0], kept.Name) {
+		t.Errorf("%s events = %v, want one for %s only", ReasonCredentialsLikelyUnreadable, unreadable, kept.Name)
+	}
+	if got := testutil.ToFloat64(injectedEnvVars); got != 0 {
+		t.Errorf("llmwarden_webhook_injected_env_vars of the skipped access = %v, want 0", got)
+	}
+}
+
+func TestPodInjector_Handle_RequestUID(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	var lines []string
+	previous := podinjectorlog
+	podinjectorlog = funcr.New(func(prefix, args string) {
+		lines = append(lines, args)
+	}, funcr.Options{Verbosity: 1})
+	t.Cleanup(func() { podinjectorlog = previous })
+
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "chatbot"}}
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai", Namespace: "test-ns"},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			ProviderRef:      llmwardenv1alpha1.ProviderReference{Name: "openai-prod"},
+			SecretName:       "openai-creds",
+			WorkloadSelector: selector,
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				Env:    []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+				Volume: &llmwardenv1alpha1.VolumeInjection{MountPath: "/etc/openai"},
+			},
+		},
+	}
+	injector := &PodInjector{
+		Client:  fake.NewClientBuilder().WithScheme(scheme).WithObjects(access).Build(),
+		decoder: admission.NewDecoder(scheme),
+	}
+
+	// The privileged container makes injectVolume log as well as Handle and injectEnvVars.
+	privileged := true
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "chatbot", Namespace: "test-ns", Labels: map[string]string{"app": "chatbot"}},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "main", Image: "nginx"},
+				{Name: "agent", Image: "agent", SecurityContext: &corev1.SecurityContext{Privileged: &privileged}},
+			},
+		},
+	}
+	podBytes, err := json.Marshal(pod)
+	if err != nil {
+		t.Fatalf("Failed to marshal pod: %v", err)
+	}
+	req := admission.Request{}
+	req.UID = "3f1c9a2e-uid"
+	req.Namespace = pod.Namespace
+	req.Object = runtime.RawExtension{Raw: podBytes}
+
+	resp := injector.Handle(context.Background(), req)
+	if !resp.Allowed {
+		t.Fatalf("Handle() allowed = false, want true")
+	}
+
+	wantField := `"admissionUID"="3f1c9a2e-uid"`
+	for _, msg := range []string{"Processing pod", "Injected env vars", "over-privileged container", "Successfully injected"} {
+		if !slices.ContainsFunc(lines, func(line string) bool { return strings.Contains(line, msg) }) {
+			t.Errorf("log lines = %q, want one containing %q", lines, msg)
+		}
+	}
+	for _, line := range lines {
+		if !strings.Contains(line, wantField) {
+			t.Errorf("log line %q, want it to carry %s", line, wantField)
+		}
+	}
+
+	var got string
+	for _, op := range resp.Patches {
+		if annotations, ok := op.Value.(map[string]any); ok && op.Path == "/metadata/annotations" {
+			got, _ = annotations[InjectionRequestAnnotation()].(string)
+		}
+	}
+	if got != string(req.UID) {
+		t.Errorf("%s = %q, want %q", InjectionRequestAnnotation(), got, req.UID)
+	}
+}
+
+func TestPodInjector_Handle_InjectedEvents(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai", Namespace: "test-ns"},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			ProviderRef:      llmwardenv1alpha1.ProviderReference{Name: "openai-prod"},
+			SecretName:       "openai-creds",
+			WorkloadSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "chatbot"}},
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+			},
+		},
+	}
+	recorder := record.NewFakeRecorder(10)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	injector := &PodInjector{
+		Client:                 fake.NewClientBuilder().WithScheme(scheme).WithObjects(access).Build(),
+		decoder:                admission.NewDecoder(scheme),
+		Recorder:               recorder,
+		InjectionEventInterval: time.Minute,
+		now:                    func() time.Time { return now },
+	}
+
+	admit := func(podName string, dryRun bool) {
+		t.Helper()
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: podName, Namespace: "test-ns", Labels: map[string]string{"app": "chatbot"}},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "main", Image: "nginx"}}},
+		}
+		podBytes, err := json.Marshal(pod)
+		if err != nil {
+			t.Fatalf("Failed to marshal pod: %v", err)
+		}
+		req := admission.Request{}
+		req.UID = types.UID(podName + "-uid")
+		req.Namespace = pod.Namespace
+		req.Object = runtime.RawExtension{Raw: podBytes}
+		req.DryRun = &dryRun
+		if resp := injector.Handle(context.Background(), req); !resp.Allowed || len(resp.Patches) == 0 {
+			t.Fatalf("Handle(%s) = %+v, want an injection", podName, resp.Result)
+		}
+	}
+
+	// The first injection is recorded, the next two within the interval are counted, and
+	// dry-run admissions are neither.
+	admit("chatbot-1", false)
+	now = now.Add(10 * time.Second)
+	admit("chatbot-2", false)
+	admit("chatbot-dry", true)
+	now = now.Add(20 * time.Second)
+	admit("chatbot-3", false)
+	now = now.Add(time.Minute)
+	admit("chatbot-4", false)
+
+	var events []string
+	for len(recorder.Events) > 0 {
+		events = append(events, <-recorder.Events)
+	}
+	want := []string{
+		fmt.Sprintf("Normal Injected Injected credentials into pod test-ns/chatbot-1 map[%s:chatbot-1-uid]",
+			InjectionRequestAnnotation()),
+		fmt.Sprintf("Normal Injected Injected credentials into pod test-ns/chatbot-4 and 2 more pods since the last event map[%s:chatbot-4-uid]",
+			InjectionRequestAnnotation()),
+	}
+	if !slices.Equal(events, want) {
+		t.Errorf("events = %q, want %q", events, want)
+	}
+}
+
+func TestPodInjector_Handle_Priority(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	// Both accesses inject OPENAI_API_KEY from their own secret.
+	access := func(name string, priority int32) *llmwardenv1alpha1.LLMAccess {
+		return &llmwardenv1alpha1.LLMAccess{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-ns"},
+			Spec: llmwardenv1alpha1.LLMAccessSpec{
+				ProviderRef:      llmwardenv1alpha1.ProviderReference{Name: name + "-provider"},
+				SecretName:       name + "-creds",
+				WorkloadSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "chatbot"}},
+				Priority:         priority,
+				Injection: llmwardenv1alpha1.InjectionConfig{
+					Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		accesses      []*llmwardenv1alpha1.LLMAccess
+		wantSecret    string
+		wantSkipped   string
+		wantProviders string
+	}{
+		{
+			name:          "higher priority wins the collision",
+			accesses:      []*llmwardenv1alpha1.LLMAccess{access("a-fallback", 0), access("b-primary", 10)},
+			wantSecret:    "b-primary-creds",
+			wantSkipped:   "a-fallback",
+			wantProviders: "b-primary-provider,a-fallback-provider",
+		},
+		{
+			name:          "equal priorities fall back to name order",
+			accesses:      []*llmwardenv1alpha1.LLMAccess{access("a-fallback", 5), access("b-primary", 5)},
+			wantSecret:    "a-fallback-creds",
+			wantSkipped:   "b-primary",
+			wantProviders: "a-fallback-provider,b-primary-provider",
+		},
+	}
+
+	for _, tt := range tests {
+		// The outcome must not depend on the order the accesses are listed in.
+		for _, reversed := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s/reversed=%v", tt.name, reversed), func(t *testing.T) {
+				objects := make([]client.Object, 0, len(tt.accesses))
+				for _, a := range tt.accesses {
+					objects = append(objects, a.DeepCopy())
+				}
+				if reversed {
+					slices.Reverse(objects)
+				}
+				injector := &PodInjector{
+					Client:  fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+					decoder: admission.NewDecoder(scheme),
+				}
+
+				pod := &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "chatbot", Namespace: "test-ns", Labels: map[string]string{"app": "chatbot"}},
+					Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "main", Image: "nginx"}}},
+				}
+				podBytes, err := json.Marshal(pod)
+				if err != nil {
+					t.Fatalf("Failed to marshal pod: %v", err)
+				}
+				req := admission.Request{}
+				req.Namespace = pod.Namespace
+				req.Object = runtime.RawExtension{Raw: podBytes}
+
+				resp := injector.Handle(context.Background(), req)
+				if !resp.Allowed {
+					t.Fatalf("Handle() allowed = false, want true")
+				}
+
+				var env []corev1.EnvVar
+				var providers string
+				for _, op := range resp.Patches {
+					switch op.Path {
+					case "/spec/containers/0/env":
+						raw, err := json.Marshal(op.Value)
+						if err != nil {
+							t.Fatalf("Failed to marshal env patch: %v", err)
+						}
+						if err := json.Unmarshal(raw, &env); err != nil {
+							t.Fatalf("env patch value = %s, want a list of env vars: %v", raw, err)
+						}
+					case "/metadata/annotations":
+						annotations, _ := op.Value.(map[string]any)
+						providers, _ = annotations[InjectedProvidersAnnotation()].(string)
+					}
+				}
+				if len(env) != 1 || env[0].ValueFrom == nil || env[0].ValueFrom.SecretKeyRef == nil {
+					t.Fatalf("env = %+v, want a single OPENAI_API_KEY from a secret", env)
+				}
+				if got := env[0].ValueFrom.SecretKeyRef.Name; got != tt.wantSecret {
+					t.Errorf("OPENAI_API_KEY comes from secret %s, want %s", got, tt.wantSecret)
+				}
+				if providers != tt.wantProviders {
+					t.Errorf("%s = %q, want %q", InjectedProvidersAnnotation(), providers, tt.wantProviders)
+				}
+				if len(resp.Warnings) != 1 || !strings.Contains(resp.Warnings[0], "OPENAI_API_KEY of LLMAccess "+tt.wantSkipped) {
+					t.Errorf("warnings = %v, want one about %s losing OPENAI_API_KEY", resp.Warnings, tt.wantSkipped)
+				}
+			})
+		}
+	}
+}
+
+func TestPodInjector_Handle_WorkflowOwnerLabels(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = llmwardenv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	// The selector targets the label users put on the Workflow; Argo labels the pods it
+	// creates with its own workflow labels instead.
+	access := &llmwardenv1alpha1.LLMAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: "training-access", Namespace: "test-ns"},
+		Spec: llmwardenv1alpha1.LLMAccessSpec{
+			ProviderRef:      llmwardenv1alpha1.ProviderReference{Name: "openai"},
+			SecretName:       "openai-creds",
+			WorkloadSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "training"}},
+			Injection: llmwardenv1alpha1.InjectionConfig{
+				Env: []llmwardenv1alpha1.EnvVarMapping{{Name: "OPENAI_API_KEY", SecretKey: "apiKey"}},
+			},
+		},
+	}
+	isController := true
+	workflowOwner := metav1.OwnerReference{
+		APIVersion: "argoproj.io/v1alpha1", Kind: "Workflow", Name: "train-42", UID: "wf-uid", Controller: &isController,
+	}
+	replicaSetOwner := metav1.OwnerReference{
+		APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "train-42", UID: "rs-uid", Controller: &isController,
+	}
+
+	// ownerReader serves the Workflow's metadata and records which owners were read.
+	var ownerReads []string
+	ownerReader := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+		Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			meta, ok := obj.(*metav1.PartialObjectMetadata)
+			if !ok {
+				return c.Get(ctx, key, obj, opts...)
+			}
+			ownerReads = append(ownerReads, meta.Kind+"/"+key.Namespace+"/"+key.Name)
+			if meta.Kind != "Workflow" || key.Name != "train-42" || key.Namespace != "test-ns" {
+				return errors.New("not found")
+			}
+			meta.Labels = map[string]string{"team": "training", "app": "from-workflow"}
+			return nil
+		},
+	}).Build()
+	failingReader := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+		Get: func(context.Context, client.WithWatch, client.ObjectKey, client.Object, ...client.GetOption) error {
+			return errors.New("workflows.argoproj.io is forbidden")
+		},
+	}).Build()
+
+	tests := []struct {
+		name          string
+		reader        client.Reader
+		owner         metav1.OwnerReference
+		wantInjected  bool
+		wantOwnerRead bool
+	}{
+		{name: "workflow owner labels match", reader: ownerReader, owner: workflowOwner, wantInjected: true, wantOwnerRead: true},
+		{name: "owner labels ignored when disabled", reader: nil, owner: workflowOwner},
+		{name: "owner read failure admits without injecting", reader: failingReader, owner: workflowOwner},
+		{name: "non-workflow owners are not read", reader: ownerReader, owner: replicaSetOwner},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ownerReads = nil
+			injector := &PodInjector{
+				Client:              fake.NewClientBuilder().WithScheme(scheme).WithObjects(access.DeepCopy()).Build(),
+				decoder:             admission.NewDecoder(scheme),
+				WorkflowOwnerReader: tt.reader,
+			}
+
+			// The pod leaves its namespace to the admission request, as clients may.
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName:    "train-42-",
+					Labels:          map[string]string{"workflows.argoproj.io/workflow": "train-42"},
+					OwnerReferences: []metav1.OwnerReference{tt.owner},
+				},
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "main", Image: "trainer"}}},
+			}
+			podBytes, err := json.Marshal(pod)
+			if err != nil {
+				t.Fatalf("Failed to marshal pod: %v", err)
+			}
+			req := admission.Request{}
+			req.Namespace = "test-ns"
+			req.Object = runtime.RawExtension{Raw: podBytes}
+
+			resp := injector.Handle(context.Background(), req)
+			if !resp.Allowed {
+				t.Fatalf("Handle() allowed = false, want true")
+			}
+			injected := false
+			for _, op := range resp.Patches {
+				injected = injected || op.Path == "/spec/containers/0/env"
+			}
+			if injected != tt.wantInjected {
+				t.Errorf("injected = %v, want %v (patches: %+v)", injected, tt.wantInjected, resp.Patches)
+			}
+			if gotRead := slices.Contains(ownerReads, "Workflow/test-ns/train-42"); gotRead != tt.wantOwnerRead {
+				t.Errorf("owner reads = %v, want Workflow/test-ns/train-42 read: %v", ownerReads, tt.wantOwnerRead)
+			}
+		})
+	}
+}